@@ -33,6 +33,110 @@ func getEnvInt(key string, defaultVal int) int {
 	return parsed
 }
 
+func getEnvBool(key string, defaultVal bool) bool {
+	val := strings.TrimSpace(os.Getenv(key))
+	if val == "" {
+		return defaultVal
+	}
+	parsed, err := strconv.ParseBool(val)
+	if err != nil {
+		return defaultVal
+	}
+	return parsed
+}
+
+// getEnvSeconds reads a duration expressed in whole seconds, falling back to the default for
+// missing, invalid, or non-positive values.
+func getEnvSeconds(key string, defaultVal time.Duration) time.Duration {
+	seconds := getEnvInt(key, int(defaultVal/time.Second))
+	if seconds <= 0 {
+		return defaultVal
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// getEnvSecondsAllowZero is like getEnvSeconds but keeps zero, for settings where zero
+// disables a feature.
+func getEnvSecondsAllowZero(key string, defaultVal time.Duration) time.Duration {
+	return time.Duration(getEnvInt(key, int(defaultVal/time.Second))) * time.Second
+}
+
+const defaultShutdownTimeout = 10 * time.Second
+
+// getShutdownTimeout returns how long graceful shutdown may take, including the WebSocket drain.
+func getShutdownTimeout() time.Duration {
+	return getEnvSeconds("SHUTDOWN_TIMEOUT_SECONDS", defaultShutdownTimeout)
+}
+
+const (
+	defaultHTTPReadTimeout       = 15 * time.Second
+	defaultHTTPReadHeaderTimeout = 5 * time.Second
+	defaultHTTPWriteTimeout      = 15 * time.Second
+	defaultHTTPIdleTimeout       = 60 * time.Second
+)
+
+// httpServerTimeouts holds the server-wide connection timeouts.
+type httpServerTimeouts struct {
+	Read       time.Duration
+	ReadHeader time.Duration
+	Write      time.Duration
+	Idle       time.Duration
+}
+
+// getHTTPServerTimeouts loads server timeouts from the environment, keeping the previous
+// hardcoded values as defaults.
+func getHTTPServerTimeouts() httpServerTimeouts {
+	return httpServerTimeouts{
+		Read:       getEnvSeconds("HTTP_READ_TIMEOUT_SECONDS", defaultHTTPReadTimeout),
+		ReadHeader: getEnvSeconds("HTTP_READ_HEADER_TIMEOUT_SECONDS", defaultHTTPReadHeaderTimeout),
+		Write:      getEnvSeconds("HTTP_WRITE_TIMEOUT_SECONDS", defaultHTTPWriteTimeout),
+		Idle:       getEnvSeconds("HTTP_IDLE_TIMEOUT_SECONDS", defaultHTTPIdleTimeout),
+	}
+}
+
+func newHTTPServer(addr string, handler http.Handler, timeouts httpServerTimeouts) *http.Server {
+	return &http.Server{
+		Addr:              addr,
+		Handler:           handler,
+		ReadTimeout:       timeouts.Read,
+		ReadHeaderTimeout: timeouts.ReadHeader,
+		WriteTimeout:      timeouts.Write,
+		IdleTimeout:       timeouts.Idle,
+	}
+}
+
+// getServiceSettings loads the operator settings for services from the environment. Zero
+// durations disable their feature; invalid values fall back to the defaults.
+func getServiceSettings() services.Settings {
+	return services.Settings{
+		EditGracePeriod:                 getEnvSecondsAllowZero("EDIT_GRACE_PERIOD_SECONDS", services.DefaultEditGracePeriod),
+		RestoreWindow:                   getEnvSecondsAllowZero("RESTORE_WINDOW_SECONDS", services.DefaultRestoreWindow),
+		RejectedUserRetention:           time.Duration(getEnvInt("REJECTED_USER_RETENTION_DAYS", int(services.DefaultRejectedUserRetention/(24*time.Hour)))) * 24 * time.Hour,
+		LinkMetadataCacheTTL:            getEnvSecondsAllowZero("LINK_METADATA_CACHE_TTL_SECONDS", services.DefaultLinkMetadataCacheTTL),
+		MaxBioLength:                    getEnvInt("PROFILE_BIO_MAX_LENGTH", services.DefaultMaxBioLength),
+		MaxProfilePictureURLLength:      getEnvInt("PROFILE_PICTURE_URL_MAX_LENGTH", services.DefaultMaxProfilePictureURLLength),
+		MaxLogNoteLength:                getEnvInt("LOG_NOTE_MAX_LENGTH", services.DefaultMaxLogNoteLength),
+		CommentContextMaxDepth:          getEnvInt("COMMENT_CONTEXT_MAX_DEPTH", services.DefaultCommentContextMaxDepth),
+		ThreadMaxComments:               getEnvInt("THREAD_MAX_COMMENTS", services.DefaultThreadMaxComments),
+		PostRemovalNotificationsEnabled: getEnvBool("POST_REMOVAL_NOTIFY_COMMENTERS", true),
+		ContentRequiredSectionTypes:     getEnvList("CONTENT_REQUIRED_SECTION_TYPES"),
+		MediaRequiredSectionTypes:       getEnvList("MEDIA_REQUIRED_SECTION_TYPES"),
+		ViewerCategoryOrders: map[string]services.ViewerCategoryOrder{
+			services.ViewerCategoryStatRecipe:    services.ViewerCategoryOrder(os.Getenv("RECIPE_VIEWER_CATEGORY_ORDER")),
+			services.ViewerCategoryStatWatchlist: services.ViewerCategoryOrder(os.Getenv("WATCHLIST_VIEWER_CATEGORY_ORDER")),
+			services.ViewerCategoryStatBookshelf: services.ViewerCategoryOrder(os.Getenv("BOOKSHELF_VIEWER_CATEGORY_ORDER")),
+		},
+	}
+}
+
+func getEnvList(key string) []string {
+	val := strings.TrimSpace(os.Getenv(key))
+	if val == "" {
+		return nil
+	}
+	return strings.Split(val, ",")
+}
+
 func writeJSONBytes(ctx context.Context, w http.ResponseWriter, statusCode int, body []byte) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(statusCode)
@@ -141,11 +245,56 @@ func main() {
 	}
 	defer redisConn.Close()
 
+	services.GetConfigService().SetSettings(getServiceSettings())
+
 	workerCount := getEnvInt("METADATA_WORKER_COUNT", 3)
 	metadataWorker := services.NewMetadataWorker(redisConn, dbConn, &services.DefaultMetadataFetcher{}, workerCount)
 	metadataWorker.Start(ctx)
 	observability.LogInfo(ctx, "metadata worker started", "worker_count", fmt.Sprintf("%d", workerCount))
 
+	// Digests are only scheduled when there is a mailer to deliver them
+	var digestScheduler *services.DigestScheduler
+	var digestMailWorker *services.DigestMailWorker
+	if digestMailer := services.NewSMTPDigestMailer(services.SMTPConfig{
+		Host:     os.Getenv("SMTP_HOST"),
+		Port:     os.Getenv("SMTP_PORT"),
+		Username: os.Getenv("SMTP_USERNAME"),
+		Password: os.Getenv("SMTP_PASSWORD"),
+		From:     os.Getenv("SMTP_FROM"),
+		Timeout:  getEnvSeconds("SMTP_TIMEOUT_SECONDS", services.DefaultSMTPTimeout),
+	}); digestMailer != nil {
+		digestMailWorker = services.NewDigestMailWorker(redisConn, digestMailer)
+		digestMailWorker.Start(ctx)
+		digestScheduler = services.NewDigestScheduler(
+			dbConn,
+			redisConn,
+			getEnvInt("DIGEST_SEND_HOUR", services.DefaultDigestSendHour),
+			getEnvSeconds("DIGEST_CHECK_INTERVAL_SECONDS", services.DefaultDigestCheckInterval),
+		)
+		digestScheduler.Start(ctx)
+	} else {
+		observability.LogInfo(ctx, "digest emails disabled: SMTP_HOST and SMTP_FROM are not set")
+	}
+
+	rejectedUserPurger := services.NewRejectedUserPurger(
+		dbConn,
+		redisConn,
+		getEnvSeconds("REJECTED_USER_PURGE_INTERVAL_SECONDS", services.DefaultRejectedUserPurgeInterval),
+	)
+	rejectedUserPurger.Start(ctx)
+
+	var linkHealthChecker *services.LinkHealthChecker
+	if getEnvBool("LINK_HEALTH_CHECK_ENABLED", false) {
+		linkHealthChecker = services.NewLinkHealthChecker(
+			dbConn,
+			redisConn,
+			getEnvSeconds("LINK_HEALTH_CHECK_INTERVAL_SECONDS", services.DefaultLinkHealthCheckInterval),
+			getEnvInt("LINK_HEALTH_CHECK_BATCH_SIZE", services.DefaultLinkHealthCheckBatchSize),
+			getEnvSeconds("LINK_HEALTH_RECHECK_AFTER_SECONDS", services.DefaultLinkHealthRecheckAfter),
+		)
+		linkHealthChecker.Start(ctx)
+	}
+
 	// Initialize HTTP server
 	mux := http.NewServeMux()
 
@@ -160,6 +309,7 @@ func main() {
 	// Initialize handlers
 	authHandler := handlers.NewAuthHandler(dbConn, redisConn)
 	configHandler := handlers.NewConfigHandler()
+	versionHandler := handlers.NewVersionHandler()
 	pushService := services.NewPushService(dbConn)
 	postHandler := handlers.NewPostHandler(dbConn, redisConn, pushService)
 	commentHandler := handlers.NewCommentHandler(dbConn, redisConn, pushService)
@@ -198,6 +348,9 @@ func main() {
 
 	// API routes
 	mux.Handle("/api/v1/config", http.HandlerFunc(configHandler.GetPublicConfig))
+	mux.Handle("/api/v1/timezones", http.HandlerFunc(configHandler.ListTimezones))
+	mux.Handle("/api/v1/timezones/validate", http.HandlerFunc(configHandler.ValidateTimezone))
+	mux.Handle("/api/v1/version", http.HandlerFunc(versionHandler.GetVersion))
 	mux.HandleFunc("/api/v1/auth/register", authHandler.Register)
 	mux.HandleFunc("/api/v1/auth/login", authHandler.Login)
 	mux.Handle("/api/v1/auth/logout", requireAuthCSRF(http.HandlerFunc(authHandler.Logout)))
@@ -206,13 +359,14 @@ func main() {
 	mux.Handle("/api/v1/auth/logout-all", requireAuthCSRF(http.HandlerFunc(authHandler.LogoutAll)))
 	mux.HandleFunc("/api/v1/auth/password-reset/redeem", authHandler.RedeemPasswordResetToken)
 	mux.Handle("/api/v1/sections", requireAuth(http.HandlerFunc(sectionHandler.ListSections)))
-	sectionRouteHandler := newSectionRouteHandler(requireAuth, sectionRouteDeps{
-		listSections:      sectionHandler.ListSections,
-		getSection:        sectionHandler.GetSection,
-		getFeed:           postHandler.GetFeed,
-		getLinks:          sectionHandler.GetSectionLinks,
-		getRecentPodcasts: sectionHandler.GetRecentPodcasts,
-		getPodcastSaved:   podcastSaveHandler.ListSectionSavedPodcastPosts,
+	sectionRouteHandler := newSectionRouteHandler(requireAuth, requireAuthCSRF, sectionRouteDeps{
+		listSections:       sectionHandler.ListSections,
+		getSection:         sectionHandler.GetSection,
+		getFeed:            postHandler.GetFeed,
+		getLinks:           sectionHandler.GetSectionLinks,
+		getRecentPodcasts:  sectionHandler.GetRecentPodcasts,
+		getPodcastSaved:    podcastSaveHandler.ListSectionSavedPodcastPosts,
+		updateReadPosition: sectionHandler.UpdateReadPosition,
 	})
 	mux.Handle("/api/v1/sections/", sectionRouteHandler)
 
@@ -254,6 +408,42 @@ func main() {
 			writeJSONBytes(r.Context(), w, http.StatusMethodNotAllowed, []byte(`{"error":"Method not allowed","code":"METHOD_NOT_ALLOWED"}`))
 			return
 		}
+		if r.URL.Path == "/api/v1/users/me/digest-preferences" {
+			if r.Method == http.MethodGet {
+				requireAuth(http.HandlerFunc(userHandler.GetMyDigestPreferences)).ServeHTTP(w, r)
+				return
+			}
+			if r.Method == http.MethodPatch {
+				requireAuthCSRF(http.HandlerFunc(userHandler.UpdateMyDigestPreferences)).ServeHTTP(w, r)
+				return
+			}
+			writeJSONBytes(r.Context(), w, http.StatusMethodNotAllowed, []byte(`{"error":"Method not allowed","code":"METHOD_NOT_ALLOWED"}`))
+			return
+		}
+		if r.URL.Path == "/api/v1/users/me/notification-preferences" {
+			if r.Method == http.MethodGet {
+				requireAuth(http.HandlerFunc(userHandler.GetMyNotificationPreferences)).ServeHTTP(w, r)
+				return
+			}
+			if r.Method == http.MethodPatch {
+				requireAuthCSRF(http.HandlerFunc(userHandler.UpdateMyNotificationPreferences)).ServeHTTP(w, r)
+				return
+			}
+			writeJSONBytes(r.Context(), w, http.StatusMethodNotAllowed, []byte(`{"error":"Method not allowed","code":"METHOD_NOT_ALLOWED"}`))
+			return
+		}
+		if r.URL.Path == "/api/v1/users/me/timezone" {
+			if r.Method == http.MethodGet {
+				requireAuth(http.HandlerFunc(userHandler.GetMyTimezone)).ServeHTTP(w, r)
+				return
+			}
+			if r.Method == http.MethodPatch {
+				requireAuthCSRF(http.HandlerFunc(userHandler.UpdateMyTimezone)).ServeHTTP(w, r)
+				return
+			}
+			writeJSONBytes(r.Context(), w, http.StatusMethodNotAllowed, []byte(`{"error":"Method not allowed","code":"METHOD_NOT_ALLOWED"}`))
+			return
+		}
 		// Check if this is the /api/v1/users/me endpoint
 		if r.URL.Path == "/api/v1/users/me" {
 			if r.Method == http.MethodPatch {
@@ -268,6 +458,22 @@ func main() {
 		if r.Method == http.MethodGet && isUserQuoteCollectionPath(r.URL.Path) {
 			quotesHandler := middleware.RequireAuth(redisConn, dbConn)(http.HandlerFunc(bookQuoteHandler.GetUserQuotes))
 			quotesHandler.ServeHTTP(w, r)
+		} else if r.Method == http.MethodGet && isUserCookLogCollectionPath(r.URL.Path) {
+			// GET /api/v1/users/{id}/cook-logs
+			cookLogsHandler := middleware.RequireAuth(redisConn, dbConn)(http.HandlerFunc(cookLogHandler.GetUserCookLogs))
+			cookLogsHandler.ServeHTTP(w, r)
+		} else if r.Method == http.MethodGet && isUserRatingBiasPath(r.URL.Path) {
+			// GET /api/v1/users/{id}/rating-bias
+			requireAuth(http.HandlerFunc(userHandler.GetUserRatingBias)).ServeHTTP(w, r)
+		} else if r.Method == http.MethodGet && isUserLastActivePath(r.URL.Path) {
+			// GET /api/v1/users/{id}/last-active
+			requireAuth(http.HandlerFunc(userHandler.GetUserLastActive)).ServeHTTP(w, r)
+		} else if r.Method == http.MethodGet && isUserMoviesPath(r.URL.Path) {
+			// GET /api/v1/users/{id}/movies
+			requireAuth(http.HandlerFunc(watchlistHandler.GetUserMovies)).ServeHTTP(w, r)
+		} else if r.Method == http.MethodGet && isUserTopPostsPath(r.URL.Path) {
+			// GET /api/v1/users/{id}/top-posts
+			requireAuth(http.HandlerFunc(userHandler.GetUserTopPosts)).ServeHTTP(w, r)
 		} else if r.Method == http.MethodGet && strings.Contains(r.URL.Path, "/posts") {
 			// GET /api/v1/users/{id}/posts
 			postsHandler := middleware.RequireAuth(redisConn, dbConn)(http.HandlerFunc(userHandler.GetUserPosts))
@@ -306,6 +512,9 @@ func main() {
 		} else if r.Method == http.MethodPatch && isCommentIDPath(r.URL.Path) {
 			updateHandler := requireAuthCSRF(http.HandlerFunc(commentHandler.UpdateComment))
 			updateHandler.ServeHTTP(w, r)
+		} else if r.Method == http.MethodGet && strings.HasSuffix(strings.TrimSuffix(r.URL.Path, "/"), "/context") {
+			// GET /api/v1/comments/{id}/context
+			requireAuth(http.HandlerFunc(commentHandler.GetCommentContext)).ServeHTTP(w, r)
 		} else if r.Method == http.MethodGet {
 			requireAuth(http.HandlerFunc(commentHandler.GetComment)).ServeHTTP(w, r)
 		} else if r.Method == http.MethodDelete {
@@ -329,17 +538,21 @@ func main() {
 		addReactionToPost:       reactionHandler.AddReactionToPost,
 		removeReactionFromPost:  reactionHandler.RemoveReactionFromPost,
 		getReactions:            reactionHandler.GetPostReactions,
+		getAllowedReactions:     reactionHandler.GetAllowedReactions,
 		saveRecipe:              savedRecipeHandler.SaveRecipe,
 		unsaveRecipe:            savedRecipeHandler.UnsaveRecipe,
+		moveSavedRecipe:         savedRecipeHandler.MoveSavedRecipe,
 		getPostSaves:            savedRecipeHandler.GetPostSaves,
 		savePodcast:             podcastSaveHandler.SavePodcast,
 		unsavePodcast:           podcastSaveHandler.UnsavePodcast,
 		getPostPodcastSaveInfo:  podcastSaveHandler.GetPostPodcastSaveInfo,
 		addToWatchlist:          watchlistHandler.AddToWatchlist,
 		removeFromWatchlist:     watchlistHandler.RemoveFromWatchlist,
+		moveWatchlistItem:       watchlistHandler.MoveWatchlistItem,
 		getPostWatchlistInfo:    watchlistHandler.GetPostWatchlistInfo,
 		addToBookshelf:          bookshelfHandler.AddToBookshelf,
 		removeFromBookshelf:     bookshelfHandler.RemoveFromBookshelf,
+		moveBookshelfItem:       bookshelfHandler.MoveBookshelfItem,
 		logCook:                 cookLogHandler.LogCook,
 		updateCookLog:           cookLogHandler.UpdateCookLog,
 		removeCookLog:           cookLogHandler.RemoveCookLog,
@@ -353,6 +566,10 @@ func main() {
 		removeReadLog:           readLogHandler.RemoveReadLog,
 		getReadLogs:             readLogHandler.GetPostReadLogs,
 		getPost:                 postHandler.GetPost,
+		getPostCounts:           postHandler.GetPostCounts,
+		getRatingDistribution:   postHandler.GetPostRatingDistribution,
+		getMoreFromAuthor:       postHandler.GetMoreFromAuthor,
+		getPostTimeline:         postHandler.GetPostTimeline,
 		updatePost:              postHandler.UpdatePost,
 		deletePost:              postHandler.DeletePost,
 	})
@@ -371,6 +588,10 @@ func main() {
 	)
 	mux.Handle("/api/v1/comments", commentCreateHandler)
 
+	mux.Handle("/api/v1/me/config", requireAuth(http.HandlerFunc(userHandler.GetMyConfig)))
+	mux.Handle("/api/v1/me/engagement", requireAuth(http.HandlerFunc(postHandler.GetMyEngagement)))
+	mux.Handle("/api/v1/me/sections/activity", requireAuth(http.HandlerFunc(sectionHandler.GetMySectionActivity)))
+
 	// Saved recipe routes (protected)
 	mux.Handle("/api/v1/me/saved-recipes", requireAuth(http.HandlerFunc(savedRecipeHandler.ListSavedRecipes)))
 	mux.Handle("/api/v1/me/recipe-categories", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -398,6 +619,7 @@ func main() {
 
 	// Watchlist routes (protected)
 	mux.Handle("/api/v1/me/watchlist", requireAuth(http.HandlerFunc(watchlistHandler.ListWatchlist)))
+	mux.Handle("/api/v1/me/watchlist/categories", requireAuth(http.HandlerFunc(watchlistHandler.ListWatchlistCategoryCounts)))
 	mux.Handle("/api/v1/me/watchlist-categories", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method == http.MethodGet {
 			requireAuth(http.HandlerFunc(watchlistHandler.ListWatchlistCategories)).ServeHTTP(w, r)
@@ -423,13 +645,14 @@ func main() {
 
 	// Bookshelf routes (protected)
 	registerBookshelfRoutes(mux, requireAuth, requireAuthCSRF, bookshelfRouteDeps{
-		getMyBookshelf:    bookshelfHandler.GetMyBookshelf,
-		getAllBookshelf:   bookshelfHandler.GetAllBookshelf,
-		listCategories:    bookshelfHandler.ListCategories,
-		createCategory:    bookshelfHandler.CreateCategory,
-		reorderCategories: bookshelfHandler.ReorderCategories,
-		updateCategory:    bookshelfHandler.UpdateCategory,
-		deleteCategory:    bookshelfHandler.DeleteCategory,
+		getMyBookshelf:     bookshelfHandler.GetMyBookshelf,
+		getAllBookshelf:    bookshelfHandler.GetAllBookshelf,
+		listCategories:     bookshelfHandler.ListCategories,
+		listCategoryCounts: bookshelfHandler.ListCategoryCounts,
+		createCategory:     bookshelfHandler.CreateCategory,
+		reorderCategories:  bookshelfHandler.ReorderCategories,
+		updateCategory:     bookshelfHandler.UpdateCategory,
+		deleteCategory:     bookshelfHandler.DeleteCategory,
 	})
 	registerBookQuoteRoutes(mux, requireAuthCSRF, bookQuoteRouteDeps{
 		updateQuote: bookQuoteHandler.UpdateQuote,
@@ -452,6 +675,8 @@ func main() {
 	// Notification routes (protected)
 	mux.Handle("/api/v1/notifications", requireAuth(http.HandlerFunc(notificationHandler.GetNotifications)))
 	mux.Handle("/api/v1/notifications/read", requireAuthCSRF(http.HandlerFunc(notificationHandler.MarkAllNotificationsRead)))
+	mux.Handle("/api/v1/notifications/digest", requireAuth(http.HandlerFunc(notificationHandler.GetNotificationDigest)))
+	mux.Handle("/api/v1/notifications/states", requireAuthCSRF(http.HandlerFunc(notificationHandler.GetNotificationStates)))
 	mux.Handle("/api/v1/notifications/", requireAuthCSRF(http.HandlerFunc(notificationHandler.MarkNotificationRead)))
 
 	// Push routes (protected)
@@ -514,6 +739,16 @@ func main() {
 		}
 	})))
 
+	// Admin link metadata refresh route
+	mux.Handle("/api/v1/admin/links/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !isAdminLinkRefreshPath(r.URL.Path) {
+			writeJSONBytes(r.Context(), w, http.StatusNotFound, []byte(`{"error":"Not found","code":"NOT_FOUND"}`))
+			return
+		}
+		requireAdminCSRF(http.HandlerFunc(adminHandler.RefreshLinkMetadata)).ServeHTTP(w, r)
+	}))
+	mux.Handle("/api/v1/admin/metadata/status", requireAdmin(http.HandlerFunc(adminHandler.GetMetadataStatus)))
+
 	// Admin config route
 	mux.Handle("/api/v1/admin/config", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method == http.MethodGet {
@@ -546,6 +781,7 @@ func main() {
 		middleware.RequestID,
 		middleware.CSPMiddleware,
 		middleware.Observability,
+		middleware.MaintenanceMode,
 	)
 
 	// HTTP server config
@@ -554,14 +790,7 @@ func main() {
 		port = "8080"
 	}
 
-	server := &http.Server{
-		Addr:              ":" + port,
-		Handler:           handler,
-		ReadTimeout:       15 * time.Second,
-		ReadHeaderTimeout: 5 * time.Second,
-		WriteTimeout:      15 * time.Second,
-		IdleTimeout:       60 * time.Second,
-	}
+	server := newHTTPServer(":"+port, handler, getHTTPServerTimeouts())
 
 	// Start server in goroutine
 	go func() {
@@ -584,7 +813,7 @@ func main() {
 
 	// Graceful shutdown
 	observability.LogInfo(ctx, "shutting down server")
-	ctxShutdown, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctxShutdown, cancel := context.WithTimeout(context.Background(), getShutdownTimeout())
 	defer cancel()
 
 	if err := server.Shutdown(ctxShutdown); err != nil {
@@ -597,7 +826,22 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Hijacked WebSocket connections are not tracked by server.Shutdown, so drain them within the same deadline
+	if err := wsHandler.Shutdown(ctxShutdown); err != nil {
+		observability.LogWarn(ctx, "websocket drain did not finish before shutdown timeout",
+			"error", err.Error(),
+		)
+	}
+
 	metadataWorker.Stop(ctx)
+	if digestScheduler != nil {
+		digestScheduler.Stop(ctx)
+		digestMailWorker.Stop(ctx)
+	}
+	rejectedUserPurger.Stop(ctx)
+	if linkHealthChecker != nil {
+		linkHealthChecker.Stop(ctx)
+	}
 
 	observability.LogInfo(ctx, "server stopped")
 }
@@ -1,6 +1,10 @@
 package main
 
-import "testing"
+import (
+	"net/http"
+	"testing"
+	"time"
+)
 
 func TestGetEnvInt(t *testing.T) {
 	t.Run("uses default when not set", func(t *testing.T) {
@@ -31,3 +35,70 @@ func TestGetEnvInt(t *testing.T) {
 		}
 	})
 }
+
+func TestGetShutdownTimeout(t *testing.T) {
+	t.Run("defaults to 10 seconds when unset", func(t *testing.T) {
+		t.Setenv("SHUTDOWN_TIMEOUT_SECONDS", "")
+		if got := getShutdownTimeout(); got != 10*time.Second {
+			t.Fatalf("expected 10s, got %s", got)
+		}
+	})
+
+	t.Run("uses env value when set", func(t *testing.T) {
+		t.Setenv("SHUTDOWN_TIMEOUT_SECONDS", "25")
+		if got := getShutdownTimeout(); got != 25*time.Second {
+			t.Fatalf("expected 25s, got %s", got)
+		}
+	})
+
+	t.Run("falls back to default for non-positive values", func(t *testing.T) {
+		t.Setenv("SHUTDOWN_TIMEOUT_SECONDS", "0")
+		if got := getShutdownTimeout(); got != defaultShutdownTimeout {
+			t.Fatalf("expected %s, got %s", defaultShutdownTimeout, got)
+		}
+	})
+}
+
+func TestGetHTTPServerTimeouts(t *testing.T) {
+	t.Run("uses defaults when unset", func(t *testing.T) {
+		t.Setenv("HTTP_READ_TIMEOUT_SECONDS", "")
+		t.Setenv("HTTP_READ_HEADER_TIMEOUT_SECONDS", "")
+		t.Setenv("HTTP_WRITE_TIMEOUT_SECONDS", "")
+		t.Setenv("HTTP_IDLE_TIMEOUT_SECONDS", "")
+
+		server := newHTTPServer(":0", http.NotFoundHandler(), getHTTPServerTimeouts())
+		if server.ReadTimeout != 15*time.Second {
+			t.Fatalf("expected read timeout 15s, got %s", server.ReadTimeout)
+		}
+		if server.ReadHeaderTimeout != 5*time.Second {
+			t.Fatalf("expected read header timeout 5s, got %s", server.ReadHeaderTimeout)
+		}
+		if server.WriteTimeout != 15*time.Second {
+			t.Fatalf("expected write timeout 15s, got %s", server.WriteTimeout)
+		}
+		if server.IdleTimeout != 60*time.Second {
+			t.Fatalf("expected idle timeout 60s, got %s", server.IdleTimeout)
+		}
+	})
+
+	t.Run("applies env values", func(t *testing.T) {
+		t.Setenv("HTTP_READ_TIMEOUT_SECONDS", "30")
+		t.Setenv("HTTP_READ_HEADER_TIMEOUT_SECONDS", "10")
+		t.Setenv("HTTP_WRITE_TIMEOUT_SECONDS", "45")
+		t.Setenv("HTTP_IDLE_TIMEOUT_SECONDS", "120")
+
+		server := newHTTPServer(":0", http.NotFoundHandler(), getHTTPServerTimeouts())
+		if server.ReadTimeout != 30*time.Second {
+			t.Fatalf("expected read timeout 30s, got %s", server.ReadTimeout)
+		}
+		if server.ReadHeaderTimeout != 10*time.Second {
+			t.Fatalf("expected read header timeout 10s, got %s", server.ReadHeaderTimeout)
+		}
+		if server.WriteTimeout != 45*time.Second {
+			t.Fatalf("expected write timeout 45s, got %s", server.WriteTimeout)
+		}
+		if server.IdleTimeout != 120*time.Second {
+			t.Fatalf("expected idle timeout 120s, got %s", server.IdleTimeout)
+		}
+	})
+}
@@ -20,17 +20,21 @@ type postRouteDeps struct {
 	addReactionToPost       http.HandlerFunc
 	removeReactionFromPost  http.HandlerFunc
 	getReactions            http.HandlerFunc
+	getAllowedReactions     http.HandlerFunc
 	saveRecipe              http.HandlerFunc
 	unsaveRecipe            http.HandlerFunc
+	moveSavedRecipe         http.HandlerFunc
 	getPostSaves            http.HandlerFunc
 	savePodcast             http.HandlerFunc
 	unsavePodcast           http.HandlerFunc
 	getPostPodcastSaveInfo  http.HandlerFunc
 	addToWatchlist          http.HandlerFunc
 	removeFromWatchlist     http.HandlerFunc
+	moveWatchlistItem       http.HandlerFunc
 	getPostWatchlistInfo    http.HandlerFunc
 	addToBookshelf          http.HandlerFunc
 	removeFromBookshelf     http.HandlerFunc
+	moveBookshelfItem       http.HandlerFunc
 	logCook                 http.HandlerFunc
 	updateCookLog           http.HandlerFunc
 	removeCookLog           http.HandlerFunc
@@ -44,6 +48,10 @@ type postRouteDeps struct {
 	removeReadLog           http.HandlerFunc
 	getReadLogs             http.HandlerFunc
 	getPost                 http.HandlerFunc
+	getPostCounts           http.HandlerFunc
+	getRatingDistribution   http.HandlerFunc
+	getMoreFromAuthor       http.HandlerFunc
+	getPostTimeline         http.HandlerFunc
 	updatePost              http.HandlerFunc
 	deletePost              http.HandlerFunc
 }
@@ -100,6 +108,11 @@ func newPostRouteHandler(requireAuth authMiddleware, requireAuthCSRF authMiddlew
 			requireAuthCSRF(http.HandlerFunc(deps.unsaveRecipe)).ServeHTTP(w, r)
 			return
 		}
+		if r.Method == http.MethodPatch && strings.HasSuffix(strings.TrimSuffix(r.URL.Path, "/"), "/save/category") {
+			// PATCH /api/v1/posts/{id}/save/category
+			requireAuthCSRF(http.HandlerFunc(deps.moveSavedRecipe)).ServeHTTP(w, r)
+			return
+		}
 		if r.Method == http.MethodGet && strings.HasSuffix(strings.TrimSuffix(r.URL.Path, "/"), "/saves") {
 			// GET /api/v1/posts/{id}/saves
 			requireAuth(http.HandlerFunc(deps.getPostSaves)).ServeHTTP(w, r)
@@ -130,6 +143,11 @@ func newPostRouteHandler(requireAuth authMiddleware, requireAuthCSRF authMiddlew
 			requireAuthCSRF(http.HandlerFunc(deps.removeFromWatchlist)).ServeHTTP(w, r)
 			return
 		}
+		if r.Method == http.MethodPatch && strings.HasSuffix(strings.TrimSuffix(r.URL.Path, "/"), "/watchlist/category") {
+			// PATCH /api/v1/posts/{id}/watchlist/category
+			requireAuthCSRF(http.HandlerFunc(deps.moveWatchlistItem)).ServeHTTP(w, r)
+			return
+		}
 		if r.Method == http.MethodGet && strings.HasSuffix(strings.TrimSuffix(r.URL.Path, "/"), "/watchlist-info") {
 			// GET /api/v1/posts/{id}/watchlist-info
 			requireAuth(http.HandlerFunc(deps.getPostWatchlistInfo)).ServeHTTP(w, r)
@@ -145,11 +163,21 @@ func newPostRouteHandler(requireAuth authMiddleware, requireAuthCSRF authMiddlew
 			requireAuthCSRF(http.HandlerFunc(deps.removeFromBookshelf)).ServeHTTP(w, r)
 			return
 		}
+		if r.Method == http.MethodPatch && strings.HasSuffix(strings.TrimSuffix(r.URL.Path, "/"), "/bookshelf/category") {
+			// PATCH /api/v1/posts/{id}/bookshelf/category
+			requireAuthCSRF(http.HandlerFunc(deps.moveBookshelfItem)).ServeHTTP(w, r)
+			return
+		}
 		if r.Method == http.MethodDelete && strings.Contains(r.URL.Path, "/reactions/") {
 			// DELETE /api/v1/posts/{id}/reactions/{emoji}
 			requireAuthCSRF(http.HandlerFunc(deps.removeReactionFromPost)).ServeHTTP(w, r)
 			return
 		}
+		if r.Method == http.MethodGet && strings.HasSuffix(strings.TrimSuffix(r.URL.Path, "/"), "/allowed-reactions") {
+			// GET /api/v1/posts/{id}/allowed-reactions
+			requireAuth(http.HandlerFunc(deps.getAllowedReactions)).ServeHTTP(w, r)
+			return
+		}
 		if r.Method == http.MethodGet && strings.Contains(r.URL.Path, "/reactions") {
 			// GET /api/v1/posts/{id}/reactions
 			requireAuth(http.HandlerFunc(deps.getReactions)).ServeHTTP(w, r)
@@ -215,6 +243,26 @@ func newPostRouteHandler(requireAuth authMiddleware, requireAuthCSRF authMiddlew
 			requireAuthCSRF(http.HandlerFunc(deps.removeReadLog)).ServeHTTP(w, r)
 			return
 		}
+		if r.Method == http.MethodGet && strings.HasSuffix(strings.TrimSuffix(r.URL.Path, "/"), "/counts") {
+			// GET /api/v1/posts/{id}/counts
+			requireAuth(http.HandlerFunc(deps.getPostCounts)).ServeHTTP(w, r)
+			return
+		}
+		if r.Method == http.MethodGet && strings.HasSuffix(strings.TrimSuffix(r.URL.Path, "/"), "/rating-distribution") {
+			// GET /api/v1/posts/{id}/rating-distribution
+			requireAuth(http.HandlerFunc(deps.getRatingDistribution)).ServeHTTP(w, r)
+			return
+		}
+		if r.Method == http.MethodGet && strings.HasSuffix(strings.TrimSuffix(r.URL.Path, "/"), "/more-from-author") {
+			// GET /api/v1/posts/{id}/more-from-author
+			requireAuth(http.HandlerFunc(deps.getMoreFromAuthor)).ServeHTTP(w, r)
+			return
+		}
+		if r.Method == http.MethodGet && strings.HasSuffix(strings.TrimSuffix(r.URL.Path, "/"), "/timeline") {
+			// GET /api/v1/posts/{id}/timeline
+			requireAuth(http.HandlerFunc(deps.getPostTimeline)).ServeHTTP(w, r)
+			return
+		}
 		if r.Method == http.MethodPatch && isPostIDPath(r.URL.Path) {
 			// PATCH /api/v1/posts/{id}
 			requireAuthCSRF(http.HandlerFunc(deps.updatePost)).ServeHTTP(w, r)
@@ -235,22 +283,24 @@ func newPostRouteHandler(requireAuth authMiddleware, requireAuthCSRF authMiddlew
 }
 
 type sectionRouteDeps struct {
-	listSections      http.HandlerFunc
-	getSection        http.HandlerFunc
-	getFeed           http.HandlerFunc
-	getLinks          http.HandlerFunc
-	getRecentPodcasts http.HandlerFunc
-	getPodcastSaved   http.HandlerFunc
+	listSections       http.HandlerFunc
+	getSection         http.HandlerFunc
+	getFeed            http.HandlerFunc
+	getLinks           http.HandlerFunc
+	getRecentPodcasts  http.HandlerFunc
+	getPodcastSaved    http.HandlerFunc
+	updateReadPosition http.HandlerFunc
 }
 
 type bookshelfRouteDeps struct {
-	getMyBookshelf    http.HandlerFunc
-	getAllBookshelf   http.HandlerFunc
-	listCategories    http.HandlerFunc
-	createCategory    http.HandlerFunc
-	reorderCategories http.HandlerFunc
-	updateCategory    http.HandlerFunc
-	deleteCategory    http.HandlerFunc
+	getMyBookshelf     http.HandlerFunc
+	getAllBookshelf    http.HandlerFunc
+	listCategories     http.HandlerFunc
+	listCategoryCounts http.HandlerFunc
+	createCategory     http.HandlerFunc
+	reorderCategories  http.HandlerFunc
+	updateCategory     http.HandlerFunc
+	deleteCategory     http.HandlerFunc
 }
 
 type bookQuoteRouteDeps struct {
@@ -258,8 +308,12 @@ type bookQuoteRouteDeps struct {
 	deleteQuote http.HandlerFunc
 }
 
-func newSectionRouteHandler(requireAuth authMiddleware, deps sectionRouteDeps) http.Handler {
+func newSectionRouteHandler(requireAuth authMiddleware, requireAuthCSRF authMiddleware, deps sectionRouteDeps) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(strings.TrimSuffix(r.URL.Path, "/"), "/read-position") {
+			requireAuthCSRF(http.HandlerFunc(deps.updateReadPosition)).ServeHTTP(w, r)
+			return
+		}
 		if strings.HasSuffix(strings.TrimSuffix(r.URL.Path, "/"), "/podcast-saved") {
 			requireAuth(http.HandlerFunc(deps.getPodcastSaved)).ServeHTTP(w, r)
 			return
@@ -294,6 +348,7 @@ func registerBookshelfRoutes(
 ) {
 	mux.Handle("/api/v1/bookshelf", requireAuth(http.HandlerFunc(deps.getMyBookshelf)))
 	mux.Handle("/api/v1/bookshelf/all", requireAuth(http.HandlerFunc(deps.getAllBookshelf)))
+	mux.Handle("/api/v1/me/bookshelf/categories", requireAuth(http.HandlerFunc(deps.listCategoryCounts)))
 	mux.Handle("/api/v1/bookshelf/categories", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method == http.MethodGet {
 			requireAuth(http.HandlerFunc(deps.listCategories)).ServeHTTP(w, r)
@@ -392,6 +447,60 @@ func isUserQuoteCollectionPath(path string) bool {
 	return parts[1] == "api" && parts[2] == "v1" && parts[3] == "users" && parts[4] != "" && parts[4] != "me" && parts[5] == "quotes"
 }
 
+func isUserCookLogCollectionPath(path string) bool {
+	trimmed := strings.TrimSuffix(path, "/")
+	parts := strings.Split(trimmed, "/")
+	if len(parts) != 6 {
+		return false
+	}
+	return parts[1] == "api" && parts[2] == "v1" && parts[3] == "users" && parts[4] != "" && parts[4] != "me" && parts[5] == "cook-logs"
+}
+
+func isUserRatingBiasPath(path string) bool {
+	trimmed := strings.TrimSuffix(path, "/")
+	parts := strings.Split(trimmed, "/")
+	if len(parts) != 6 {
+		return false
+	}
+	return parts[1] == "api" && parts[2] == "v1" && parts[3] == "users" && parts[4] != "" && parts[4] != "me" && parts[5] == "rating-bias"
+}
+
+func isUserMoviesPath(path string) bool {
+	trimmed := strings.TrimSuffix(path, "/")
+	parts := strings.Split(trimmed, "/")
+	if len(parts) != 6 {
+		return false
+	}
+	return parts[1] == "api" && parts[2] == "v1" && parts[3] == "users" && parts[4] != "" && parts[4] != "me" && parts[5] == "movies"
+}
+
+func isUserLastActivePath(path string) bool {
+	trimmed := strings.TrimSuffix(path, "/")
+	parts := strings.Split(trimmed, "/")
+	if len(parts) != 6 {
+		return false
+	}
+	return parts[1] == "api" && parts[2] == "v1" && parts[3] == "users" && parts[4] != "" && parts[4] != "me" && parts[5] == "last-active"
+}
+
+func isUserTopPostsPath(path string) bool {
+	trimmed := strings.TrimSuffix(path, "/")
+	parts := strings.Split(trimmed, "/")
+	if len(parts) != 6 {
+		return false
+	}
+	return parts[1] == "api" && parts[2] == "v1" && parts[3] == "users" && parts[4] != "" && parts[4] != "me" && parts[5] == "top-posts"
+}
+
+func isAdminLinkRefreshPath(path string) bool {
+	trimmed := strings.TrimSuffix(path, "/")
+	parts := strings.Split(trimmed, "/")
+	if len(parts) != 7 {
+		return false
+	}
+	return parts[1] == "api" && parts[2] == "v1" && parts[3] == "admin" && parts[4] == "links" && parts[5] != "" && parts[6] == "refresh"
+}
+
 func isCommentIDPath(path string) bool {
 	trimmed := strings.TrimSuffix(path, "/")
 	parts := strings.Split(trimmed, "/")
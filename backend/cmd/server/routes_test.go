@@ -744,6 +744,224 @@ func TestPostRouteHandlerGetPostWatchlistInfoRequiresAuth(t *testing.T) {
 	}
 }
 
+func TestPostRouteHandlerGetPostCountsRequiresAuth(t *testing.T) {
+	authCalled := false
+	handlerCalled := false
+
+	requireAuth := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authCalled = true
+			next.ServeHTTP(w, r)
+		})
+	}
+
+	requireAuthCSRF := func(next http.Handler) http.Handler {
+		return next
+	}
+
+	deps := postRouteDeps{
+		getPostCounts: func(w http.ResponseWriter, r *http.Request) {
+			handlerCalled = true
+			w.WriteHeader(http.StatusOK)
+		},
+		getPost: func(w http.ResponseWriter, r *http.Request) {
+			t.Fatal("getPost should not be called")
+		},
+	}
+
+	handler := newPostRouteHandler(requireAuth, requireAuthCSRF, deps)
+	postID := uuid.New()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/posts/"+postID.String()+"/counts", nil)
+	rr := httptest.NewRecorder()
+
+	handler.ServeHTTP(rr, req)
+
+	if status := rr.Code; status != http.StatusOK {
+		t.Fatalf("expected status %v, got %v", http.StatusOK, status)
+	}
+	if !authCalled {
+		t.Fatal("expected auth middleware to be called")
+	}
+	if !handlerCalled {
+		t.Fatal("expected getPostCounts handler to be called")
+	}
+}
+
+func TestPostRouteHandlerGetRatingDistributionRequiresAuth(t *testing.T) {
+	authCalled := false
+	handlerCalled := false
+
+	requireAuth := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authCalled = true
+			next.ServeHTTP(w, r)
+		})
+	}
+
+	requireAuthCSRF := func(next http.Handler) http.Handler {
+		return next
+	}
+
+	deps := postRouteDeps{
+		getRatingDistribution: func(w http.ResponseWriter, r *http.Request) {
+			handlerCalled = true
+			w.WriteHeader(http.StatusOK)
+		},
+		getPost: func(w http.ResponseWriter, r *http.Request) {
+			t.Fatal("getPost should not be called")
+		},
+	}
+
+	handler := newPostRouteHandler(requireAuth, requireAuthCSRF, deps)
+	postID := uuid.New()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/posts/"+postID.String()+"/rating-distribution", nil)
+	rr := httptest.NewRecorder()
+
+	handler.ServeHTTP(rr, req)
+
+	if status := rr.Code; status != http.StatusOK {
+		t.Fatalf("expected status %v, got %v", http.StatusOK, status)
+	}
+	if !authCalled {
+		t.Fatal("expected auth middleware to be called")
+	}
+	if !handlerCalled {
+		t.Fatal("expected getRatingDistribution handler to be called")
+	}
+}
+
+func TestPostRouteHandlerGetMoreFromAuthorRequiresAuth(t *testing.T) {
+	authCalled := false
+	handlerCalled := false
+
+	requireAuth := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authCalled = true
+			next.ServeHTTP(w, r)
+		})
+	}
+
+	requireAuthCSRF := func(next http.Handler) http.Handler {
+		return next
+	}
+
+	deps := postRouteDeps{
+		getMoreFromAuthor: func(w http.ResponseWriter, r *http.Request) {
+			handlerCalled = true
+			w.WriteHeader(http.StatusOK)
+		},
+		getPost: func(w http.ResponseWriter, r *http.Request) {
+			t.Fatal("getPost should not be called")
+		},
+	}
+
+	handler := newPostRouteHandler(requireAuth, requireAuthCSRF, deps)
+	postID := uuid.New()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/posts/"+postID.String()+"/more-from-author", nil)
+	rr := httptest.NewRecorder()
+
+	handler.ServeHTTP(rr, req)
+
+	if status := rr.Code; status != http.StatusOK {
+		t.Fatalf("expected status %v, got %v", http.StatusOK, status)
+	}
+	if !authCalled {
+		t.Fatal("expected auth middleware to be called")
+	}
+	if !handlerCalled {
+		t.Fatal("expected getMoreFromAuthor handler to be called")
+	}
+}
+
+func TestPostRouteHandlerGetPostTimelineRequiresAuth(t *testing.T) {
+	authCalled := false
+	handlerCalled := false
+
+	requireAuth := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authCalled = true
+			next.ServeHTTP(w, r)
+		})
+	}
+
+	requireAuthCSRF := func(next http.Handler) http.Handler {
+		return next
+	}
+
+	deps := postRouteDeps{
+		getPostTimeline: func(w http.ResponseWriter, r *http.Request) {
+			handlerCalled = true
+			w.WriteHeader(http.StatusOK)
+		},
+		getPost: func(w http.ResponseWriter, r *http.Request) {
+			t.Fatal("getPost should not be called")
+		},
+	}
+
+	handler := newPostRouteHandler(requireAuth, requireAuthCSRF, deps)
+	postID := uuid.New()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/posts/"+postID.String()+"/timeline", nil)
+	rr := httptest.NewRecorder()
+
+	handler.ServeHTTP(rr, req)
+
+	if status := rr.Code; status != http.StatusOK {
+		t.Fatalf("expected status %v, got %v", http.StatusOK, status)
+	}
+	if !authCalled {
+		t.Fatal("expected auth middleware to be called")
+	}
+	if !handlerCalled {
+		t.Fatal("expected getPostTimeline handler to be called")
+	}
+}
+
+func TestPostRouteHandlerGetAllowedReactionsRequiresAuth(t *testing.T) {
+	authCalled := false
+	handlerCalled := false
+
+	requireAuth := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authCalled = true
+			next.ServeHTTP(w, r)
+		})
+	}
+
+	requireAuthCSRF := func(next http.Handler) http.Handler {
+		return next
+	}
+
+	deps := postRouteDeps{
+		getAllowedReactions: func(w http.ResponseWriter, r *http.Request) {
+			handlerCalled = true
+			w.WriteHeader(http.StatusOK)
+		},
+		getReactions: func(w http.ResponseWriter, r *http.Request) {
+			t.Fatal("getReactions should not be called")
+		},
+		getPost: func(w http.ResponseWriter, r *http.Request) {
+			t.Fatal("getPost should not be called")
+		},
+	}
+
+	handler := newPostRouteHandler(requireAuth, requireAuthCSRF, deps)
+	postID := uuid.New()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/posts/"+postID.String()+"/allowed-reactions", nil)
+	rr := httptest.NewRecorder()
+
+	handler.ServeHTTP(rr, req)
+
+	if status := rr.Code; status != http.StatusOK {
+		t.Fatalf("expected status %v, got %v", http.StatusOK, status)
+	}
+	if !authCalled {
+		t.Fatal("expected auth middleware to be called")
+	}
+	if !handlerCalled {
+		t.Fatal("expected getAllowedReactions handler to be called")
+	}
+}
+
 func TestPostRouteHandlerSavePodcastUsesCSRFAuth(t *testing.T) {
 	authCalled := false
 	handlerCalled := false
@@ -953,6 +1171,83 @@ func TestPostRouteHandlerRemoveFromBookshelfUsesCSRFAuth(t *testing.T) {
 	}
 }
 
+func TestPostRouteHandlerMoveSaveCategoryUsesCSRFAuth(t *testing.T) {
+	tests := []struct {
+		name   string
+		suffix string
+		deps   func(called *string) postRouteDeps
+	}{
+		{
+			name:   "saved recipe",
+			suffix: "/save/category",
+			deps: func(called *string) postRouteDeps {
+				return postRouteDeps{moveSavedRecipe: func(w http.ResponseWriter, r *http.Request) {
+					*called = "moveSavedRecipe"
+					w.WriteHeader(http.StatusOK)
+				}}
+			},
+		},
+		{
+			name:   "watchlist",
+			suffix: "/watchlist/category",
+			deps: func(called *string) postRouteDeps {
+				return postRouteDeps{moveWatchlistItem: func(w http.ResponseWriter, r *http.Request) {
+					*called = "moveWatchlistItem"
+					w.WriteHeader(http.StatusOK)
+				}}
+			},
+		},
+		{
+			name:   "bookshelf",
+			suffix: "/bookshelf/category",
+			deps: func(called *string) postRouteDeps {
+				return postRouteDeps{moveBookshelfItem: func(w http.ResponseWriter, r *http.Request) {
+					*called = "moveBookshelfItem"
+					w.WriteHeader(http.StatusOK)
+				}}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			authCalled := false
+			called := ""
+
+			requireAuth := func(next http.Handler) http.Handler {
+				return next
+			}
+			requireAuthCSRF := func(next http.Handler) http.Handler {
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					authCalled = true
+					next.ServeHTTP(w, r)
+				})
+			}
+
+			deps := tt.deps(&called)
+			deps.updatePost = func(w http.ResponseWriter, r *http.Request) {
+				t.Fatal("updatePost should not be called")
+			}
+
+			handler := newPostRouteHandler(requireAuth, requireAuthCSRF, deps)
+			req := httptest.NewRequest(http.MethodPatch, "/api/v1/posts/"+uuid.New().String()+tt.suffix, nil)
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			if status := rr.Code; status != http.StatusOK {
+				t.Fatalf("expected status %v, got %v", http.StatusOK, status)
+			}
+			if !authCalled {
+				t.Fatal("expected CSRF auth middleware to be called")
+			}
+			if called == "" {
+				t.Fatal("expected move handler to be called")
+			}
+		})
+	}
+}
+
 func TestPostRouteHandlerLogWatchUsesCSRFAuth(t *testing.T) {
 	authCalled := false
 	logCalled := false
@@ -1443,7 +1738,7 @@ func TestSectionRouteHandlerFeedRequiresAuth(t *testing.T) {
 		},
 	}
 
-	handler := newSectionRouteHandler(requireAuth, deps)
+	handler := newSectionRouteHandler(requireAuth, requireAuth, deps)
 	sectionID := uuid.New()
 	req := httptest.NewRequest(http.MethodGet, "/api/v1/sections/"+sectionID.String()+"/feed", nil)
 	rr := httptest.NewRecorder()
@@ -1487,7 +1782,7 @@ func TestSectionRouteHandlerRecentPodcastsRequiresAuth(t *testing.T) {
 		},
 	}
 
-	handler := newSectionRouteHandler(requireAuth, deps)
+	handler := newSectionRouteHandler(requireAuth, requireAuth, deps)
 	sectionID := uuid.New()
 	req := httptest.NewRequest(http.MethodGet, "/api/v1/sections/"+sectionID.String()+"/podcasts/recent", nil)
 	rr := httptest.NewRecorder()
@@ -1533,7 +1828,7 @@ func TestSectionRouteHandlerPodcastSavedRequiresAuth(t *testing.T) {
 		},
 	}
 
-	handler := newSectionRouteHandler(requireAuth, deps)
+	handler := newSectionRouteHandler(requireAuth, requireAuth, deps)
 	sectionID := uuid.New()
 	req := httptest.NewRequest(http.MethodGet, "/api/v1/sections/"+sectionID.String()+"/podcast-saved", nil)
 	rr := httptest.NewRecorder()
@@ -1548,6 +1843,58 @@ func TestSectionRouteHandlerPodcastSavedRequiresAuth(t *testing.T) {
 	}
 }
 
+func TestSectionRouteHandlerReadPositionRequiresCSRF(t *testing.T) {
+	authCalled := false
+	csrfCalled := false
+	updateCalled := false
+
+	requireAuth := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authCalled = true
+			next.ServeHTTP(w, r)
+		})
+	}
+	requireAuthCSRF := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			csrfCalled = true
+			next.ServeHTTP(w, r)
+		})
+	}
+
+	deps := sectionRouteDeps{
+		getSection: func(w http.ResponseWriter, r *http.Request) {
+			t.Fatal("getSection should not be called for read position")
+		},
+		getFeed: func(w http.ResponseWriter, r *http.Request) {
+			t.Fatal("getFeed should not be called for read position")
+		},
+		updateReadPosition: func(w http.ResponseWriter, r *http.Request) {
+			updateCalled = true
+			w.WriteHeader(http.StatusOK)
+		},
+	}
+
+	handler := newSectionRouteHandler(requireAuth, requireAuthCSRF, deps)
+	sectionID := uuid.New()
+	req := httptest.NewRequest(http.MethodPut, "/api/v1/sections/"+sectionID.String()+"/read-position", nil)
+	rr := httptest.NewRecorder()
+
+	handler.ServeHTTP(rr, req)
+
+	if status := rr.Code; status != http.StatusOK {
+		t.Fatalf("expected status %v, got %v", http.StatusOK, status)
+	}
+	if !csrfCalled {
+		t.Fatal("expected CSRF auth middleware to be called")
+	}
+	if authCalled {
+		t.Fatal("expected plain auth middleware not to be called")
+	}
+	if !updateCalled {
+		t.Fatal("expected updateReadPosition handler to be called")
+	}
+}
+
 func TestRegisterBookshelfRoutesWiresHandlersAndMiddleware(t *testing.T) {
 	mux := http.NewServeMux()
 
@@ -1576,13 +1923,14 @@ func TestRegisterBookshelfRoutesWiresHandlersAndMiddleware(t *testing.T) {
 	}
 
 	registerBookshelfRoutes(mux, requireAuth, requireAuthCSRF, bookshelfRouteDeps{
-		getMyBookshelf:    handler("getMyBookshelf", http.StatusOK),
-		getAllBookshelf:   handler("getAllBookshelf", http.StatusOK),
-		listCategories:    handler("listCategories", http.StatusOK),
-		createCategory:    handler("createCategory", http.StatusCreated),
-		reorderCategories: handler("reorderCategories", http.StatusOK),
-		updateCategory:    handler("updateCategory", http.StatusOK),
-		deleteCategory:    handler("deleteCategory", http.StatusNoContent),
+		getMyBookshelf:     handler("getMyBookshelf", http.StatusOK),
+		getAllBookshelf:    handler("getAllBookshelf", http.StatusOK),
+		listCategories:     handler("listCategories", http.StatusOK),
+		listCategoryCounts: handler("listCategoryCounts", http.StatusOK),
+		createCategory:     handler("createCategory", http.StatusCreated),
+		reorderCategories:  handler("reorderCategories", http.StatusOK),
+		updateCategory:     handler("updateCategory", http.StatusOK),
+		deleteCategory:     handler("deleteCategory", http.StatusNoContent),
 	})
 
 	tests := []struct {
@@ -1621,6 +1969,15 @@ func TestRegisterBookshelfRoutesWiresHandlersAndMiddleware(t *testing.T) {
 			expectAuth:         true,
 			expectAuthWithCSRF: false,
 		},
+		{
+			name:               "GET /api/v1/me/bookshelf/categories",
+			method:             http.MethodGet,
+			path:               "/api/v1/me/bookshelf/categories",
+			expectedStatus:     http.StatusOK,
+			expectedHandler:    "listCategoryCounts",
+			expectAuth:         true,
+			expectAuthWithCSRF: false,
+		},
 		{
 			name:               "POST /api/v1/bookshelf/categories",
 			method:             http.MethodPost,
@@ -1705,13 +2062,14 @@ func TestRegisterBookshelfRoutesRejectsUnsupportedMethods(t *testing.T) {
 	}
 
 	registerBookshelfRoutes(mux, requireAuth, requireAuthCSRF, bookshelfRouteDeps{
-		getMyBookshelf:    func(w http.ResponseWriter, r *http.Request) { t.Fatal("unexpected getMyBookshelf call") },
-		getAllBookshelf:   func(w http.ResponseWriter, r *http.Request) { t.Fatal("unexpected getAllBookshelf call") },
-		listCategories:    func(w http.ResponseWriter, r *http.Request) { t.Fatal("unexpected listCategories call") },
-		createCategory:    func(w http.ResponseWriter, r *http.Request) { t.Fatal("unexpected createCategory call") },
-		reorderCategories: func(w http.ResponseWriter, r *http.Request) { t.Fatal("unexpected reorderCategories call") },
-		updateCategory:    func(w http.ResponseWriter, r *http.Request) { t.Fatal("unexpected updateCategory call") },
-		deleteCategory:    func(w http.ResponseWriter, r *http.Request) { t.Fatal("unexpected deleteCategory call") },
+		getMyBookshelf:     func(w http.ResponseWriter, r *http.Request) { t.Fatal("unexpected getMyBookshelf call") },
+		getAllBookshelf:    func(w http.ResponseWriter, r *http.Request) { t.Fatal("unexpected getAllBookshelf call") },
+		listCategories:     func(w http.ResponseWriter, r *http.Request) { t.Fatal("unexpected listCategories call") },
+		listCategoryCounts: func(w http.ResponseWriter, r *http.Request) { t.Fatal("unexpected listCategoryCounts call") },
+		createCategory:     func(w http.ResponseWriter, r *http.Request) { t.Fatal("unexpected createCategory call") },
+		reorderCategories:  func(w http.ResponseWriter, r *http.Request) { t.Fatal("unexpected reorderCategories call") },
+		updateCategory:     func(w http.ResponseWriter, r *http.Request) { t.Fatal("unexpected updateCategory call") },
+		deleteCategory:     func(w http.ResponseWriter, r *http.Request) { t.Fatal("unexpected deleteCategory call") },
 	})
 
 	tests := []struct {
@@ -1977,3 +2335,21 @@ func TestRegisterBookQuoteRoutesRejectsUnsupportedMethods(t *testing.T) {
 		t.Fatal("expected CSRF auth middleware to not be called")
 	}
 }
+
+func TestIsAdminLinkRefreshPath(t *testing.T) {
+	tests := map[string]bool{
+		"/api/v1/admin/links/123/refresh":        true,
+		"/api/v1/admin/links/123/refresh/":       true,
+		"/api/v1/admin/links/123":                false,
+		"/api/v1/admin/links/123/refresh/extra":  false,
+		"/api/v1/admin/links/123/delete":         false,
+		"/api/v1/admin/links//refresh":           false,
+		"/api/v1/admin/links/123/refresh-status": false,
+	}
+
+	for path, want := range tests {
+		if got := isAdminLinkRefreshPath(path); got != want {
+			t.Errorf("isAdminLinkRefreshPath(%q) = %v, want %v", path, got, want)
+		}
+	}
+}
@@ -0,0 +1,15 @@
+// Package buildinfo exposes build metadata injected at link time, e.g.
+//
+//	go build -ldflags "-X github.com/sanderginn/clubhouse/internal/buildinfo.Version=1.4.0 \
+//	  -X github.com/sanderginn/clubhouse/internal/buildinfo.Commit=abc1234"
+package buildinfo
+
+// APIVersion is the version of the HTTP API served under /api/v1.
+const APIVersion = "v1"
+
+// Build metadata, overridden via -ldflags at build time.
+var (
+	Version   = "dev"
+	Commit    = "unknown"
+	BuildTime = ""
+)
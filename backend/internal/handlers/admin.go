@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"reflect"
 	"strconv"
 	"strings"
 	"time"
@@ -30,6 +31,7 @@ type AdminHandler struct {
 	passwordResetService *services.PasswordResetService
 	totpService          *services.TOTPService
 	sessionService       *services.SessionService
+	redis                *redis.Client
 }
 
 // NewAdminHandler creates a new admin handler
@@ -42,11 +44,12 @@ func NewAdminHandler(db *sql.DB, redis *redis.Client) *AdminHandler {
 	return &AdminHandler{
 		db:                   db,
 		userService:          services.NewUserService(db),
-		postService:          services.NewPostService(db),
+		postService:          services.NewPostServiceWithRedis(db, redis),
 		commentService:       services.NewCommentService(db),
 		passwordResetService: services.NewPasswordResetService(redis),
 		totpService:          services.NewTOTPService(db),
 		sessionService:       sessionService,
+		redis:                redis,
 	}
 }
 
@@ -366,7 +369,7 @@ func (h *AdminHandler) UnsuspendUser(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// RejectUser rejects a pending user (hard delete)
+// RejectUser rejects a pending user, deleting them now or after the configured retention window
 func (h *AdminHandler) RejectUser(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodDelete {
 		writeError(r.Context(), w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "Only DELETE requests are allowed")
@@ -397,6 +400,8 @@ func (h *AdminHandler) RejectUser(w http.ResponseWriter, r *http.Request) {
 			writeError(r.Context(), w, http.StatusNotFound, "USER_NOT_FOUND", err.Error())
 		case "cannot reject approved user":
 			writeError(r.Context(), w, http.StatusConflict, "USER_ALREADY_APPROVED", "Cannot reject an already approved user")
+		case "user already rejected":
+			writeError(r.Context(), w, http.StatusConflict, "USER_ALREADY_REJECTED", "User has already been rejected")
 		default:
 			writeError(r.Context(), w, http.StatusInternalServerError, "REJECTION_FAILED", "Failed to reject user")
 		}
@@ -605,6 +610,87 @@ func (h *AdminHandler) HardDeletePost(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// RefreshLinkMetadata queues a metadata re-fetch for a post link, bypassing the URL cache (admin only)
+func (h *AdminHandler) RefreshLinkMetadata(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(r.Context(), w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "Only POST requests are allowed")
+		return
+	}
+
+	// Extract link ID from URL path: /admin/links/{id}/refresh
+	linkIDStr := strings.TrimPrefix(strings.TrimSuffix(r.URL.Path, "/"), "/api/v1/admin/links/")
+	linkIDStr = strings.TrimSuffix(linkIDStr, "/refresh")
+
+	linkID, err := uuid.Parse(linkIDStr)
+	if err != nil {
+		writeError(r.Context(), w, http.StatusBadRequest, "INVALID_LINK_ID", "Invalid link ID format")
+		return
+	}
+
+	if err := h.postService.RefreshLinkMetadata(r.Context(), linkID); err != nil {
+		switch {
+		case errors.Is(err, services.ErrLinkNotFound):
+			writeError(r.Context(), w, http.StatusNotFound, "LINK_NOT_FOUND", "Link not found")
+		case errors.Is(err, services.ErrMetadataQueueOff):
+			writeError(r.Context(), w, http.StatusServiceUnavailable, "METADATA_QUEUE_UNAVAILABLE", "Metadata queue is not available")
+		default:
+			writeError(r.Context(), w, http.StatusInternalServerError, "REFRESH_METADATA_FAILED", "Failed to refresh link metadata")
+		}
+		return
+	}
+
+	h.logAdminAudit(r.Context(), "refresh_link_metadata", uuid.Nil, map[string]interface{}{
+		"link_id": linkID.String(),
+	})
+	observability.RecordAdminAction(r.Context(), "refresh_link_metadata")
+
+	response := models.RefreshLinkMetadataResponse{
+		LinkID:  linkID,
+		Message: "Link metadata refresh queued",
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		observability.LogError(r.Context(), observability.ErrorLog{
+			Message:    "failed to encode refresh link metadata response",
+			Code:       "ENCODE_FAILED",
+			StatusCode: http.StatusAccepted,
+			Err:        err,
+		})
+	}
+}
+
+// GetMetadataStatus reports link metadata queue depth and worker health (admin only)
+func (h *AdminHandler) GetMetadataStatus(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(r.Context(), w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "Only GET requests are allowed")
+		return
+	}
+
+	if h.redis == nil {
+		writeError(r.Context(), w, http.StatusServiceUnavailable, "METADATA_QUEUE_UNAVAILABLE", "Metadata queue is not available")
+		return
+	}
+
+	status, err := services.GetMetadataQueueStatus(r.Context(), h.redis)
+	if err != nil {
+		writeError(r.Context(), w, http.StatusInternalServerError, "GET_METADATA_STATUS_FAILED", "Failed to get metadata queue status")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(status); err != nil {
+		observability.LogError(r.Context(), observability.ErrorLog{
+			Message:    "failed to encode metadata status response",
+			Code:       "ENCODE_FAILED",
+			StatusCode: http.StatusOK,
+			Err:        err,
+		})
+	}
+}
+
 // HardDeleteComment permanently deletes a comment (admin only)
 func (h *AdminHandler) HardDeleteComment(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodDelete {
@@ -779,11 +865,19 @@ func (h *AdminHandler) AdminRestoreComment(w http.ResponseWriter, r *http.Reques
 
 // UpdateConfigRequest represents the request body for updating config
 type UpdateConfigRequest struct {
-	LinkMetadataEnabled *bool   `json:"linkMetadataEnabled"`
-	MFARequired         *bool   `json:"mfa_required"`
-	MFARequiredAlt      *bool   `json:"mfaRequired"`
-	DisplayTimezone     *string `json:"display_timezone"`
-	DisplayTimezoneAlt  *string `json:"displayTimezone"`
+	LinkMetadataEnabled  *bool                `json:"linkMetadataEnabled"`
+	MFARequired          *bool                `json:"mfa_required"`
+	MFARequiredAlt       *bool                `json:"mfaRequired"`
+	DisplayTimezone      *string              `json:"display_timezone"`
+	DisplayTimezoneAlt   *string              `json:"displayTimezone"`
+	AllowedImageHosts    *[]string            `json:"allowed_image_hosts"`
+	AllowedImageHostsAlt *[]string            `json:"allowedImageHosts"`
+	MaintenanceMode      *bool                `json:"maintenance_mode"`
+	MaintenanceModeAlt   *bool                `json:"maintenanceMode"`
+	AllowedReactions     *[]string            `json:"allowed_reactions"`
+	AllowedReactionsAlt  *[]string            `json:"allowedReactions"`
+	SectionReactions     *map[string][]string `json:"section_reactions"`
+	SectionReactionsAlt  *map[string][]string `json:"sectionReactions"`
 }
 
 // ConfigResponse wraps the config in a response object per API spec
@@ -846,14 +940,65 @@ func (h *AdminHandler) UpdateConfig(w http.ResponseWriter, r *http.Request) {
 			writeError(r.Context(), w, http.StatusBadRequest, "INVALID_REQUEST", "Display timezone is required")
 			return
 		}
-		if _, err := time.LoadLocation(trimmed); err != nil {
+		if !services.ValidateTimezone(trimmed) {
 			writeError(r.Context(), w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid display timezone")
 			return
 		}
 		displayTimezone = &trimmed
 	}
 
-	config, err := configService.UpdateConfig(r.Context(), req.LinkMetadataEnabled, mfaRequired, displayTimezone)
+	allowedImageHosts := req.AllowedImageHosts
+	if allowedImageHosts == nil {
+		allowedImageHosts = req.AllowedImageHostsAlt
+	}
+	if allowedImageHosts != nil {
+		normalized, err := services.NormalizeAllowedImageHosts(*allowedImageHosts)
+		if err != nil {
+			writeError(r.Context(), w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid allowed image host")
+			return
+		}
+		allowedImageHosts = &normalized
+	}
+
+	maintenanceMode := req.MaintenanceMode
+	if maintenanceMode == nil {
+		maintenanceMode = req.MaintenanceModeAlt
+	}
+
+	allowedReactions := req.AllowedReactions
+	if allowedReactions == nil {
+		allowedReactions = req.AllowedReactionsAlt
+	}
+	if allowedReactions != nil {
+		normalized, err := services.NormalizeReactionSet(*allowedReactions)
+		if err != nil {
+			writeError(r.Context(), w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid allowed reaction")
+			return
+		}
+		allowedReactions = &normalized
+	}
+	sectionReactions := req.SectionReactions
+	if sectionReactions == nil {
+		sectionReactions = req.SectionReactionsAlt
+	}
+	if sectionReactions != nil {
+		normalized, err := services.NormalizeSectionReactions(*sectionReactions)
+		if err != nil {
+			writeError(r.Context(), w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid section reactions")
+			return
+		}
+		sectionReactions = &normalized
+	}
+
+	config, err := configService.UpdateConfig(r.Context(), services.ConfigUpdate{
+		LinkMetadataEnabled: req.LinkMetadataEnabled,
+		MFARequired:         mfaRequired,
+		DisplayTimezone:     displayTimezone,
+		AllowedImageHosts:   allowedImageHosts,
+		MaintenanceMode:     maintenanceMode,
+		AllowedReactions:    allowedReactions,
+		SectionReactions:    sectionReactions,
+	})
 	if err != nil {
 		writeError(r.Context(), w, http.StatusInternalServerError, "CONFIG_UPDATE_FAILED", "Failed to update config")
 		return
@@ -883,6 +1028,38 @@ func (h *AdminHandler) UpdateConfig(w http.ResponseWriter, r *http.Request) {
 		})
 		observability.RecordAdminAction(r.Context(), "update_display_timezone")
 	}
+	if allowedImageHosts != nil && strings.Join(previousConfig.AllowedImageHosts, ",") != strings.Join(config.AllowedImageHosts, ",") {
+		h.logAdminAudit(r.Context(), "update_allowed_image_hosts", uuid.Nil, map[string]interface{}{
+			"setting":   "allowed_image_hosts",
+			"old_value": previousConfig.AllowedImageHosts,
+			"new_value": config.AllowedImageHosts,
+		})
+		observability.RecordAdminAction(r.Context(), "update_allowed_image_hosts")
+	}
+	if maintenanceMode != nil && previousConfig.MaintenanceMode != config.MaintenanceMode {
+		h.logAdminAudit(r.Context(), "toggle_maintenance_mode", uuid.Nil, map[string]interface{}{
+			"setting":   "maintenance_mode",
+			"old_value": previousConfig.MaintenanceMode,
+			"new_value": config.MaintenanceMode,
+		})
+		observability.RecordAdminAction(r.Context(), "toggle_maintenance_mode")
+	}
+	if allowedReactions != nil && strings.Join(previousConfig.AllowedReactions, ",") != strings.Join(config.AllowedReactions, ",") {
+		h.logAdminAudit(r.Context(), "update_allowed_reactions", uuid.Nil, map[string]interface{}{
+			"setting":   "allowed_reactions",
+			"old_value": previousConfig.AllowedReactions,
+			"new_value": config.AllowedReactions,
+		})
+		observability.RecordAdminAction(r.Context(), "update_allowed_reactions")
+	}
+	if sectionReactions != nil && !reflect.DeepEqual(previousConfig.SectionReactions, config.SectionReactions) {
+		h.logAdminAudit(r.Context(), "update_section_reactions", uuid.Nil, map[string]interface{}{
+			"setting":   "section_reactions",
+			"old_value": previousConfig.SectionReactions,
+			"new_value": config.SectionReactions,
+		})
+		observability.RecordAdminAction(r.Context(), "update_section_reactions")
+	}
 
 	adminUserID, err := middleware.GetUserIDFromContext(r.Context())
 	if err != nil {
@@ -893,6 +1070,9 @@ func (h *AdminHandler) UpdateConfig(w http.ResponseWriter, r *http.Request) {
 		"link_metadata_enabled", strconv.FormatBool(config.LinkMetadataEnabled),
 		"mfa_required", strconv.FormatBool(config.MFARequired),
 		"display_timezone", config.DisplayTimezone,
+		"allowed_image_hosts", strings.Join(config.AllowedImageHosts, ","),
+		"maintenance_mode", strconv.FormatBool(config.MaintenanceMode),
+		"allowed_reactions", strings.Join(config.AllowedReactions, ","),
 	)
 
 	w.Header().Set("Content-Type", "application/json")
@@ -1158,15 +1158,17 @@ func TestUpdateConfig(t *testing.T) {
 func TestUpdateConfigInvalidTimezone(t *testing.T) {
 	handler := NewAdminHandler(nil, nil)
 
-	body := `{"display_timezone": "Not/AZone"}`
-	req := httptest.NewRequest("PATCH", "/api/v1/admin/config", strings.NewReader(body))
-	req.Header.Set("Content-Type", "application/json")
-	w := httptest.NewRecorder()
+	for _, timezone := range []string{"Not/AZone", "Local"} {
+		body := `{"display_timezone": "` + timezone + `"}`
+		req := httptest.NewRequest("PATCH", "/api/v1/admin/config", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
 
-	handler.UpdateConfig(w, req)
+		handler.UpdateConfig(w, req)
 
-	if w.Code != http.StatusBadRequest {
-		t.Errorf("expected status %d, got %d. Body: %s", http.StatusBadRequest, w.Code, w.Body.String())
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: expected status %d, got %d. Body: %s", timezone, http.StatusBadRequest, w.Code, w.Body.String())
+		}
 	}
 }
 
@@ -1185,7 +1187,7 @@ func TestUpdateConfigAuditLog(t *testing.T) {
 	current := configService.GetConfig().LinkMetadataEnabled
 	t.Cleanup(func() {
 		restore := current
-		if _, err := configService.UpdateConfig(context.Background(), &restore, nil, nil); err != nil {
+		if _, err := configService.UpdateConfig(context.Background(), services.ConfigUpdate{LinkMetadataEnabled: &restore}); err != nil {
 			t.Fatalf("failed to restore link metadata config: %v", err)
 		}
 	})
@@ -1246,7 +1248,7 @@ func TestUpdateConfigAuditLogMFARequired(t *testing.T) {
 	current := configService.GetConfig().MFARequired
 	t.Cleanup(func() {
 		restore := current
-		if _, err := configService.UpdateConfig(context.Background(), nil, &restore, nil); err != nil {
+		if _, err := configService.UpdateConfig(context.Background(), services.ConfigUpdate{MFARequired: &restore}); err != nil {
 			t.Fatalf("failed to restore mfa_required config: %v", err)
 		}
 	})
@@ -1307,7 +1309,7 @@ func TestUpdateConfigAuditLogDisplayTimezone(t *testing.T) {
 	current := configService.GetConfig().DisplayTimezone
 	t.Cleanup(func() {
 		restore := current
-		if _, err := configService.UpdateConfig(context.Background(), nil, nil, &restore); err != nil {
+		if _, err := configService.UpdateConfig(context.Background(), services.ConfigUpdate{DisplayTimezone: &restore}); err != nil {
 			t.Fatalf("failed to restore display_timezone config: %v", err)
 		}
 	})
@@ -1956,3 +1958,53 @@ func TestAdminTOTPEnrollAndVerify(t *testing.T) {
 		t.Errorf("expected enable method 'totp', got %v", verifyMetadata["method"])
 	}
 }
+
+func TestGetMetadataStatusReportsQueueLength(t *testing.T) {
+	redisServer := miniredis.RunT(t)
+	redisClient := redis.NewClient(&redis.Options{Addr: redisServer.Addr()})
+	t.Cleanup(func() { _ = redisClient.Close() })
+
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		job := services.MetadataJob{
+			PostID:    uuid.New(),
+			LinkID:    uuid.New(),
+			URL:       "https://example.com/queued",
+			CreatedAt: time.Now(),
+		}
+		if err := services.EnqueueMetadataJob(ctx, redisClient, job); err != nil {
+			t.Fatalf("failed to enqueue metadata job: %v", err)
+		}
+	}
+	if err := services.DeadLetterMetadataJob(ctx, redisClient, services.MetadataJob{PostID: uuid.New(), LinkID: uuid.New(), URL: "https://example.com/failed"}); err != nil {
+		t.Fatalf("failed to dead-letter metadata job: %v", err)
+	}
+	processedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	if err := services.RecordMetadataJobSuccess(ctx, redisClient, processedAt); err != nil {
+		t.Fatalf("failed to record metadata success: %v", err)
+	}
+
+	handler := NewAdminHandler(nil, redisClient)
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/metadata/status", nil)
+	rr := httptest.NewRecorder()
+
+	handler.GetMetadataStatus(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d. Body: %s", http.StatusOK, rr.Code, rr.Body.String())
+	}
+
+	var status models.MetadataQueueStatus
+	if err := json.NewDecoder(rr.Body).Decode(&status); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if status.QueueLength != 3 {
+		t.Fatalf("expected queue length 3, got %d", status.QueueLength)
+	}
+	if status.DeadLetterLength != 1 {
+		t.Fatalf("expected dead letter length 1, got %d", status.DeadLetterLength)
+	}
+	if status.LastSuccessAt == nil || !status.LastSuccessAt.Equal(processedAt) {
+		t.Fatalf("expected last success at %s, got %v", processedAt, status.LastSuccessAt)
+	}
+}
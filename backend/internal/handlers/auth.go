@@ -228,6 +228,15 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 				UserAgent:  r.UserAgent(),
 			})
 			writeError(r.Context(), w, http.StatusForbidden, "USER_NOT_APPROVED", "Your account is awaiting admin approval.")
+		case errors.Is(err, services.ErrUserRejected):
+			observability.RecordAuthFailure(ctx, "rejected")
+			h.logAuthEvent(ctx, &models.AuthEventCreate{
+				Identifier: req.Username,
+				EventType:  "login_rejected",
+				IPAddress:  clientIP,
+				UserAgent:  r.UserAgent(),
+			})
+			writeError(r.Context(), w, http.StatusForbidden, "USER_REJECTED", "Your registration was rejected.")
 		case errors.Is(err, services.ErrUserSuspended):
 			observability.RecordAuthFailure(ctx, "suspended")
 			h.logAuthEvent(ctx, &models.AuthEventCreate{
@@ -420,6 +429,7 @@ func (h *AuthHandler) GetMe(w http.ResponseWriter, r *http.Request) {
 		Bio:               user.Bio,
 		IsAdmin:           user.IsAdmin,
 		TotpEnabled:       user.TotpEnabled,
+		ActivityPrivate:   user.ActivityPrivate,
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -162,12 +162,35 @@ func TestLoginSuspendedUser(t *testing.T) {
 	}
 }
 
+func TestLoginRejectedUser(t *testing.T) {
+	handler := &AuthHandler{
+		userService: &stubAuthUserService{loginErr: services.ErrUserRejected},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/login", strings.NewReader(`{"username":"TestUser","password":"Password123"}`))
+	w := httptest.NewRecorder()
+
+	handler.Login(w, req)
+
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("expected status 403, got %d", w.Code)
+	}
+
+	var resp models.ErrorResponse
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if resp.Code != "USER_REJECTED" {
+		t.Fatalf("expected USER_REJECTED code, got %s", resp.Code)
+	}
+}
+
 func TestLoginMFASetupRequired(t *testing.T) {
 	services.ResetConfigServiceForTests()
 	t.Cleanup(services.ResetConfigServiceForTests)
 
 	required := true
-	if _, err := services.GetConfigService().UpdateConfig(context.Background(), nil, &required, nil); err != nil {
+	if _, err := services.GetConfigService().UpdateConfig(context.Background(), services.ConfigUpdate{MFARequired: &required}); err != nil {
 		t.Fatalf("failed to enable mfa_required: %v", err)
 	}
 
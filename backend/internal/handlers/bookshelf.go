@@ -125,6 +125,38 @@ func (h *BookshelfHandler) ListCategories(w http.ResponseWriter, r *http.Request
 	}
 }
 
+// ListCategoryCounts handles GET /api/v1/me/bookshelf/categories.
+func (h *BookshelfHandler) ListCategoryCounts(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(r.Context(), w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "Only GET requests are allowed")
+		return
+	}
+
+	userID, err := middleware.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeError(r.Context(), w, http.StatusUnauthorized, "UNAUTHORIZED", "Missing or invalid user ID")
+		return
+	}
+
+	counts, err := h.bookshelfService.GetCategoryCounts(r.Context(), userID)
+	if err != nil {
+		writeError(r.Context(), w, http.StatusInternalServerError, "GET_BOOKSHELF_CATEGORY_COUNTS_FAILED", "Failed to get bookshelf category counts")
+		return
+	}
+
+	response := models.ListBookshelfCategoryCountsResponse{Categories: counts}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		observability.LogError(r.Context(), observability.ErrorLog{
+			Message:    "failed to encode bookshelf category counts response",
+			Code:       "ENCODE_FAILED",
+			StatusCode: http.StatusOK,
+			Err:        err,
+		})
+	}
+}
+
 // UpdateCategory handles PUT /api/v1/bookshelf/categories/{id}.
 func (h *BookshelfHandler) UpdateCategory(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPut {
@@ -345,6 +377,72 @@ func (h *BookshelfHandler) AddToBookshelf(w http.ResponseWriter, r *http.Request
 	}
 }
 
+// MoveBookshelfItem handles PATCH /api/v1/posts/{postId}/bookshelf/category.
+func (h *BookshelfHandler) MoveBookshelfItem(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPatch {
+		writeError(r.Context(), w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "Only PATCH requests are allowed")
+		return
+	}
+
+	userID, err := middleware.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeError(r.Context(), w, http.StatusUnauthorized, "UNAUTHORIZED", "Missing or invalid user ID")
+		return
+	}
+
+	postID, err := extractPostIDFromPath(r.URL.Path)
+	if err != nil {
+		writeError(r.Context(), w, http.StatusBadRequest, "INVALID_POST_ID", "Invalid post ID format")
+		return
+	}
+
+	var req models.MoveBookshelfItemRequest
+	if err := decodeJSONBody(w, r, &req); err != nil {
+		if isRequestBodyTooLarge(err) {
+			writeError(r.Context(), w, http.StatusRequestEntityTooLarge, "REQUEST_TOO_LARGE", "Request body too large")
+			return
+		}
+		writeError(r.Context(), w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+		return
+	}
+
+	item, err := h.bookshelfService.MoveBookshelfItem(r.Context(), userID, postID, req.FromCategory, req.ToCategory)
+	if err != nil {
+		switch err.Error() {
+		case "book post not found":
+			writeError(r.Context(), w, http.StatusNotFound, "POST_NOT_FOUND", "Post not found")
+		case "bookshelf item not found":
+			writeError(r.Context(), w, http.StatusNotFound, "BOOKSHELF_ITEM_NOT_FOUND", "Bookshelf item not found")
+		case "category not found":
+			writeError(r.Context(), w, http.StatusNotFound, "CATEGORY_NOT_FOUND", "Category not found")
+		default:
+			writeError(r.Context(), w, http.StatusInternalServerError, "BOOKSHELF_MOVE_FAILED", "Failed to move bookshelf item")
+		}
+		return
+	}
+
+	observability.LogInfo(r.Context(), "bookshelf item moved",
+		"user_id", userID.String(),
+		"post_id", postID.String(),
+	)
+
+	response := models.MoveBookshelfItemResponse{
+		Item: *item,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		observability.LogError(r.Context(), observability.ErrorLog{
+			Message:    "failed to encode move bookshelf item response",
+			Code:       "ENCODE_FAILED",
+			StatusCode: http.StatusOK,
+			UserID:     userID.String(),
+			Err:        err,
+		})
+	}
+}
+
 // RemoveFromBookshelf handles DELETE /api/v1/posts/{postId}/bookshelf.
 func (h *BookshelfHandler) RemoveFromBookshelf(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodDelete {
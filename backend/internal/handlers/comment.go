@@ -308,6 +308,53 @@ func (h *CommentHandler) GetComment(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// GetCommentContext handles GET /api/v1/comments/{id}/context
+func (h *CommentHandler) GetCommentContext(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(r.Context(), w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "Only GET requests are allowed")
+		return
+	}
+
+	// Extract comment ID from URL path: /api/v1/comments/{id}/context
+	pathParts := strings.Split(r.URL.Path, "/")
+	if len(pathParts) < 6 || pathParts[5] != "context" {
+		writeError(r.Context(), w, http.StatusBadRequest, "INVALID_REQUEST", "Comment ID is required")
+		return
+	}
+
+	commentID, err := uuid.Parse(pathParts[4])
+	if err != nil {
+		writeError(r.Context(), w, http.StatusBadRequest, "INVALID_COMMENT_ID", "Invalid comment ID format")
+		return
+	}
+
+	userID, _ := middleware.GetUserIDFromContext(r.Context())
+	threadContext, err := h.commentService.GetCommentThreadContext(r.Context(), commentID, userID)
+	if err != nil {
+		if err.Error() == "comment not found" {
+			writeError(r.Context(), w, http.StatusNotFound, "COMMENT_NOT_FOUND", "Comment not found")
+			return
+		}
+		writeError(r.Context(), w, http.StatusInternalServerError, "GET_COMMENT_CONTEXT_FAILED", "Failed to get comment context")
+		return
+	}
+
+	response := models.GetCommentContextResponse{
+		Context: *threadContext,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		observability.LogError(r.Context(), observability.ErrorLog{
+			Message:    "failed to encode comment context response",
+			Code:       "ENCODE_FAILED",
+			StatusCode: http.StatusOK,
+			Err:        err,
+		})
+	}
+}
+
 // GetThread handles GET /api/v1/posts/{postId}/comments
 func (h *CommentHandler) GetThread(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodGet {
@@ -346,8 +393,12 @@ func (h *CommentHandler) GetThread(w http.ResponseWriter, r *http.Request) {
 
 	// Get thread comments
 	userID, _ := middleware.GetUserIDFromContext(r.Context())
-	comments, nextCursor, hasMore, err := h.commentService.GetThreadComments(r.Context(), postID, limit, cursorPtr, userID)
+	comments, nextCursor, hasMore, truncated, sort, err := h.commentService.GetThreadComments(r.Context(), postID, limit, cursorPtr, r.URL.Query().Get("sort"), userID)
 	if err != nil {
+		if err.Error() == "invalid sort" {
+			writeError(r.Context(), w, http.StatusBadRequest, "INVALID_SORT", "Sort must be 'new' or 'top'")
+			return
+		}
 		if err.Error() == "post not found" {
 			writeError(r.Context(), w, http.StatusNotFound, "POST_NOT_FOUND", "Post not found")
 			return
@@ -366,7 +417,9 @@ func (h *CommentHandler) GetThread(w http.ResponseWriter, r *http.Request) {
 
 	// Return response
 	response := models.GetThreadResponse{
-		Comments: comments,
+		Comments:  comments,
+		Sort:      sort,
+		Truncated: truncated,
 		Meta: models.PageMeta{
 			Cursor:  nextCursor,
 			HasMore: hasMore,
@@ -498,7 +551,7 @@ func (h *CommentHandler) RestoreComment(w http.ResponseWriter, r *http.Request)
 		case "unauthorized":
 			writeError(r.Context(), w, http.StatusForbidden, "FORBIDDEN", "You do not have permission to restore this comment")
 		case "comment permanently deleted":
-			writeError(r.Context(), w, http.StatusGone, "COMMENT_PERMANENTLY_DELETED", "Comment was permanently deleted more than 7 days ago")
+			writeError(r.Context(), w, http.StatusGone, "COMMENT_PERMANENTLY_DELETED", "Comment can no longer be restored")
 		default:
 			writeError(r.Context(), w, http.StatusInternalServerError, "RESTORE_FAILED", "Failed to restore comment")
 		}
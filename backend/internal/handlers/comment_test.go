@@ -391,6 +391,111 @@ func TestGetCommentHandlerInvalidID(t *testing.T) {
 	}
 }
 
+func TestGetCommentHandlerIncludesReactionsAndAuthor(t *testing.T) {
+	db, mock, err := setupMockDB(t)
+	if err != nil {
+		t.Fatalf("failed to setup mock db: %v", err)
+	}
+	defer db.Close()
+
+	handler := NewCommentHandler(db, nil, nil)
+	authorID := uuid.New()
+	viewerID := uuid.New()
+	commentID := uuid.New()
+	postID := uuid.New()
+	sectionID := uuid.New()
+	now := time.Now()
+	bio := "Always reading"
+
+	rows := mock.NewRows([]string{
+		"id", "user_id", "post_id", "section_id", "parent_comment_id", "image_id", "timestamp_seconds", "content", "contains_spoiler",
+		"created_at", "updated_at", "deleted_at", "deleted_by_user_id",
+		"id", "username", "email", "profile_picture_url", "bio", "is_admin", "created_at",
+	}).AddRow(
+		commentID, authorID, postID, sectionID, nil, nil, nil, "Deep-linked comment", false,
+		now, nil, nil, nil,
+		authorID, "author", "author@example.com", nil, bio, false, now,
+	)
+	mock.ExpectQuery("SELECT").WithArgs(commentID).WillReturnRows(rows)
+	mock.ExpectQuery("SELECT id, url, metadata, created_at").WithArgs(commentID).
+		WillReturnRows(mock.NewRows([]string{"id", "url", "metadata", "created_at"}))
+	mock.ExpectQuery("SELECT comment_id, emoji, COUNT").WithArgs(sqlmock.AnyArg(), viewerID).
+		WillReturnRows(mock.NewRows([]string{"comment_id", "emoji", "count", "viewer_reacted"}).
+			AddRow(commentID, "👍", 3, true).
+			AddRow(commentID, "🎉", 1, false))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/comments/"+commentID.String(), nil)
+	req = req.WithContext(createTestUserContext(req.Context(), viewerID, "viewer", false))
+	rr := httptest.NewRecorder()
+	handler.GetComment(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, rr.Code)
+	}
+
+	var response models.GetCommentResponse
+	if err := json.NewDecoder(rr.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	comment := response.Comment
+	if comment == nil || comment.ID != commentID {
+		t.Fatalf("expected comment %s in response", commentID)
+	}
+	if comment.User == nil || comment.User.ID != authorID || comment.User.Username != "author" {
+		t.Fatalf("expected author details in response, got %+v", comment.User)
+	}
+	if comment.User.Bio == nil || *comment.User.Bio != bio {
+		t.Fatalf("expected author bio %q", bio)
+	}
+	if comment.ReactionCounts["👍"] != 3 || comment.ReactionCounts["🎉"] != 1 {
+		t.Fatalf("unexpected reaction counts: %v", comment.ReactionCounts)
+	}
+	if len(comment.ViewerReactions) != 1 || comment.ViewerReactions[0] != "👍" {
+		t.Fatalf("unexpected viewer reactions: %v", comment.ViewerReactions)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestGetCommentHandlerDeletedCommentNotFound(t *testing.T) {
+	db, mock, err := setupMockDB(t)
+	if err != nil {
+		t.Fatalf("failed to setup mock db: %v", err)
+	}
+	defer db.Close()
+
+	handler := NewCommentHandler(db, nil, nil)
+	viewerID := uuid.New()
+	commentID := uuid.New()
+
+	// Deleted comments are filtered out by the lookup, so no row comes back.
+	mock.ExpectQuery("c.deleted_at IS NULL").WithArgs(commentID).
+		WillReturnRows(mock.NewRows([]string{"id"}))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/comments/"+commentID.String(), nil)
+	req = req.WithContext(createTestUserContext(req.Context(), viewerID, "viewer", false))
+	rr := httptest.NewRecorder()
+	handler.GetComment(rr, req)
+
+	if rr.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, rr.Code)
+	}
+
+	var errResp models.ErrorResponse
+	if err := json.NewDecoder(rr.Body).Decode(&errResp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if errResp.Code != "COMMENT_NOT_FOUND" {
+		t.Fatalf("expected COMMENT_NOT_FOUND, got %s", errResp.Code)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unfulfilled expectations: %v", err)
+	}
+}
+
 func TestDeleteCommentHandlerMethodNotAllowed(t *testing.T) {
 	handler := &CommentHandler{}
 
@@ -466,7 +571,7 @@ func TestUpdateCommentSuccess(t *testing.T) {
 	mock.ExpectQuery("SELECT c.user_id, c.content, c.contains_spoiler, c.post_id, p.section_id, s.type").WithArgs(commentID).
 		WillReturnRows(sqlmock.NewRows([]string{"user_id", "content", "contains_spoiler", "post_id", "section_id", "type"}).AddRow(userID, "Original comment", false, postID, sectionID, "general"))
 	mock.ExpectBegin()
-	mock.ExpectExec("UPDATE comments").WithArgs("Updated comment", true, commentID).
+	mock.ExpectExec("UPDATE comments").WithArgs("Updated comment", true, commentID, services.GetConfigService().GetSettings().EditGracePeriod.Seconds()).
 		WillReturnResult(sqlmock.NewResult(0, 1))
 	mock.ExpectExec("INSERT INTO audit_logs").WithArgs(userID, "update_comment", userID, userID, sqlmock.AnyArg()).
 		WillReturnResult(sqlmock.NewResult(0, 1))
@@ -486,11 +591,8 @@ func TestUpdateCommentSuccess(t *testing.T) {
 	linksRows := mock.NewRows([]string{"id", "url", "metadata", "created_at"})
 	mock.ExpectQuery("SELECT id, url, metadata, created_at").WithArgs(commentID).WillReturnRows(linksRows)
 
-	reactionRows := mock.NewRows([]string{"emoji", "count"})
-	mock.ExpectQuery("SELECT emoji, COUNT").WithArgs(commentID).WillReturnRows(reactionRows)
-
-	viewerRows := mock.NewRows([]string{"emoji"})
-	mock.ExpectQuery("SELECT emoji").WithArgs(commentID, userID).WillReturnRows(viewerRows)
+	reactionRows := mock.NewRows([]string{"comment_id", "emoji", "count", "viewer_reacted"})
+	mock.ExpectQuery("SELECT comment_id, emoji, COUNT").WithArgs(sqlmock.AnyArg(), userID).WillReturnRows(reactionRows)
 
 	req, err := http.NewRequest(http.MethodPatch, "/api/v1/comments/"+commentID.String(), bytes.NewReader(body))
 	if err != nil {
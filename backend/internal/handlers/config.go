@@ -3,6 +3,7 @@ package handlers
 import (
 	"encoding/json"
 	"net/http"
+	"strings"
 
 	"github.com/sanderginn/clubhouse/internal/observability"
 	"github.com/sanderginn/clubhouse/internal/services"
@@ -51,3 +52,67 @@ func (h *ConfigHandler) GetPublicConfig(w http.ResponseWriter, r *http.Request)
 		})
 	}
 }
+
+// TimezonesResponse lists the timezones clients can offer to users.
+type TimezonesResponse struct {
+	Timezones []string `json:"timezones"`
+}
+
+// TimezoneValidationResponse reports whether the server accepts a timezone.
+type TimezoneValidationResponse struct {
+	Timezone string `json:"timezone"`
+	Valid    bool   `json:"valid"`
+}
+
+// ListTimezones handles GET /api/v1/timezones
+func (h *ConfigHandler) ListTimezones(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(r.Context(), w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "Only GET requests are allowed")
+		return
+	}
+
+	response := TimezonesResponse{
+		Timezones: services.SupportedTimezones(),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		observability.LogError(r.Context(), observability.ErrorLog{
+			Message:    "failed to encode timezones response",
+			Code:       "ENCODE_FAILED",
+			StatusCode: http.StatusOK,
+			Err:        err,
+		})
+	}
+}
+
+// ValidateTimezone handles GET /api/v1/timezones/validate?timezone={name}
+func (h *ConfigHandler) ValidateTimezone(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(r.Context(), w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "Only GET requests are allowed")
+		return
+	}
+
+	timezone := strings.TrimSpace(r.URL.Query().Get("timezone"))
+	if timezone == "" {
+		writeError(r.Context(), w, http.StatusBadRequest, "TIMEZONE_REQUIRED", "timezone query parameter is required")
+		return
+	}
+
+	response := TimezoneValidationResponse{
+		Timezone: timezone,
+		Valid:    services.ValidateTimezone(timezone),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		observability.LogError(r.Context(), observability.ErrorLog{
+			Message:    "failed to encode timezone validation response",
+			Code:       "ENCODE_FAILED",
+			StatusCode: http.StatusOK,
+			Err:        err,
+		})
+	}
+}
@@ -16,7 +16,7 @@ func TestGetPublicConfig(t *testing.T) {
 	t.Cleanup(func() { services.ResetConfigServiceForTests() })
 
 	timezone := "America/Los_Angeles"
-	if _, err := configService.UpdateConfig(context.Background(), nil, nil, &timezone); err != nil {
+	if _, err := configService.UpdateConfig(context.Background(), services.ConfigUpdate{DisplayTimezone: &timezone}); err != nil {
 		t.Fatalf("failed to set display timezone: %v", err)
 	}
 
@@ -43,3 +43,70 @@ func TestGetPublicConfig(t *testing.T) {
 		t.Fatalf("expected displayTimezone %s, got %s", timezone, response.Config.DisplayTimezone)
 	}
 }
+
+func TestListTimezonesIncludesCommonZones(t *testing.T) {
+	handler := NewConfigHandler()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/timezones", nil)
+	w := httptest.NewRecorder()
+
+	handler.ListTimezones(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d. Body: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+
+	var response TimezonesResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	zones := make(map[string]bool, len(response.Timezones))
+	for _, zone := range response.Timezones {
+		zones[zone] = true
+	}
+	for _, zone := range []string{"UTC", "Europe/Amsterdam", "America/New_York", "America/Los_Angeles", "Asia/Tokyo", "Australia/Sydney"} {
+		if !zones[zone] {
+			t.Errorf("expected timezones to include %s", zone)
+		}
+	}
+}
+
+func TestValidateTimezone(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		wantStatus int
+		wantValid  bool
+	}{
+		{name: "valid zone", query: "?timezone=Europe/Amsterdam", wantStatus: http.StatusOK, wantValid: true},
+		{name: "valid alias", query: "?timezone=US/Pacific", wantStatus: http.StatusOK, wantValid: true},
+		{name: "invalid zone", query: "?timezone=Mars/Olympus_Mons", wantStatus: http.StatusOK, wantValid: false},
+		{name: "local is rejected", query: "?timezone=Local", wantStatus: http.StatusOK, wantValid: false},
+		{name: "missing zone", query: "", wantStatus: http.StatusBadRequest},
+	}
+
+	handler := NewConfigHandler()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/timezones/validate"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			handler.ValidateTimezone(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d. Body: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+
+			var response TimezoneValidationResponse
+			if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if response.Valid != tt.wantValid {
+				t.Fatalf("expected valid=%v for %q, got %v", tt.wantValid, response.Timezone, response.Valid)
+			}
+		})
+	}
+}
@@ -3,9 +3,12 @@ package handlers
 import (
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"strconv"
+	"strings"
 
+	"github.com/google/uuid"
 	"github.com/redis/go-redis/v9"
 	"github.com/sanderginn/clubhouse/internal/middleware"
 	"github.com/sanderginn/clubhouse/internal/models"
@@ -62,6 +65,10 @@ func (h *CookLogHandler) LogCook(w http.ResponseWriter, r *http.Request) {
 
 	cookLog, err := h.cookLogService.LogCook(r.Context(), userID, postID, req.Rating, req.Notes)
 	if err != nil {
+		if errors.Is(err, services.ErrLogNoteTooLong) {
+			writeError(r.Context(), w, http.StatusBadRequest, "NOTES_TOO_LONG", err.Error())
+			return
+		}
 		switch err.Error() {
 		case "rating must be between 1 and 5":
 			writeError(r.Context(), w, http.StatusBadRequest, "INVALID_RATING", err.Error())
@@ -158,6 +165,10 @@ func (h *CookLogHandler) UpdateCookLog(w http.ResponseWriter, r *http.Request) {
 
 	cookLog, err := h.cookLogService.UpdateCookLog(r.Context(), userID, postID, *req.Rating, req.Notes)
 	if err != nil {
+		if errors.Is(err, services.ErrLogNoteTooLong) {
+			writeError(r.Context(), w, http.StatusBadRequest, "NOTES_TOO_LONG", err.Error())
+			return
+		}
 		switch err.Error() {
 		case "rating must be between 1 and 5":
 			writeError(r.Context(), w, http.StatusBadRequest, "INVALID_RATING", err.Error())
@@ -307,6 +318,52 @@ func (h *CookLogHandler) GetMyCookLogs(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	h.writeUserCookLogs(w, r, userID)
+}
+
+// GetUserCookLogs handles GET /api/v1/users/{id}/cook-logs.
+func (h *CookLogHandler) GetUserCookLogs(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(r.Context(), w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "Only GET requests are allowed")
+		return
+	}
+
+	viewerID, err := middleware.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeError(r.Context(), w, http.StatusUnauthorized, "UNAUTHORIZED", "Missing or invalid user ID")
+		return
+	}
+
+	targetUserID, err := extractCookLogUserIDFromPath(r.URL.Path)
+	if err != nil {
+		writeError(r.Context(), w, http.StatusBadRequest, "INVALID_USER_ID", "Invalid user ID format")
+		return
+	}
+
+	if targetUserID != viewerID {
+		activityPrivate, err := h.userService.IsActivityPrivate(r.Context(), targetUserID)
+		if err != nil {
+			switch err.Error() {
+			case "user not found":
+				writeError(r.Context(), w, http.StatusNotFound, "USER_NOT_FOUND", err.Error())
+			default:
+				writeError(r.Context(), w, http.StatusInternalServerError, "GET_COOK_LOGS_FAILED", "Failed to get cook logs")
+			}
+			return
+		}
+		if activityPrivate {
+			writeCookLogsResponse(w, r, models.ListCookLogsResponse{
+				CookLogs: []models.CookLogWithPost{},
+				Meta:     models.PageMeta{},
+			})
+			return
+		}
+	}
+
+	h.writeUserCookLogs(w, r, targetUserID)
+}
+
+func (h *CookLogHandler) writeUserCookLogs(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
 	limit := 20
 	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
 		if parsed, err := strconv.Atoi(limitStr); err == nil && parsed > 0 {
@@ -334,14 +391,16 @@ func (h *CookLogHandler) GetMyCookLogs(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	response := models.ListCookLogsResponse{
+	writeCookLogsResponse(w, r, models.ListCookLogsResponse{
 		CookLogs: logs,
 		Meta: models.PageMeta{
 			Cursor:  nextCursor,
 			HasMore: hasMore,
 		},
-	}
+	})
+}
 
+func writeCookLogsResponse(w http.ResponseWriter, r *http.Request, response models.ListCookLogsResponse) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	if err := json.NewEncoder(w).Encode(response); err != nil {
@@ -353,3 +412,14 @@ func (h *CookLogHandler) GetMyCookLogs(w http.ResponseWriter, r *http.Request) {
 		})
 	}
 }
+
+func extractCookLogUserIDFromPath(path string) (uuid.UUID, error) {
+	pathParts := strings.Split(path, "/")
+	for i, part := range pathParts {
+		if part == "users" && i+1 < len(pathParts) {
+			return uuid.Parse(pathParts[i+1])
+		}
+	}
+
+	return uuid.Nil, errors.New("user ID not found in path")
+}
@@ -344,3 +344,126 @@ func TestCookLogHandlerGetMyCookLogs(t *testing.T) {
 		t.Fatalf("expected has_more to be false")
 	}
 }
+
+func TestCookLogHandlerGetUserCookLogs(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	cookID := testutil.CreateTestUser(t, db, "cooklogowner", "cooklogowner@test.com", false, true)
+	viewerID := testutil.CreateTestUser(t, db, "cooklogviewer", "cooklogviewer@test.com", false, true)
+	sectionID := testutil.CreateTestSection(t, db, "Recipe Section", "recipe")
+	postID := testutil.CreateTestPost(t, db, viewerID, sectionID, "Visible recipe")
+	deletedPostID := testutil.CreateTestPost(t, db, viewerID, sectionID, "Deleted recipe")
+
+	_, err := db.Exec(`
+		INSERT INTO cook_logs (id, user_id, post_id, rating, created_at)
+		VALUES ($1, $2, $3, $4, now()), ($5, $6, $7, $8, now())
+	`,
+		uuid.New(), cookID, postID, 5,
+		uuid.New(), cookID, deletedPostID, 3,
+	)
+	if err != nil {
+		t.Fatalf("failed to create cook logs: %v", err)
+	}
+	if _, err := db.Exec(`UPDATE posts SET deleted_at = now() WHERE id = $1`, deletedPostID); err != nil {
+		t.Fatalf("failed to delete post: %v", err)
+	}
+
+	handler := NewCookLogHandler(db, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/users/"+cookID+"/cook-logs", nil)
+	req = req.WithContext(createTestUserContext(req.Context(), uuid.MustParse(viewerID), "cooklogviewer", false))
+	w := httptest.NewRecorder()
+
+	handler.GetUserCookLogs(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d. Body: %s", w.Code, w.Body.String())
+	}
+
+	var response models.ListCookLogsResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if len(response.CookLogs) != 1 {
+		t.Fatalf("expected 1 cook log, got %d", len(response.CookLogs))
+	}
+	if response.CookLogs[0].PostID != uuid.MustParse(postID) {
+		t.Fatalf("expected cook log for post %s, got %s", postID, response.CookLogs[0].PostID)
+	}
+	if response.CookLogs[0].UserID != uuid.MustParse(cookID) {
+		t.Fatalf("expected cook log for user %s, got %s", cookID, response.CookLogs[0].UserID)
+	}
+}
+
+func TestCookLogHandlerGetUserCookLogsInvalidUserID(t *testing.T) {
+	handler := &CookLogHandler{}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/users/not-a-uuid/cook-logs", nil)
+	req = req.WithContext(createTestUserContext(req.Context(), uuid.New(), "cooklogviewer", false))
+	w := httptest.NewRecorder()
+
+	handler.GetUserCookLogs(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d. Body: %s", w.Code, w.Body.String())
+	}
+}
+
+func TestCookLogHandlerGetUserCookLogsHidesPrivateUser(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	cookID := testutil.CreateTestUser(t, db, "cooklogprivate", "cooklogprivate@test.com", false, true)
+	viewerID := testutil.CreateTestUser(t, db, "cooklogprivateviewer", "cooklogprivateviewer@test.com", false, true)
+	sectionID := testutil.CreateTestSection(t, db, "Recipe Section", "recipe")
+	postID := testutil.CreateTestPost(t, db, viewerID, sectionID, "Private recipe")
+
+	if _, err := db.Exec(`UPDATE users SET activity_private = true WHERE id = $1`, cookID); err != nil {
+		t.Fatalf("failed to mark user private: %v", err)
+	}
+	if _, err := db.Exec(`
+		INSERT INTO cook_logs (id, user_id, post_id, rating, created_at)
+		VALUES ($1, $2, $3, $4, now())
+	`, uuid.New(), cookID, postID, 4); err != nil {
+		t.Fatalf("failed to create cook log: %v", err)
+	}
+
+	handler := NewCookLogHandler(db, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/users/"+cookID+"/cook-logs", nil)
+	req = req.WithContext(createTestUserContext(req.Context(), uuid.MustParse(viewerID), "cooklogprivateviewer", false))
+	w := httptest.NewRecorder()
+
+	handler.GetUserCookLogs(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d. Body: %s", w.Code, w.Body.String())
+	}
+
+	var response models.ListCookLogsResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(response.CookLogs) != 0 {
+		t.Fatalf("expected private cook logs to be hidden, got %d", len(response.CookLogs))
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/users/"+cookID+"/cook-logs", nil)
+	req = req.WithContext(createTestUserContext(req.Context(), uuid.MustParse(cookID), "cooklogprivate", false))
+	w = httptest.NewRecorder()
+
+	handler.GetUserCookLogs(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d. Body: %s", w.Code, w.Body.String())
+	}
+	response = models.ListCookLogsResponse{}
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(response.CookLogs) != 1 {
+		t.Fatalf("expected owner to see their own cook log, got %d", len(response.CookLogs))
+	}
+}
@@ -192,12 +192,12 @@ func TestParseRecipeNotFound(t *testing.T) {
 func TestPreviewLinkDisabled(t *testing.T) {
 	configService := services.GetConfigService()
 	disabled := false
-	if _, err := configService.UpdateConfig(context.Background(), &disabled, nil, nil); err != nil {
+	if _, err := configService.UpdateConfig(context.Background(), services.ConfigUpdate{LinkMetadataEnabled: &disabled}); err != nil {
 		t.Fatalf("failed to disable link metadata: %v", err)
 	}
 	defer func() {
 		enabled := true
-		if _, err := configService.UpdateConfig(context.Background(), &enabled, nil, nil); err != nil {
+		if _, err := configService.UpdateConfig(context.Background(), services.ConfigUpdate{LinkMetadataEnabled: &enabled}); err != nil {
 			t.Fatalf("failed to re-enable link metadata: %v", err)
 		}
 	}()
@@ -246,7 +246,7 @@ func TestPreviewLinkInvalidBody(t *testing.T) {
 
 func TestPreviewLinkRequestTooLarge(t *testing.T) {
 	enabled := true
-	if _, err := services.GetConfigService().UpdateConfig(context.Background(), &enabled, nil, nil); err != nil {
+	if _, err := services.GetConfigService().UpdateConfig(context.Background(), services.ConfigUpdate{LinkMetadataEnabled: &enabled}); err != nil {
 		t.Fatalf("failed to enable link metadata: %v", err)
 	}
 
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/redis/go-redis/v9"
@@ -93,6 +94,52 @@ func (h *NotificationHandler) GetNotifications(w http.ResponseWriter, r *http.Re
 	}
 }
 
+// GetNotificationDigest handles GET /api/v1/notifications/digest.
+func (h *NotificationHandler) GetNotificationDigest(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(r.Context(), w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "Only GET requests are allowed")
+		return
+	}
+
+	userID, err := middleware.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeError(r.Context(), w, http.StatusUnauthorized, "UNAUTHORIZED", "Missing or invalid user ID")
+		return
+	}
+
+	var window time.Duration
+	switch r.URL.Query().Get("window") {
+	case "", "daily":
+		window = services.DefaultDigestWindow
+	case "weekly":
+		window = 7 * 24 * time.Hour
+	default:
+		writeError(r.Context(), w, http.StatusBadRequest, "INVALID_WINDOW", "window must be daily or weekly")
+		return
+	}
+
+	digest, err := h.notificationService.BuildDigest(r.Context(), userID, window)
+	if err != nil {
+		writeError(r.Context(), w, http.StatusInternalServerError, "GET_DIGEST_FAILED", "Failed to build notification digest")
+		return
+	}
+
+	response := models.GetNotificationDigestResponse{
+		Digest: *digest,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		observability.LogError(r.Context(), observability.ErrorLog{
+			Message:    "failed to encode notification digest response",
+			Code:       "ENCODE_FAILED",
+			StatusCode: http.StatusOK,
+			Err:        err,
+		})
+	}
+}
+
 // MarkNotificationRead handles PATCH /api/v1/notifications/{id}.
 func (h *NotificationHandler) MarkNotificationRead(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPatch {
@@ -150,6 +197,71 @@ func (h *NotificationHandler) MarkNotificationRead(w http.ResponseWriter, r *htt
 	}
 }
 
+// GetNotificationStates handles POST /api/v1/notifications/states.
+func (h *NotificationHandler) GetNotificationStates(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(r.Context(), w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "Only POST requests are allowed")
+		return
+	}
+
+	userID, err := middleware.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeError(r.Context(), w, http.StatusUnauthorized, "UNAUTHORIZED", "Missing or invalid user ID")
+		return
+	}
+
+	var req models.GetNotificationStatesRequest
+	if err := decodeJSONBody(w, r, &req); err != nil {
+		if isRequestBodyTooLarge(err) {
+			writeError(r.Context(), w, http.StatusRequestEntityTooLarge, "REQUEST_TOO_LARGE", "Request body too large")
+			return
+		}
+		writeError(r.Context(), w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+		return
+	}
+
+	notificationIDs := make([]uuid.UUID, 0, len(req.NotificationIDs))
+	seen := make(map[uuid.UUID]struct{}, len(req.NotificationIDs))
+	for _, rawID := range req.NotificationIDs {
+		notificationID, err := uuid.Parse(strings.TrimSpace(rawID))
+		if err != nil {
+			writeError(r.Context(), w, http.StatusBadRequest, "INVALID_NOTIFICATION_ID", "Invalid notification ID format")
+			return
+		}
+		if _, ok := seen[notificationID]; ok {
+			continue
+		}
+		seen[notificationID] = struct{}{}
+		notificationIDs = append(notificationIDs, notificationID)
+	}
+
+	states, err := h.notificationService.GetNotificationReadStates(r.Context(), userID, notificationIDs)
+	if err != nil {
+		switch err.Error() {
+		case "too many notification ids":
+			writeError(r.Context(), w, http.StatusBadRequest, "TOO_MANY_NOTIFICATION_IDS", "Too many notification IDs (maximum 100)")
+		default:
+			writeError(r.Context(), w, http.StatusInternalServerError, "GET_NOTIFICATION_STATES_FAILED", "Failed to get notification states")
+		}
+		return
+	}
+
+	response := models.GetNotificationStatesResponse{
+		States: states,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		observability.LogError(r.Context(), observability.ErrorLog{
+			Message:    "failed to encode notification states response",
+			Code:       "ENCODE_FAILED",
+			StatusCode: http.StatusOK,
+			Err:        err,
+		})
+	}
+}
+
 // MarkAllNotificationsRead handles PATCH /api/v1/notifications/read.
 func (h *NotificationHandler) MarkAllNotificationsRead(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPatch {
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
 	"time"
 
@@ -112,6 +113,110 @@ func TestGetNotificationsSuccessPagination(t *testing.T) {
 	}
 }
 
+func TestGetNotificationDigestAggregatesWindow(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	userID := uuid.MustParse(testutil.CreateTestUser(t, db, "digestuser", "digestuser@test.com", false, true))
+	authorID := testutil.CreateTestUser(t, db, "digestauthor", "digestauthor@test.com", false, true)
+	subscribedSectionID := testutil.CreateTestSection(t, db, "Digest Subscribed", "general")
+	mutedSectionID := testutil.CreateTestSection(t, db, "Digest Muted", "general")
+
+	if _, err := db.Exec(`
+		INSERT INTO section_subscriptions (user_id, section_id, opted_out_at)
+		VALUES ($1, $2, now())
+	`, userID, mutedSectionID); err != nil {
+		t.Fatalf("failed to opt out of section: %v", err)
+	}
+
+	now := time.Now().UTC()
+	recentPostID := testutil.CreateTestPost(t, db, authorID, subscribedSectionID, "Fresh post")
+	oldPostID := testutil.CreateTestPost(t, db, authorID, subscribedSectionID, "Old post")
+	testutil.CreateTestPost(t, db, authorID, mutedSectionID, "Muted post")
+	testutil.CreateTestPost(t, db, userID.String(), subscribedSectionID, "Own post")
+	if _, err := db.Exec(`UPDATE posts SET created_at = $1 WHERE id = $2`, now.Add(-72*time.Hour), oldPostID); err != nil {
+		t.Fatalf("failed to backdate post: %v", err)
+	}
+
+	unreadID := uuid.New()
+	readID := uuid.New()
+	mentionID := uuid.New()
+	staleID := uuid.New()
+	readAt := now.Add(-time.Minute)
+	for _, n := range []struct {
+		id        uuid.UUID
+		kind      string
+		createdAt time.Time
+		readAt    *time.Time
+	}{
+		{unreadID, "reaction", now.Add(-time.Hour), nil},
+		{readID, "reaction", now.Add(-2 * time.Hour), &readAt},
+		{mentionID, "mention", now.Add(-3 * time.Hour), nil},
+		{staleID, "reaction", now.Add(-72 * time.Hour), nil},
+	} {
+		var readAtValue interface{}
+		if n.readAt != nil {
+			readAtValue = *n.readAt
+		}
+		if _, err := db.Exec(`
+			INSERT INTO notifications (id, user_id, type, read_at, created_at)
+			VALUES ($1, $2, $3, $4, $5)
+		`, n.id, userID, n.kind, readAtValue, n.createdAt); err != nil {
+			t.Fatalf("failed to insert notification: %v", err)
+		}
+	}
+
+	handler := NewNotificationHandler(db, nil, nil)
+	req := httptest.NewRequest("GET", "/api/v1/notifications/digest?window=daily", nil)
+	req = req.WithContext(createTestUserContext(req.Context(), userID, "digestuser", false))
+	w := httptest.NewRecorder()
+
+	handler.GetNotificationDigest(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d. Body: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+
+	var response models.GetNotificationDigestResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	digest := response.Digest
+
+	if digest.UnreadNotificationCount != 1 {
+		t.Errorf("expected 1 unread non-mention notification in window, got %d", digest.UnreadNotificationCount)
+	}
+	if len(digest.UnreadNotifications) != 1 || digest.UnreadNotifications[0].ID != unreadID {
+		t.Errorf("expected only unread notification %s, got %+v", unreadID, digest.UnreadNotifications)
+	}
+	if len(digest.Mentions) != 1 || digest.Mentions[0].ID != mentionID {
+		t.Errorf("expected mention %s, got %+v", mentionID, digest.Mentions)
+	}
+	if digest.NewPostCount != 1 {
+		t.Errorf("expected 1 new post, got %d", digest.NewPostCount)
+	}
+	if len(digest.Sections) != 1 || digest.Sections[0].SectionID.String() != subscribedSectionID {
+		t.Fatalf("expected only subscribed section in digest, got %+v", digest.Sections)
+	}
+	if len(digest.Sections[0].Posts) != 1 || digest.Sections[0].Posts[0].ID.String() != recentPostID {
+		t.Errorf("expected recent post %s, got %+v", recentPostID, digest.Sections[0].Posts)
+	}
+}
+
+func TestGetNotificationDigestInvalidWindow(t *testing.T) {
+	handler := NewNotificationHandler(nil, nil, nil)
+	userID := uuid.New()
+	req := httptest.NewRequest("GET", "/api/v1/notifications/digest?window=monthly", nil)
+	req = req.WithContext(createTestUserContext(req.Context(), userID, "digestuser", false))
+	w := httptest.NewRecorder()
+
+	handler.GetNotificationDigest(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
 func TestGetNotificationsInvalidMethod(t *testing.T) {
 	db := testutil.RequireTestDB(t)
 	t.Cleanup(func() { testutil.CleanupTables(t, db) })
@@ -387,6 +492,83 @@ func TestMarkNotificationReadForbidden(t *testing.T) {
 	}
 }
 
+func TestGetNotificationStatesReturnsOwnedStates(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	userID := uuid.MustParse(testutil.CreateTestUser(t, db, "notifstates", "notifstates@test.com", false, true))
+	otherID := uuid.MustParse(testutil.CreateTestUser(t, db, "notifstatesother", "notifstatesother@test.com", false, true))
+	handler := NewNotificationHandler(db, nil, nil)
+
+	now := time.Now().UTC()
+	unreadID := uuid.New()
+	readID := uuid.New()
+	otherUsersID := uuid.New()
+	readAt := now.Add(-5 * time.Minute)
+	insertTestNotification(t, db, unreadID, userID, now.Add(-2*time.Hour), nil)
+	insertTestNotification(t, db, readID, userID, now.Add(-1*time.Hour), &readAt)
+	insertTestNotification(t, db, otherUsersID, otherID, now.Add(-30*time.Minute), nil)
+	unknownID := uuid.New()
+
+	body := `{"notification_ids":["` + unreadID.String() + `","` + readID.String() + `","` + otherUsersID.String() + `","` + unknownID.String() + `","` + readID.String() + `"]}`
+	req := httptest.NewRequest("POST", "/api/v1/notifications/states", strings.NewReader(body))
+	req = req.WithContext(createTestUserContext(req.Context(), userID, "notifstates", false))
+	w := httptest.NewRecorder()
+
+	handler.GetNotificationStates(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d. Body: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+
+	var response models.GetNotificationStatesResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if len(response.States) != 2 {
+		t.Fatalf("expected 2 states, got %d: %+v", len(response.States), response.States)
+	}
+	states := make(map[uuid.UUID]models.NotificationReadState, len(response.States))
+	for _, state := range response.States {
+		states[state.ID] = state
+	}
+	if state, ok := states[unreadID]; !ok || state.Read || state.ReadAt != nil {
+		t.Errorf("expected unread state for %s, got %+v", unreadID, state)
+	}
+	if state, ok := states[readID]; !ok || !state.Read || state.ReadAt == nil {
+		t.Errorf("expected read state for %s, got %+v", readID, state)
+	}
+	if _, ok := states[otherUsersID]; ok {
+		t.Errorf("expected other user's notification to be omitted")
+	}
+	if _, ok := states[unknownID]; ok {
+		t.Errorf("expected unknown notification to be omitted")
+	}
+}
+
+func TestGetNotificationStatesInvalidID(t *testing.T) {
+	handler := NewNotificationHandler(nil, nil, nil)
+
+	req := httptest.NewRequest("POST", "/api/v1/notifications/states", strings.NewReader(`{"notification_ids":["not-a-uuid"]}`))
+	req = req.WithContext(createTestUserContext(req.Context(), uuid.New(), "notifstatesinvalid", false))
+	w := httptest.NewRecorder()
+
+	handler.GetNotificationStates(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d. Body: %s", http.StatusBadRequest, w.Code, w.Body.String())
+	}
+
+	var response models.ErrorResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if response.Code != "INVALID_NOTIFICATION_ID" {
+		t.Errorf("expected code INVALID_NOTIFICATION_ID, got %s", response.Code)
+	}
+}
+
 func TestMarkAllNotificationsReadSuccess(t *testing.T) {
 	db := testutil.RequireTestDB(t)
 	t.Cleanup(func() { testutil.CleanupTables(t, db) })
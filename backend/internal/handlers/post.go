@@ -3,6 +3,7 @@ package handlers
 import (
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"strconv"
 	"strings"
@@ -81,6 +82,10 @@ func (h *PostHandler) CreatePost(w http.ResponseWriter, r *http.Request) {
 			writeError(r.Context(), w, http.StatusNotFound, "SECTION_NOT_FOUND", err.Error())
 		case "content is required":
 			writeError(r.Context(), w, http.StatusBadRequest, "CONTENT_REQUIRED", err.Error())
+		case "content is required for this section":
+			writeError(r.Context(), w, http.StatusBadRequest, "CONTENT_REQUIRED_FOR_SECTION", err.Error())
+		case "media is required for this section":
+			writeError(r.Context(), w, http.StatusBadRequest, "MEDIA_REQUIRED_FOR_SECTION", "Posts in this section must include an image or link")
 		case "content must be less than 5000 characters":
 			writeError(r.Context(), w, http.StatusBadRequest, "CONTENT_TOO_LONG", err.Error())
 		case "link url cannot be empty":
@@ -91,6 +96,8 @@ func (h *PostHandler) CreatePost(w http.ResponseWriter, r *http.Request) {
 			writeError(r.Context(), w, http.StatusBadRequest, "IMAGE_URL_REQUIRED", err.Error())
 		case "image url must be less than 2048 characters":
 			writeError(r.Context(), w, http.StatusBadRequest, "IMAGE_URL_TOO_LONG", err.Error())
+		case "image host is not allowed":
+			writeError(r.Context(), w, http.StatusBadRequest, "IMAGE_HOST_NOT_ALLOWED", err.Error())
 		case "too many images":
 			writeError(r.Context(), w, http.StatusBadRequest, "TOO_MANY_IMAGES", "Too many images (maximum 10)")
 		default:
@@ -185,6 +192,10 @@ func (h *PostHandler) UpdatePost(w http.ResponseWriter, r *http.Request) {
 			writeError(r.Context(), w, http.StatusForbidden, "FORBIDDEN", "You can only edit your own posts")
 		case "content is required":
 			writeError(r.Context(), w, http.StatusBadRequest, "CONTENT_REQUIRED", err.Error())
+		case "content is required for this section":
+			writeError(r.Context(), w, http.StatusBadRequest, "CONTENT_REQUIRED_FOR_SECTION", err.Error())
+		case "media is required for this section":
+			writeError(r.Context(), w, http.StatusBadRequest, "MEDIA_REQUIRED_FOR_SECTION", "Posts in this section must include an image or link")
 		case "content must be less than 5000 characters":
 			writeError(r.Context(), w, http.StatusBadRequest, "CONTENT_TOO_LONG", err.Error())
 		case "link url cannot be empty":
@@ -195,6 +206,8 @@ func (h *PostHandler) UpdatePost(w http.ResponseWriter, r *http.Request) {
 			writeError(r.Context(), w, http.StatusBadRequest, "IMAGE_URL_REQUIRED", err.Error())
 		case "image url must be less than 2048 characters":
 			writeError(r.Context(), w, http.StatusBadRequest, "IMAGE_URL_TOO_LONG", err.Error())
+		case "image host is not allowed":
+			writeError(r.Context(), w, http.StatusBadRequest, "IMAGE_HOST_NOT_ALLOWED", err.Error())
 		case "too many images":
 			writeError(r.Context(), w, http.StatusBadRequest, "TOO_MANY_IMAGES", "Too many images (maximum 10)")
 		default:
@@ -289,6 +302,222 @@ func (h *PostHandler) GetPost(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// GetPostCounts handles GET /api/v1/posts/{id}/counts
+func (h *PostHandler) GetPostCounts(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(r.Context(), w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "Only GET requests are allowed")
+		return
+	}
+
+	postID, err := extractPostIDFromPath(r.URL.Path)
+	if err != nil {
+		writeError(r.Context(), w, http.StatusBadRequest, "INVALID_POST_ID", "Invalid post ID format")
+		return
+	}
+
+	counts, err := h.postService.GetPostCounts(r.Context(), postID)
+	if err != nil {
+		if errors.Is(err, services.ErrPostNotFound) {
+			writeError(r.Context(), w, http.StatusNotFound, "POST_NOT_FOUND", "Post not found")
+			return
+		}
+		writeError(r.Context(), w, http.StatusInternalServerError, "GET_POST_COUNTS_FAILED", "Failed to get post counts")
+		return
+	}
+
+	response := models.GetPostCountsResponse{
+		Counts: *counts,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		observability.LogError(r.Context(), observability.ErrorLog{
+			Message:    "failed to encode get post counts response",
+			Code:       "ENCODE_FAILED",
+			StatusCode: http.StatusOK,
+			Err:        err,
+		})
+	}
+}
+
+// GetPostRatingDistribution handles GET /api/v1/posts/{id}/rating-distribution
+func (h *PostHandler) GetPostRatingDistribution(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(r.Context(), w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "Only GET requests are allowed")
+		return
+	}
+
+	postID, err := extractPostIDFromPath(r.URL.Path)
+	if err != nil {
+		writeError(r.Context(), w, http.StatusBadRequest, "INVALID_POST_ID", "Invalid post ID format")
+		return
+	}
+
+	distribution, err := h.postService.GetPostRatingDistribution(r.Context(), postID)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrPostNotFound):
+			writeError(r.Context(), w, http.StatusNotFound, "POST_NOT_FOUND", "Post not found")
+		case errors.Is(err, services.ErrRatingsNotSupported):
+			writeError(r.Context(), w, http.StatusBadRequest, "RATINGS_NOT_SUPPORTED", "Ratings are only available for movie, series, book, and recipe posts")
+		default:
+			writeError(r.Context(), w, http.StatusInternalServerError, "GET_RATING_DISTRIBUTION_FAILED", "Failed to get rating distribution")
+		}
+		return
+	}
+
+	response := models.GetRatingDistributionResponse{
+		Distribution: *distribution,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		observability.LogError(r.Context(), observability.ErrorLog{
+			Message:    "failed to encode get rating distribution response",
+			Code:       "ENCODE_FAILED",
+			StatusCode: http.StatusOK,
+			Err:        err,
+		})
+	}
+}
+
+// GetMoreFromAuthor handles GET /api/v1/posts/{id}/more-from-author
+func (h *PostHandler) GetMoreFromAuthor(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(r.Context(), w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "Only GET requests are allowed")
+		return
+	}
+
+	postID, err := extractPostIDFromPath(r.URL.Path)
+	if err != nil {
+		writeError(r.Context(), w, http.StatusBadRequest, "INVALID_POST_ID", "Invalid post ID format")
+		return
+	}
+
+	viewerID, err := middleware.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeError(r.Context(), w, http.StatusUnauthorized, "UNAUTHORIZED", "Authentication required")
+		return
+	}
+
+	limit := 0
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		if parsedLimit, err := parseIntParam(limitStr); err == nil && parsedLimit > 0 {
+			limit = parsedLimit
+		}
+	}
+
+	posts, err := h.postService.GetMoreFromAuthor(r.Context(), postID, viewerID, limit)
+	if err != nil {
+		if errors.Is(err, services.ErrPostNotFound) {
+			writeError(r.Context(), w, http.StatusNotFound, "POST_NOT_FOUND", "Post not found")
+			return
+		}
+		writeError(r.Context(), w, http.StatusInternalServerError, "GET_MORE_FROM_AUTHOR_FAILED", "Failed to get more posts from author")
+		return
+	}
+
+	response := models.MoreFromAuthorResponse{
+		Posts: posts,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		observability.LogError(r.Context(), observability.ErrorLog{
+			Message:    "failed to encode more from author response",
+			Code:       "ENCODE_FAILED",
+			StatusCode: http.StatusOK,
+			UserID:     viewerID.String(),
+			Err:        err,
+		})
+	}
+}
+
+// GetPostTimeline handles GET /api/v1/posts/{id}/timeline
+func (h *PostHandler) GetPostTimeline(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(r.Context(), w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "Only GET requests are allowed")
+		return
+	}
+
+	postID, err := extractPostIDFromPath(r.URL.Path)
+	if err != nil {
+		writeError(r.Context(), w, http.StatusBadRequest, "INVALID_POST_ID", "Invalid post ID format")
+		return
+	}
+
+	session, err := middleware.GetUserFromContext(r.Context())
+	if err != nil {
+		writeError(r.Context(), w, http.StatusUnauthorized, "UNAUTHORIZED", "Missing or invalid user session")
+		return
+	}
+
+	events, err := h.postService.GetPostTimeline(r.Context(), postID, session.UserID, session.IsAdmin)
+	if err != nil {
+		switch err.Error() {
+		case "post not found":
+			writeError(r.Context(), w, http.StatusNotFound, "POST_NOT_FOUND", "Post not found")
+		case "unauthorized":
+			writeError(r.Context(), w, http.StatusForbidden, "FORBIDDEN", "You do not have permission to view this post's timeline")
+		default:
+			writeError(r.Context(), w, http.StatusInternalServerError, "GET_POST_TIMELINE_FAILED", "Failed to get post timeline")
+		}
+		return
+	}
+
+	response := models.PostTimelineResponse{
+		PostID: postID,
+		Events: events,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		observability.LogError(r.Context(), observability.ErrorLog{
+			Message:    "failed to encode post timeline response",
+			Code:       "ENCODE_FAILED",
+			StatusCode: http.StatusOK,
+			UserID:     session.UserID.String(),
+			Err:        err,
+		})
+	}
+}
+
+// GetMyEngagement handles GET /api/v1/me/engagement
+func (h *PostHandler) GetMyEngagement(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(r.Context(), w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "Only GET requests are allowed")
+		return
+	}
+
+	userID, err := middleware.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeError(r.Context(), w, http.StatusUnauthorized, "UNAUTHORIZED", "Authentication required")
+		return
+	}
+
+	response, err := h.postService.GetAuthorEngagement(r.Context(), userID)
+	if err != nil {
+		writeError(r.Context(), w, http.StatusInternalServerError, "GET_ENGAGEMENT_FAILED", "Failed to get engagement")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		observability.LogError(r.Context(), observability.ErrorLog{
+			Message:    "failed to encode engagement response",
+			Code:       "ENCODE_FAILED",
+			StatusCode: http.StatusOK,
+			UserID:     userID.String(),
+			Err:        err,
+		})
+	}
+}
+
 // GetFeed handles GET /api/v1/sections/{sectionId}/feed
 func (h *PostHandler) GetFeed(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodGet {
@@ -332,8 +561,29 @@ func (h *PostHandler) GetFeed(w http.ResponseWriter, r *http.Request) {
 		cursorPtr = &cursor
 	}
 
+	unreadOnly := false
+	if unreadStr := r.URL.Query().Get("unread_only"); unreadStr != "" {
+		parsed, err := strconv.ParseBool(unreadStr)
+		if err != nil {
+			writeError(r.Context(), w, http.StatusBadRequest, "INVALID_UNREAD_ONLY", "unread_only must be a boolean")
+			return
+		}
+		unreadOnly = parsed
+	}
+
+	includeCommentCounts, err := parseIncludeCommentCounts(r)
+	if err != nil {
+		writeError(r.Context(), w, http.StatusBadRequest, "INVALID_INCLUDE_COMMENT_COUNTS", "include_comment_counts must be a boolean")
+		return
+	}
+
 	userID, _ := middleware.GetUserIDFromContext(r.Context())
-	feed, err := h.postService.GetFeed(r.Context(), sectionID, cursorPtr, limit, userID)
+	var feed *models.FeedResponse
+	if unreadOnly {
+		feed, err = h.postService.GetUnreadFeed(r.Context(), sectionID, cursorPtr, limit, userID, includeCommentCounts)
+	} else {
+		feed, err = h.postService.GetFeed(r.Context(), sectionID, cursorPtr, limit, userID, includeCommentCounts)
+	}
 	if err != nil {
 		writeError(r.Context(), w, http.StatusInternalServerError, "GET_FEED_FAILED", "Failed to get feed")
 		return
@@ -381,8 +631,14 @@ func (h *PostHandler) GetMovieFeed(w http.ResponseWriter, r *http.Request) {
 		cursorPtr = &cursor
 	}
 
+	includeCommentCounts, err := parseIncludeCommentCounts(r)
+	if err != nil {
+		writeError(r.Context(), w, http.StatusBadRequest, "INVALID_INCLUDE_COMMENT_COUNTS", "include_comment_counts must be a boolean")
+		return
+	}
+
 	userID, _ := middleware.GetUserIDFromContext(r.Context())
-	feed, err := h.postService.GetMovieFeed(r.Context(), cursorPtr, limit, userID, sectionType)
+	feed, err := h.postService.GetMovieFeed(r.Context(), cursorPtr, limit, userID, sectionType, includeCommentCounts)
 	if err != nil {
 		writeError(r.Context(), w, http.StatusInternalServerError, "GET_MOVIE_FEED_FAILED", "Failed to get movie feed")
 		return
@@ -479,6 +735,16 @@ func parseIntParam(s string) (int, error) {
 	return strconv.Atoi(s)
 }
 
+// parseIncludeCommentCounts reads the include_comment_counts query parameter shared by feed
+// endpoints. Lightweight clients pass include_comment_counts=false to skip the comment count aggregation.
+func parseIncludeCommentCounts(r *http.Request) (bool, error) {
+	raw := r.URL.Query().Get("include_comment_counts")
+	if raw == "" {
+		return true, nil
+	}
+	return strconv.ParseBool(raw)
+}
+
 // RestorePost handles POST /api/v1/posts/{id}/restore
 func (h *PostHandler) RestorePost(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
@@ -524,7 +790,7 @@ func (h *PostHandler) RestorePost(w http.ResponseWriter, r *http.Request) {
 		case "unauthorized":
 			writeError(r.Context(), w, http.StatusForbidden, "FORBIDDEN", "You do not have permission to restore this post")
 		case "post permanently deleted":
-			writeError(r.Context(), w, http.StatusGone, "POST_PERMANENTLY_DELETED", "Post was permanently deleted more than 7 days ago")
+			writeError(r.Context(), w, http.StatusGone, "POST_PERMANENTLY_DELETED", "Post can no longer be restored")
 		default:
 			writeError(r.Context(), w, http.StatusInternalServerError, "RESTORE_FAILED", "Failed to restore post")
 		}
@@ -217,11 +217,11 @@ func disableLinkMetadataForCreatePostPodcastTests(t *testing.T) {
 	config := services.GetConfigService()
 	current := config.GetConfig().LinkMetadataEnabled
 	disabled := false
-	if _, err := config.UpdateConfig(context.Background(), &disabled, nil, nil); err != nil {
+	if _, err := config.UpdateConfig(context.Background(), services.ConfigUpdate{LinkMetadataEnabled: &disabled}); err != nil {
 		t.Fatalf("failed to disable link metadata: %v", err)
 	}
 	t.Cleanup(func() {
-		if _, err := config.UpdateConfig(context.Background(), &current, nil, nil); err != nil {
+		if _, err := config.UpdateConfig(context.Background(), services.ConfigUpdate{LinkMetadataEnabled: &current}); err != nil {
 			t.Fatalf("failed to restore link metadata config: %v", err)
 		}
 	})
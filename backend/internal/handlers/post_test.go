@@ -47,7 +47,7 @@ func TestGetPostSuccess(t *testing.T) {
 
 	// Mock the links query
 
-	linksRows := mock.NewRows([]string{"id", "url", "metadata", "created_at"})
+	linksRows := mock.NewRows([]string{"id", "url", "metadata", "created_at", "is_dead", "last_checked_at"})
 
 	mock.ExpectQuery("SELECT id, url, metadata, created_at").WithArgs(postID).WillReturnRows(linksRows)
 
@@ -92,8 +92,8 @@ func TestGetPostSuccess(t *testing.T) {
 		t.Errorf("expected content 'Test post content', got '%s'", response.Post.Content)
 	}
 
-	if response.Post.CommentCount != 5 {
-		t.Errorf("expected comment count 5, got %d", response.Post.CommentCount)
+	if response.Post.CommentCount == nil || *response.Post.CommentCount != 5 {
+		t.Errorf("expected comment count 5, got %v", response.Post.CommentCount)
 	}
 
 	if err := mock.ExpectationsWereMet(); err != nil {
@@ -129,11 +129,13 @@ func TestGetPostSuccessIncludesPodcastMetadata(t *testing.T) {
 	mock.ExpectQuery("SELECT").WithArgs(postID).WillReturnRows(rows)
 
 	linkMetadata := `{"podcast":{"kind":"show","highlight_episodes":[{"title":"Episode 1","url":"https://example.com/show/1","note":"Start here"}]},"title":"Example Show"}`
-	linksRows := mock.NewRows([]string{"id", "url", "metadata", "created_at"}).AddRow(
+	linksRows := mock.NewRows([]string{"id", "url", "metadata", "created_at", "is_dead", "last_checked_at"}).AddRow(
 		linkID,
 		"https://example.com/show",
 		linkMetadata,
 		now,
+		false,
+		nil,
 	)
 	mock.ExpectQuery("SELECT id, url, metadata, created_at").WithArgs(postID).WillReturnRows(linksRows)
 
@@ -231,6 +233,157 @@ func TestGetPostNotFound(t *testing.T) {
 	}
 }
 
+func TestGetPostCountsSuccess(t *testing.T) {
+	db, mock, err := setupMockDB(t)
+	if err != nil {
+		t.Fatalf("failed to setup mock db: %v", err)
+	}
+	defer db.Close()
+
+	handler := NewPostHandler(db, nil, nil)
+	postID := uuid.New()
+
+	mock.ExpectQuery("SELECT COUNT\\(c.id\\)").WithArgs(postID).
+		WillReturnRows(mock.NewRows([]string{"count"}).AddRow(3))
+	mock.ExpectQuery("SELECT emoji, COUNT").WithArgs(postID).
+		WillReturnRows(mock.NewRows([]string{"emoji", "count"}).AddRow("👍", 2).AddRow("🔥", 1))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/posts/"+postID.String()+"/counts", nil)
+	rr := httptest.NewRecorder()
+	handler.GetPostCounts(rr, req)
+
+	if status := rr.Code; status != http.StatusOK {
+		t.Fatalf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
+	}
+
+	var response models.GetPostCountsResponse
+	if err := json.NewDecoder(rr.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if response.Counts.PostID != postID {
+		t.Errorf("expected post id %s, got %s", postID, response.Counts.PostID)
+	}
+	if response.Counts.CommentCount != 3 {
+		t.Errorf("expected comment count 3, got %d", response.Counts.CommentCount)
+	}
+	if response.Counts.ReactionCount != 3 {
+		t.Errorf("expected reaction count 3, got %d", response.Counts.ReactionCount)
+	}
+	if response.Counts.ReactionCounts["👍"] != 2 || response.Counts.ReactionCounts["🔥"] != 1 {
+		t.Errorf("unexpected reaction counts: %v", response.Counts.ReactionCounts)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestGetPostCountsNotFound(t *testing.T) {
+	db, mock, err := setupMockDB(t)
+	if err != nil {
+		t.Fatalf("failed to setup mock db: %v", err)
+	}
+	defer db.Close()
+
+	handler := NewPostHandler(db, nil, nil)
+	postID := uuid.New()
+
+	mock.ExpectQuery("SELECT COUNT\\(c.id\\)").WithArgs(postID).WillReturnError(sql.ErrNoRows)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/posts/"+postID.String()+"/counts", nil)
+	rr := httptest.NewRecorder()
+	handler.GetPostCounts(rr, req)
+
+	if status := rr.Code; status != http.StatusNotFound {
+		t.Fatalf("handler returned wrong status code: got %v want %v", status, http.StatusNotFound)
+	}
+
+	var response models.ErrorResponse
+	if err := json.NewDecoder(rr.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if response.Code != "POST_NOT_FOUND" {
+		t.Errorf("expected code POST_NOT_FOUND, got %s", response.Code)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestGetPostRatingDistributionSuccess(t *testing.T) {
+	db, mock, err := setupMockDB(t)
+	if err != nil {
+		t.Fatalf("failed to setup mock db: %v", err)
+	}
+	defer db.Close()
+
+	handler := NewPostHandler(db, nil, nil)
+	postID := uuid.New()
+
+	mock.ExpectQuery("SELECT s.type").WithArgs(postID).
+		WillReturnRows(mock.NewRows([]string{"type"}).AddRow("recipe"))
+	mock.ExpectQuery("FROM cook_logs").WithArgs(postID).
+		WillReturnRows(mock.NewRows([]string{"rating", "count"}).AddRow(4, 2).AddRow(5, 1))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/posts/"+postID.String()+"/rating-distribution", nil)
+	rr := httptest.NewRecorder()
+	handler.GetPostRatingDistribution(rr, req)
+
+	if status := rr.Code; status != http.StatusOK {
+		t.Fatalf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
+	}
+
+	var response models.GetRatingDistributionResponse
+	if err := json.NewDecoder(rr.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if response.Distribution.TotalRatings != 3 {
+		t.Errorf("expected total ratings 3, got %d", response.Distribution.TotalRatings)
+	}
+	if response.Distribution.Counts[4] != 2 || response.Distribution.Counts[5] != 1 || response.Distribution.Counts[1] != 0 {
+		t.Errorf("unexpected counts: %v", response.Distribution.Counts)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestGetPostRatingDistributionUnsupportedSection(t *testing.T) {
+	db, mock, err := setupMockDB(t)
+	if err != nil {
+		t.Fatalf("failed to setup mock db: %v", err)
+	}
+	defer db.Close()
+
+	handler := NewPostHandler(db, nil, nil)
+	postID := uuid.New()
+
+	mock.ExpectQuery("SELECT s.type").WithArgs(postID).
+		WillReturnRows(mock.NewRows([]string{"type"}).AddRow("music"))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/posts/"+postID.String()+"/rating-distribution", nil)
+	rr := httptest.NewRecorder()
+	handler.GetPostRatingDistribution(rr, req)
+
+	if status := rr.Code; status != http.StatusBadRequest {
+		t.Fatalf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
+	}
+
+	var response models.ErrorResponse
+	if err := json.NewDecoder(rr.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if response.Code != "RATINGS_NOT_SUPPORTED" {
+		t.Errorf("expected code RATINGS_NOT_SUPPORTED, got %s", response.Code)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
 func TestCreatePostHandlerRateLimited(t *testing.T) {
 	limiter := &stubContentRateLimiter{allowed: false}
 	handler := &PostHandler{rateLimiter: limiter}
@@ -416,7 +569,7 @@ func TestGetFeedSuccess(t *testing.T) {
 
 	// Mock links queries
 
-	linksRows := mock.NewRows([]string{"id", "url", "metadata", "created_at"})
+	linksRows := mock.NewRows([]string{"id", "url", "metadata", "created_at", "is_dead", "last_checked_at"})
 
 	mock.ExpectQuery("SELECT id, url, metadata, created_at").WillReturnRows(linksRows)
 
@@ -496,7 +649,7 @@ func TestGetFeedWithCursor(t *testing.T) {
 
 	// Mock links query
 
-	linksRows := mock.NewRows([]string{"id", "url", "metadata", "created_at"})
+	linksRows := mock.NewRows([]string{"id", "url", "metadata", "created_at", "is_dead", "last_checked_at"})
 
 	mock.ExpectQuery("SELECT id, url, metadata, created_at").WillReturnRows(linksRows)
 
@@ -550,17 +703,17 @@ func TestGetMovieFeedSuccess(t *testing.T) {
 		"id", "user_id", "section_id", "content",
 		"created_at", "updated_at", "deleted_at", "deleted_by_user_id",
 		"id", "username", "email", "profile_picture_url", "bio", "is_admin", "created_at",
-		"comment_count",
+		"comment_count", "type",
 	}).AddRow(
 		postID, userID, sectionID, "Movie post",
 		now, nil, nil, nil,
 		userID, "movieuser", "movie@example.com", nil, nil, false, now,
-		3,
+		3, "movie",
 	)
 
 	mock.ExpectQuery("FROM posts p").WillReturnRows(mainRows)
 	mock.ExpectQuery("SELECT id, url, metadata, created_at").WithArgs(postID).
-		WillReturnRows(mock.NewRows([]string{"id", "url", "metadata", "created_at"}))
+		WillReturnRows(mock.NewRows([]string{"id", "url", "metadata", "created_at", "is_dead", "last_checked_at"}))
 	mock.ExpectQuery("SELECT id, image_url, position, caption, alt_text, created_at").WithArgs(postID).
 		WillReturnRows(mock.NewRows([]string{"id", "image_url", "position", "caption", "alt_text", "created_at"}))
 	mock.ExpectQuery("SELECT emoji, COUNT").WithArgs(postID).
@@ -704,12 +857,12 @@ func TestRestorePostSuccess(t *testing.T) {
 		"id", "user_id", "section_id", "content",
 		"created_at", "updated_at", "deleted_at", "deleted_by_user_id",
 		"id", "username", "email", "profile_picture_url", "bio", "is_admin", "created_at",
-		"comment_count",
+		"comment_count", "type",
 	}).AddRow(
 		postID, userID, sectionID, "Test post content",
 		now, nil, &deletedAt, nil,
 		userID, "testuser", "test@example.com", nil, nil, false, now,
-		0,
+		0, "general",
 	)
 
 	mock.ExpectQuery("SELECT").WithArgs(postID).WillReturnRows(rows)
@@ -727,7 +880,7 @@ func TestRestorePostSuccess(t *testing.T) {
 
 	// Mock the links query
 
-	linksRows := mock.NewRows([]string{"id", "url", "metadata", "created_at"})
+	linksRows := mock.NewRows([]string{"id", "url", "metadata", "created_at", "is_dead", "last_checked_at"})
 
 	mock.ExpectQuery("SELECT id, url, metadata, created_at").WithArgs(postID).WillReturnRows(linksRows)
 
@@ -796,12 +949,12 @@ func TestRestorePostByAdmin(t *testing.T) {
 		"id", "user_id", "section_id", "content",
 		"created_at", "updated_at", "deleted_at", "deleted_by_user_id",
 		"id", "username", "email", "profile_picture_url", "bio", "is_admin", "created_at",
-		"comment_count",
+		"comment_count", "type",
 	}).AddRow(
 		postID, ownerID, sectionID, "Test post content",
 		now, nil, &deletedAt, nil,
 		ownerID, "testuser", "test@example.com", nil, nil, false, now,
-		0,
+		0, "general",
 	)
 
 	mock.ExpectQuery("SELECT").WithArgs(postID).WillReturnRows(rows)
@@ -819,7 +972,7 @@ func TestRestorePostByAdmin(t *testing.T) {
 
 	// Mock the links query
 
-	linksRows := mock.NewRows([]string{"id", "url", "metadata", "created_at"})
+	linksRows := mock.NewRows([]string{"id", "url", "metadata", "created_at", "is_dead", "last_checked_at"})
 
 	mock.ExpectQuery("SELECT id, url, metadata, created_at").WithArgs(postID).WillReturnRows(linksRows)
 
@@ -875,12 +1028,12 @@ func TestRestorePostUnauthorized(t *testing.T) {
 		"id", "user_id", "section_id", "content",
 		"created_at", "updated_at", "deleted_at", "deleted_by_user_id",
 		"id", "username", "email", "profile_picture_url", "bio", "is_admin", "created_at",
-		"comment_count",
+		"comment_count", "type",
 	}).AddRow(
 		postID, ownerID, sectionID, "Test post content",
 		now, nil, &deletedAt, nil,
 		ownerID, "testuser", "test@example.com", nil, nil, false, now,
-		0,
+		0, "general",
 	)
 
 	mock.ExpectQuery("SELECT").WithArgs(postID).WillReturnRows(rows)
@@ -934,12 +1087,12 @@ func TestRestorePostPermanentlyDeleted(t *testing.T) {
 		"id", "user_id", "section_id", "content",
 		"created_at", "updated_at", "deleted_at", "deleted_by_user_id",
 		"id", "username", "email", "profile_picture_url", "bio", "is_admin", "created_at",
-		"comment_count",
+		"comment_count", "type",
 	}).AddRow(
 		postID, userID, sectionID, "Test post content",
 		now, nil, &deletedAt, nil,
 		userID, "testuser", "test@example.com", nil, nil, false, now,
-		0,
+		0, "general",
 	)
 
 	mock.ExpectQuery("SELECT").WithArgs(postID).WillReturnRows(rows)
@@ -995,7 +1148,7 @@ func TestUpdatePostSuccess(t *testing.T) {
 	mock.ExpectQuery("SELECT p.user_id, p.content, p.section_id, s.type").WithArgs(postID).
 		WillReturnRows(sqlmock.NewRows([]string{"user_id", "content", "section_id", "type"}).AddRow(userID, "Original content", sectionID, "general"))
 	mock.ExpectBegin()
-	mock.ExpectExec("UPDATE posts").WithArgs("Updated content", postID).
+	mock.ExpectExec("UPDATE posts").WithArgs("Updated content", postID, services.GetConfigService().GetSettings().EditGracePeriod.Seconds()).
 		WillReturnResult(sqlmock.NewResult(0, 1))
 	mock.ExpectExec("INSERT INTO audit_logs").WithArgs(userID, "update_post", userID, userID, sqlmock.AnyArg()).
 		WillReturnResult(sqlmock.NewResult(0, 1))
@@ -1014,7 +1167,7 @@ func TestUpdatePostSuccess(t *testing.T) {
 	)
 	mock.ExpectQuery("SELECT").WithArgs(postID).WillReturnRows(rows)
 
-	linksRows := mock.NewRows([]string{"id", "url", "metadata", "created_at"})
+	linksRows := mock.NewRows([]string{"id", "url", "metadata", "created_at", "is_dead", "last_checked_at"})
 	mock.ExpectQuery("SELECT id, url, metadata, created_at").WithArgs(postID).WillReturnRows(linksRows)
 
 	imageRows := mock.NewRows([]string{"id", "image_url", "position", "caption", "alt_text", "created_at"})
@@ -1093,6 +1246,64 @@ func TestUpdatePostEmptyContent(t *testing.T) {
 	}
 }
 
+func TestUpdatePostRequiresMediaForConfiguredSectionTypes(t *testing.T) {
+	services.ResetConfigServiceForTests()
+	t.Cleanup(services.ResetConfigServiceForTests)
+	settings := services.DefaultSettings()
+	settings.MediaRequiredSectionTypes = []string{"photos"}
+	services.GetConfigService().SetSettings(settings)
+
+	db, mock, err := setupMockDB(t)
+	if err != nil {
+		t.Fatalf("failed to setup mock db: %v", err)
+	}
+	defer db.Close()
+
+	handler := NewPostHandler(db, nil, nil)
+	userID := uuid.New()
+	postID := uuid.New()
+
+	// Removing the only image from a photos post must be rejected
+	body, err := json.Marshal(models.UpdatePostRequest{Content: "Sunset", Images: &[]models.PostImageRequest{}})
+	if err != nil {
+		t.Fatalf("failed to marshal body: %v", err)
+	}
+
+	mock.ExpectQuery("SELECT p.user_id, p.content, p.section_id, s.type").
+		WithArgs(postID).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "content", "section_id", "type"}).AddRow(userID, "Sunset", uuid.New(), "photos"))
+	mock.ExpectQuery("SELECT image_url, caption, alt_text").
+		WithArgs(postID).
+		WillReturnRows(sqlmock.NewRows([]string{"image_url", "caption", "alt_text"}).AddRow("/api/v1/uploads/sunset.png", nil, nil))
+	mock.ExpectQuery("SELECT id, url, metadata, created_at").
+		WithArgs(postID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "url", "metadata", "created_at", "is_dead", "last_checked_at"}))
+
+	req, err := http.NewRequest(http.MethodPatch, "/api/v1/posts/"+postID.String(), bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+	req = req.WithContext(createTestUserContext(req.Context(), userID, "testuser", false))
+
+	rr := httptest.NewRecorder()
+	handler.UpdatePost(rr, req)
+
+	if status := rr.Code; status != http.StatusBadRequest {
+		t.Fatalf("expected status %v, got %v: %s", http.StatusBadRequest, status, rr.Body.String())
+	}
+
+	var response models.ErrorResponse
+	if err := json.NewDecoder(rr.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if response.Code != "MEDIA_REQUIRED_FOR_SECTION" {
+		t.Fatalf("expected code MEDIA_REQUIRED_FOR_SECTION, got %s", response.Code)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unfulfilled expectations: %v", err)
+	}
+}
+
 func TestUpdatePostForbidden(t *testing.T) {
 	db, mock, err := setupMockDB(t)
 	if err != nil {
@@ -1145,3 +1356,28 @@ func setupMockDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, error) {
 	}
 	return db, mock, nil
 }
+
+func TestGetFeedRejectsInvalidIncludeCommentCounts(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	handler := NewPostHandler(db, nil, nil)
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/sections/"+uuid.New().String()+"/feed?include_comment_counts=maybe", nil)
+	rr := httptest.NewRecorder()
+
+	handler.GetFeed(rr, req)
+
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, rr.Code)
+	}
+	var response map[string]string
+	if err := json.Unmarshal(rr.Body.Bytes(), &response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if response["code"] != "INVALID_INCLUDE_COMMENT_COUNTS" {
+		t.Fatalf("expected INVALID_INCLUDE_COMMENT_COUNTS, got %q", response["code"])
+	}
+}
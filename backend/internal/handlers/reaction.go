@@ -72,6 +72,8 @@ func (h *ReactionHandler) AddReactionToPost(w http.ResponseWriter, r *http.Reque
 			writeError(r.Context(), w, http.StatusBadRequest, "EMOJI_REQUIRED", err.Error())
 		case "emoji must be 10 characters or less":
 			writeError(r.Context(), w, http.StatusBadRequest, "EMOJI_TOO_LONG", err.Error())
+		case "emoji not allowed":
+			writeError(r.Context(), w, http.StatusBadRequest, "EMOJI_NOT_ALLOWED", "Emoji is not allowed in this section")
 		case "post not found":
 			writeError(r.Context(), w, http.StatusNotFound, "POST_NOT_FOUND", err.Error())
 		default:
@@ -159,6 +161,41 @@ func (h *ReactionHandler) GetPostReactions(w http.ResponseWriter, r *http.Reques
 	}
 }
 
+// GetAllowedReactions handles GET /api/v1/posts/{postId}/allowed-reactions
+func (h *ReactionHandler) GetAllowedReactions(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(r.Context(), w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "Only GET requests are allowed")
+		return
+	}
+
+	postID, err := extractPostIDFromPath(r.URL.Path)
+	if err != nil {
+		writeError(r.Context(), w, http.StatusBadRequest, "INVALID_POST_ID", "Invalid post ID format")
+		return
+	}
+
+	response, err := h.reactionService.GetAllowedReactions(r.Context(), postID)
+	if err != nil {
+		if err.Error() == "post not found" {
+			writeError(r.Context(), w, http.StatusNotFound, "POST_NOT_FOUND", "Post not found")
+			return
+		}
+		writeError(r.Context(), w, http.StatusInternalServerError, "GET_ALLOWED_REACTIONS_FAILED", "Failed to get allowed reactions")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		observability.LogError(r.Context(), observability.ErrorLog{
+			Message:    "failed to encode allowed reactions response",
+			Code:       "ENCODE_FAILED",
+			StatusCode: http.StatusOK,
+			Err:        err,
+		})
+	}
+}
+
 // RemoveReactionFromPost handles DELETE /api/v1/posts/{postId}/reactions/{emoji}
 func (h *ReactionHandler) RemoveReactionFromPost(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodDelete {
@@ -268,8 +305,12 @@ func (h *ReactionHandler) AddReactionToComment(w http.ResponseWriter, r *http.Re
 			writeError(r.Context(), w, http.StatusBadRequest, "EMOJI_REQUIRED", err.Error())
 		case "emoji must be 10 characters or less":
 			writeError(r.Context(), w, http.StatusBadRequest, "EMOJI_TOO_LONG", err.Error())
+		case "emoji not allowed":
+			writeError(r.Context(), w, http.StatusBadRequest, "EMOJI_NOT_ALLOWED", "Emoji is not allowed in this section")
 		case "comment not found":
 			writeError(r.Context(), w, http.StatusNotFound, "COMMENT_NOT_FOUND", err.Error())
+		case "post not found":
+			writeError(r.Context(), w, http.StatusNotFound, "POST_NOT_FOUND", err.Error())
 		default:
 			writeError(r.Context(), w, http.StatusInternalServerError, "REACTION_CREATION_FAILED", "Failed to add reaction")
 		}
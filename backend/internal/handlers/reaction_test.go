@@ -3,14 +3,17 @@ package handlers
 import (
 	"bytes"
 	"context"
+	"database/sql"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 	"time"
 
+	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
 	"github.com/redis/go-redis/v9"
+	"github.com/sanderginn/clubhouse/internal/services"
 	"github.com/sanderginn/clubhouse/internal/testutil"
 )
 
@@ -183,3 +186,94 @@ func TestAddReactionToCommentPublishesSectionEvent(t *testing.T) {
 		t.Fatalf("expected comment_id %s, got %v", commentID, payload.CommentID)
 	}
 }
+
+func TestAddReactionToPostRejectsEmojiOutsideSectionSet(t *testing.T) {
+	services.ResetConfigServiceForTests()
+	t.Cleanup(services.ResetConfigServiceForTests)
+	overrides := map[string][]string{"movie": {"🍿"}}
+	if _, err := services.GetConfigService().UpdateConfig(context.Background(), services.ConfigUpdate{SectionReactions: &overrides}); err != nil {
+		t.Fatalf("UpdateConfig failed: %v", err)
+	}
+
+	db, mock, err := setupMockDB(t)
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	postID := uuid.New()
+	mock.ExpectQuery("SELECT EXISTS").
+		WithArgs(postID).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectQuery("SELECT s.type").
+		WithArgs(postID).
+		WillReturnRows(sqlmock.NewRows([]string{"type"}).AddRow("movie"))
+
+	body := bytes.NewBufferString(`{"emoji":"👍"}`)
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/posts/"+postID.String()+"/reactions", body)
+	req.Header.Set("Content-Type", "application/json")
+	req = req.WithContext(createTestUserContext(req.Context(), uuid.New(), "reactionuser", false))
+	w := httptest.NewRecorder()
+
+	NewReactionHandler(db, nil, nil).AddReactionToPost(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d. Body: %s", w.Code, w.Body.String())
+	}
+	var response map[string]string
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if response["code"] != "EMOJI_NOT_ALLOWED" {
+		t.Fatalf("expected EMOJI_NOT_ALLOWED, got %v", response)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
+
+func TestAddReactionToCommentReturnsNotFoundWhenPostIsGone(t *testing.T) {
+	services.ResetConfigServiceForTests()
+	t.Cleanup(services.ResetConfigServiceForTests)
+	overrides := map[string][]string{"movie": {"🍿"}}
+	if _, err := services.GetConfigService().UpdateConfig(context.Background(), services.ConfigUpdate{SectionReactions: &overrides}); err != nil {
+		t.Fatalf("UpdateConfig failed: %v", err)
+	}
+
+	db, mock, err := setupMockDB(t)
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	commentID := uuid.New()
+	postID := uuid.New()
+	mock.ExpectQuery("SELECT post_id").
+		WithArgs(commentID).
+		WillReturnRows(sqlmock.NewRows([]string{"post_id"}).AddRow(postID))
+	mock.ExpectQuery("SELECT s.type").
+		WithArgs(postID).
+		WillReturnError(sql.ErrNoRows)
+
+	body := bytes.NewBufferString(`{"emoji":"🍿"}`)
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/comments/"+commentID.String()+"/reactions", body)
+	req.Header.Set("Content-Type", "application/json")
+	req = req.WithContext(createTestUserContext(req.Context(), uuid.New(), "reactionuser", false))
+	w := httptest.NewRecorder()
+
+	NewReactionHandler(db, nil, nil).AddReactionToComment(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected status 404, got %d. Body: %s", w.Code, w.Body.String())
+	}
+	var response map[string]string
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if response["code"] != "POST_NOT_FOUND" {
+		t.Fatalf("expected POST_NOT_FOUND, got %v", response)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
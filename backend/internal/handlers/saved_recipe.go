@@ -124,6 +124,76 @@ func (h *SavedRecipeHandler) SaveRecipe(w http.ResponseWriter, r *http.Request)
 	}
 }
 
+// MoveSavedRecipe handles PATCH /api/v1/posts/{postId}/save/category
+func (h *SavedRecipeHandler) MoveSavedRecipe(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPatch {
+		writeError(r.Context(), w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "Only PATCH requests are allowed")
+		return
+	}
+
+	userID, err := middleware.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeError(r.Context(), w, http.StatusUnauthorized, "UNAUTHORIZED", "Missing or invalid user ID")
+		return
+	}
+
+	postID, err := extractPostIDFromPath(r.URL.Path)
+	if err != nil {
+		writeError(r.Context(), w, http.StatusBadRequest, "INVALID_POST_ID", "Invalid post ID format")
+		return
+	}
+
+	var req models.MoveSavedRecipeRequest
+	if err := decodeJSONBody(w, r, &req); err != nil {
+		if isRequestBodyTooLarge(err) {
+			writeError(r.Context(), w, http.StatusRequestEntityTooLarge, "REQUEST_TOO_LARGE", "Request body too large")
+			return
+		}
+		writeError(r.Context(), w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+		return
+	}
+
+	savedRecipes, err := h.savedRecipeService.MoveSavedRecipe(r.Context(), userID, postID, req.FromCategory, req.ToCategories)
+	if err != nil {
+		switch err.Error() {
+		case "recipe post not found":
+			writeError(r.Context(), w, http.StatusNotFound, "POST_NOT_FOUND", "Post not found")
+		case "saved recipe not found":
+			writeError(r.Context(), w, http.StatusNotFound, "SAVED_RECIPE_NOT_FOUND", "Saved recipe not found")
+		case "category not found":
+			writeError(r.Context(), w, http.StatusNotFound, "CATEGORY_NOT_FOUND", "Category not found")
+		case "target category is required":
+			writeError(r.Context(), w, http.StatusBadRequest, "TARGET_CATEGORY_REQUIRED", "At least one target category is required")
+		case "category name must be 100 characters or less":
+			writeError(r.Context(), w, http.StatusBadRequest, "CATEGORY_NAME_TOO_LONG", err.Error())
+		default:
+			writeError(r.Context(), w, http.StatusInternalServerError, "MOVE_SAVED_RECIPE_FAILED", "Failed to move saved recipe")
+		}
+		return
+	}
+
+	observability.LogInfo(r.Context(), "saved recipe moved",
+		"user_id", userID.String(),
+		"post_id", postID.String(),
+	)
+
+	response := models.MoveSavedRecipeResponse{
+		SavedRecipes: savedRecipes,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		observability.LogError(r.Context(), observability.ErrorLog{
+			Message:    "failed to encode move saved recipe response",
+			Code:       "ENCODE_FAILED",
+			StatusCode: http.StatusOK,
+			UserID:     userID.String(),
+			Err:        err,
+		})
+	}
+}
+
 // UnsaveRecipe handles DELETE /api/v1/posts/{postId}/save
 func (h *SavedRecipeHandler) UnsaveRecipe(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodDelete {
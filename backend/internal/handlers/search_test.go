@@ -211,7 +211,7 @@ func TestSearchSectionScopeUsesContextSectionID(t *testing.T) {
 
 	mock.ExpectQuery(regexp.QuoteMeta("FROM links")).
 		WithArgs(postID).
-		WillReturnRows(sqlmock.NewRows([]string{"id", "url", "metadata", "created_at"}))
+		WillReturnRows(sqlmock.NewRows([]string{"id", "url", "metadata", "created_at", "is_dead", "last_checked_at"}))
 
 	mock.ExpectQuery(regexp.QuoteMeta("FROM post_images")).
 		WithArgs(postID).
@@ -237,9 +237,9 @@ func TestSearchSectionScopeUsesContextSectionID(t *testing.T) {
 		WithArgs(commentID).
 		WillReturnRows(sqlmock.NewRows([]string{"id", "url", "metadata", "created_at"}))
 
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT emoji, COUNT")).
-		WithArgs(commentID).
-		WillReturnRows(sqlmock.NewRows([]string{"emoji", "count"}))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT comment_id, emoji, COUNT")).
+		WithArgs(sqlmock.AnyArg(), uuid.Nil).
+		WillReturnRows(sqlmock.NewRows([]string{"comment_id", "emoji", "count", "viewer_reacted"}))
 
 	linkRows := sqlmock.NewRows([]string{"id", "url", "metadata", "post_id", "comment_id"}).
 		AddRow(linkID, "https://example.com", []byte(`{"title":"Example"}`), postID, nil)
@@ -359,7 +359,7 @@ func TestSearchSuccessGlobal(t *testing.T) {
 
 	mock.ExpectQuery(regexp.QuoteMeta("FROM links")).
 		WithArgs(postID).
-		WillReturnRows(sqlmock.NewRows([]string{"id", "url", "metadata", "created_at"}))
+		WillReturnRows(sqlmock.NewRows([]string{"id", "url", "metadata", "created_at", "is_dead", "last_checked_at"}))
 
 	mock.ExpectQuery(regexp.QuoteMeta("FROM post_images")).
 		WithArgs(postID).
@@ -385,9 +385,9 @@ func TestSearchSuccessGlobal(t *testing.T) {
 		WithArgs(commentID).
 		WillReturnRows(sqlmock.NewRows([]string{"id", "url", "metadata", "created_at"}))
 
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT emoji, COUNT")).
-		WithArgs(commentID).
-		WillReturnRows(sqlmock.NewRows([]string{"emoji", "count"}))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT comment_id, emoji, COUNT")).
+		WithArgs(sqlmock.AnyArg(), uuid.Nil).
+		WillReturnRows(sqlmock.NewRows([]string{"comment_id", "emoji", "count", "viewer_reacted"}))
 
 	linkRows := sqlmock.NewRows([]string{"id", "url", "metadata", "post_id", "comment_id"}).
 		AddRow(linkID, "https://example.com", []byte(`{"title":"Example"}`), postID, nil)
@@ -3,10 +3,13 @@ package handlers
 import (
 	"database/sql"
 	"encoding/json"
+	"errors"
+	"io"
 	"net/http"
 	"strings"
 
 	"github.com/google/uuid"
+	"github.com/sanderginn/clubhouse/internal/middleware"
 	"github.com/sanderginn/clubhouse/internal/models"
 	"github.com/sanderginn/clubhouse/internal/observability"
 	"github.com/sanderginn/clubhouse/internal/services"
@@ -229,3 +232,101 @@ func (h *SectionHandler) GetRecentPodcasts(w http.ResponseWriter, r *http.Reques
 		})
 	}
 }
+
+// UpdateReadPosition handles PUT /api/v1/sections/{sectionId}/read-position
+func (h *SectionHandler) UpdateReadPosition(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut {
+		writeError(r.Context(), w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "Only PUT requests are allowed")
+		return
+	}
+
+	userID, err := middleware.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeError(r.Context(), w, http.StatusUnauthorized, "UNAUTHORIZED", "Missing or invalid user ID")
+		return
+	}
+
+	pathParts := strings.Split(r.URL.Path, "/")
+	if len(pathParts) < 6 {
+		writeError(r.Context(), w, http.StatusBadRequest, "INVALID_REQUEST", "Section ID is required")
+		return
+	}
+
+	sectionID, err := uuid.Parse(pathParts[4])
+	if err != nil {
+		writeError(r.Context(), w, http.StatusBadRequest, "INVALID_SECTION_ID", "Invalid section ID format")
+		return
+	}
+
+	// An empty body marks the section as read up to now.
+	var req models.UpdateSectionReadPositionRequest
+	if err := decodeJSONBody(w, r, &req); err != nil && !errors.Is(err, io.EOF) {
+		if isRequestBodyTooLarge(err) {
+			writeError(r.Context(), w, http.StatusRequestEntityTooLarge, "REQUEST_TOO_LARGE", "Request body too large")
+			return
+		}
+		writeError(r.Context(), w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+		return
+	}
+
+	position, err := h.sectionService.UpdateReadPosition(r.Context(), userID, sectionID, req.LastReadAt)
+	if err != nil {
+		if err.Error() == "section not found" {
+			writeError(r.Context(), w, http.StatusNotFound, "SECTION_NOT_FOUND", "Section not found")
+			return
+		}
+		writeError(r.Context(), w, http.StatusInternalServerError, "UPDATE_READ_POSITION_FAILED", "Failed to update read position")
+		return
+	}
+
+	response := models.UpdateSectionReadPositionResponse{
+		ReadPosition: *position,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		observability.LogError(r.Context(), observability.ErrorLog{
+			Message:    "failed to encode section read position response",
+			Code:       "ENCODE_FAILED",
+			StatusCode: http.StatusOK,
+			Err:        err,
+		})
+	}
+}
+
+// GetMySectionActivity handles GET /api/v1/me/sections/activity
+func (h *SectionHandler) GetMySectionActivity(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(r.Context(), w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "Only GET requests are allowed")
+		return
+	}
+
+	userID, err := middleware.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeError(r.Context(), w, http.StatusUnauthorized, "UNAUTHORIZED", "Missing or invalid user ID")
+		return
+	}
+
+	activity, err := h.sectionService.GetSubscribedSectionActivity(r.Context(), userID)
+	if err != nil {
+		writeError(r.Context(), w, http.StatusInternalServerError, "GET_SECTION_ACTIVITY_FAILED", "Failed to get section activity")
+		return
+	}
+
+	response := models.SectionActivityResponse{
+		Sections: activity,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		observability.LogError(r.Context(), observability.ErrorLog{
+			Message:    "failed to encode section activity response",
+			Code:       "ENCODE_FAILED",
+			StatusCode: http.StatusOK,
+			UserID:     userID.String(),
+			Err:        err,
+		})
+	}
+}
@@ -1,16 +1,19 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"mime"
+	"mime/multipart"
 	"net/http"
 	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/sanderginn/clubhouse/internal/middleware"
@@ -22,6 +25,7 @@ const (
 	defaultUploadDir      = "uploads"
 	defaultUploadMaxBytes = int64(10 << 20) // 10MB
 	uploadFormOverhead    = int64(1 << 20)  // 1MB for multipart overhead
+	defaultUploadTimeout  = 2 * time.Minute
 )
 
 var errUploadTooLarge = errors.New("upload exceeds max size")
@@ -30,6 +34,7 @@ var errUploadTooLarge = errors.New("upload exceeds max size")
 type UploadHandler struct {
 	uploadDir    string
 	maxBytes     int64
+	timeout      time.Duration
 	allowedTypes map[string]string
 }
 
@@ -50,9 +55,18 @@ func NewUploadHandler() *UploadHandler {
 		}
 	}
 
+	// Uploads get their own read/write deadline instead of the server-wide timeouts
+	timeout := defaultUploadTimeout
+	if rawTimeout := strings.TrimSpace(os.Getenv("CLUBHOUSE_UPLOAD_TIMEOUT_SECONDS")); rawTimeout != "" {
+		if parsed, err := strconv.Atoi(rawTimeout); err == nil && parsed > 0 {
+			timeout = time.Duration(parsed) * time.Second
+		}
+	}
+
 	return &UploadHandler{
 		uploadDir: uploadDir,
 		maxBytes:  maxBytes,
+		timeout:   timeout,
 		allowedTypes: map[string]string{
 			"image/jpeg": ".jpg",
 			"image/png":  ".png",
@@ -85,45 +99,35 @@ func (h *UploadHandler) UploadImage(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	r.Body = http.MaxBytesReader(w, r.Body, h.maxBytes+uploadFormOverhead)
-	if err := r.ParseMultipartForm(h.maxBytes); err != nil {
-		if isRequestBodyTooLarge(err) {
-			observability.RecordUploadAttempt(r.Context(), "failure", "", 0)
-			writeError(r.Context(), w, http.StatusRequestEntityTooLarge, "FILE_TOO_LARGE", "Image exceeds the upload size limit")
-			return
-		}
-		observability.RecordUploadAttempt(r.Context(), "failure", "", 0)
-		writeError(r.Context(), w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid upload payload")
-		return
-	}
-	defer func() {
-		if r.MultipartForm != nil {
-			_ = r.MultipartForm.RemoveAll()
-		}
-	}()
+	h.extendDeadlines(r.Context(), w)
 
-	file, header, err := r.FormFile("file")
+	r.Body = http.MaxBytesReader(w, r.Body, h.maxBytes+uploadFormOverhead)
+	file, err := nextUploadFilePart(r, "file")
 	if err != nil {
 		if errors.Is(err, http.ErrMissingFile) {
 			observability.RecordUploadAttempt(r.Context(), "failure", "", 0)
 			writeError(r.Context(), w, http.StatusBadRequest, "FILE_REQUIRED", "Select an image to upload")
 			return
 		}
+		if isRequestBodyTooLarge(err) {
+			observability.RecordUploadAttempt(r.Context(), "failure", "", 0)
+			writeError(r.Context(), w, http.StatusRequestEntityTooLarge, "FILE_TOO_LARGE", "Image exceeds the upload size limit")
+			return
+		}
 		observability.RecordUploadAttempt(r.Context(), "failure", "", 0)
 		writeError(r.Context(), w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid upload payload")
 		return
 	}
 	defer file.Close()
 
-	if header.Size > h.maxBytes {
-		observability.RecordUploadAttempt(r.Context(), "failure", "", 0)
-		writeError(r.Context(), w, http.StatusRequestEntityTooLarge, "FILE_TOO_LARGE", "Image exceeds the upload size limit")
-		return
-	}
-
 	sniffBuffer := make([]byte, 512)
 	n, err := io.ReadFull(file, sniffBuffer)
-	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		if isRequestBodyTooLarge(err) {
+			observability.RecordUploadAttempt(r.Context(), "failure", "", 0)
+			writeError(r.Context(), w, http.StatusRequestEntityTooLarge, "FILE_TOO_LARGE", "Image exceeds the upload size limit")
+			return
+		}
 		observability.RecordUploadAttempt(r.Context(), "failure", "", 0)
 		writeError(r.Context(), w, http.StatusBadRequest, "INVALID_REQUEST", "Unable to read uploaded file")
 		return
@@ -152,8 +156,9 @@ func (h *UploadHandler) UploadImage(w http.ResponseWriter, r *http.Request) {
 
 	fileName := fmt.Sprintf("%s%s", uuid.New().String(), resolvedExt)
 	filePath := filepath.Join(userDir, fileName)
-	if err := writeUploadFile(filePath, sniffBuffer[:n], file, h.maxBytes); err != nil {
-		if errors.Is(err, errUploadTooLarge) {
+	size, err := writeUploadFile(filePath, sniffBuffer[:n], file, h.maxBytes)
+	if err != nil {
+		if errors.Is(err, errUploadTooLarge) || isRequestBodyTooLarge(err) {
 			observability.RecordUploadAttempt(r.Context(), "failure", mediaType, 0)
 			writeError(r.Context(), w, http.StatusRequestEntityTooLarge, "FILE_TOO_LARGE", "Image exceeds the upload size limit")
 			return
@@ -165,7 +170,7 @@ func (h *UploadHandler) UploadImage(w http.ResponseWriter, r *http.Request) {
 
 	url := fmt.Sprintf("/api/v1/uploads/%s/%s", userID.String(), fileName)
 	observability.LogInfo(r.Context(), "image uploaded", "user_id", userID.String(), "path", fileName)
-	observability.RecordUploadAttempt(r.Context(), "success", mediaType, header.Size)
+	observability.RecordUploadAttempt(r.Context(), "success", mediaType, size)
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
@@ -180,23 +185,81 @@ func (h *UploadHandler) UploadImage(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func writeUploadFile(path string, prefix []byte, src io.Reader, maxBytes int64) error {
+// extendDeadlines replaces the server-wide read/write timeouts for this request so large
+// uploads on slow connections are not cut off mid-transfer.
+// A writer that cannot adjust deadlines (http.ErrNotSupported) is logged, since the upload then
+// stays bound by the server-wide timeouts.
+func (h *UploadHandler) extendDeadlines(ctx context.Context, w http.ResponseWriter) {
+	deadline := time.Now().Add(h.timeout)
+	controller := http.NewResponseController(w)
+	if err := controller.SetReadDeadline(deadline); err != nil {
+		observability.LogWarn(ctx, "failed to extend upload read deadline",
+			"error", err.Error(),
+		)
+	}
+	if err := controller.SetWriteDeadline(deadline); err != nil {
+		observability.LogWarn(ctx, "failed to extend upload write deadline",
+			"error", err.Error(),
+		)
+	}
+}
+
+// nextUploadFilePart advances the multipart stream to the named file field without buffering
+// earlier parts in memory or on disk.
+func nextUploadFilePart(r *http.Request, fieldName string) (*multipart.Part, error) {
+	reader, err := r.MultipartReader()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			return nil, http.ErrMissingFile
+		}
+		if err != nil {
+			return nil, err
+		}
+		if part.FormName() == fieldName && part.FileName() != "" {
+			return part, nil
+		}
+		_ = part.Close()
+	}
+}
+
+// writeUploadFile streams src to path, stopping as soon as the size cap is exceeded.
+// Partially written files are removed on any failure.
+func writeUploadFile(path string, prefix []byte, src io.Reader, maxBytes int64) (size int64, err error) {
 	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
 	if err != nil {
-		return err
+		return 0, err
 	}
-	defer file.Close()
+	defer func() {
+		closeErr := file.Close()
+		if err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			_ = os.Remove(path)
+		}
+	}()
 
 	written, err := file.Write(prefix)
 	if err != nil {
-		return err
+		return 0, err
+	}
+	size = int64(written)
+	if size > maxBytes {
+		return 0, errUploadTooLarge
 	}
-	copied, err := io.Copy(file, src)
+
+	// Read one byte past the cap so an oversized upload is detected without consuming the rest
+	copied, err := io.Copy(file, io.LimitReader(src, maxBytes-size+1))
 	if err != nil {
-		return err
+		return 0, err
 	}
-	if int64(written)+copied > maxBytes {
-		return errUploadTooLarge
+	size += copied
+	if size > maxBytes {
+		return 0, errUploadTooLarge
 	}
-	return nil
+	return size, nil
 }
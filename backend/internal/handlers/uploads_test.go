@@ -4,14 +4,18 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"io"
 	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"net/textproto"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/sanderginn/clubhouse/internal/middleware"
@@ -114,6 +118,172 @@ func TestUploadImageRejectsLargeFile(t *testing.T) {
 	}
 }
 
+func TestUploadImageStreamsLargeFileWithinCap(t *testing.T) {
+	tempDir := t.TempDir()
+	t.Setenv("CLUBHOUSE_UPLOAD_DIR", tempDir)
+	t.Setenv("CLUBHOUSE_UPLOAD_MAX_BYTES", strconv.Itoa(4<<20))
+
+	handler := NewUploadHandler()
+	userID := uuid.New()
+
+	payload := append([]byte{0x89, 0x50, 0x4E, 0x47, 0x0D, 0x0A, 0x1A, 0x0A}, bytes.Repeat([]byte{0x00}, (4<<20)-8)...)
+	req := newMultipartRequest(t, "file", "large.png", "image/png", payload)
+	ctx := context.WithValue(req.Context(), middleware.UserContextKey, &services.Session{UserID: userID})
+	req = req.WithContext(ctx)
+
+	recorder := httptest.NewRecorder()
+	handler.UploadImage(recorder, req)
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, recorder.Code, recorder.Body.String())
+	}
+
+	var response models.ImageUploadResponse
+	if err := json.NewDecoder(recorder.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	info, err := os.Stat(filepath.Join(tempDir, filepath.FromSlash(strings.TrimPrefix(response.URL, "/api/v1/uploads/"))))
+	if err != nil {
+		t.Fatalf("expected uploaded file to exist: %v", err)
+	}
+	if info.Size() != int64(len(payload)) {
+		t.Fatalf("expected stored size %d, got %d", len(payload), info.Size())
+	}
+}
+
+func TestUploadImageRejectsOversizedFileMidStream(t *testing.T) {
+	tempDir := t.TempDir()
+	t.Setenv("CLUBHOUSE_UPLOAD_DIR", tempDir)
+	t.Setenv("CLUBHOUSE_UPLOAD_MAX_BYTES", strconv.Itoa(64<<10))
+
+	handler := NewUploadHandler()
+	userID := uuid.New()
+
+	// Stream far more than the cap plus multipart overhead; the handler must stop reading early.
+	const totalFileBytes = 8 << 20
+	bodyReader, bodyWriter := io.Pipe()
+	multipartWriter := multipart.NewWriter(bodyWriter)
+	var sent atomic.Int64
+	go func() {
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Disposition", `form-data; name="file"; filename="huge.png"`)
+		header.Set("Content-Type", "image/png")
+		part, err := multipartWriter.CreatePart(header)
+		if err != nil {
+			_ = bodyWriter.CloseWithError(err)
+			return
+		}
+		if _, err := part.Write([]byte{0x89, 0x50, 0x4E, 0x47, 0x0D, 0x0A, 0x1A, 0x0A}); err != nil {
+			_ = bodyWriter.CloseWithError(err)
+			return
+		}
+		chunk := bytes.Repeat([]byte{0x00}, 32<<10)
+		for written := 0; written < totalFileBytes; written += len(chunk) {
+			if _, err := part.Write(chunk); err != nil {
+				_ = bodyWriter.CloseWithError(err)
+				return
+			}
+			sent.Add(int64(len(chunk)))
+		}
+		_ = multipartWriter.Close()
+		_ = bodyWriter.Close()
+	}()
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/uploads", bodyReader)
+	req.Header.Set("Content-Type", multipartWriter.FormDataContentType())
+	ctx := context.WithValue(req.Context(), middleware.UserContextKey, &services.Session{UserID: userID})
+	req = req.WithContext(ctx)
+
+	recorder := httptest.NewRecorder()
+	handler.UploadImage(recorder, req)
+	_ = bodyReader.Close()
+
+	if recorder.Code != http.StatusRequestEntityTooLarge {
+		t.Fatalf("expected status %d, got %d", http.StatusRequestEntityTooLarge, recorder.Code)
+	}
+	if sent.Load() >= totalFileBytes {
+		t.Fatalf("expected upload to be rejected before the full body was read, sent %d bytes", sent.Load())
+	}
+
+	entries, err := os.ReadDir(filepath.Join(tempDir, userID.String()))
+	if err != nil && !os.IsNotExist(err) {
+		t.Fatalf("failed to read upload dir: %v", err)
+	}
+	if len(entries) != 0 {
+		t.Fatalf("expected partial upload to be removed, found %d files", len(entries))
+	}
+}
+
+func TestUploadImageExtendsDeadlinesThroughObservabilityMiddleware(t *testing.T) {
+	tempDir := t.TempDir()
+	t.Setenv("CLUBHOUSE_UPLOAD_DIR", tempDir)
+	t.Setenv("CLUBHOUSE_UPLOAD_TIMEOUT_SECONDS", "5")
+
+	handler := NewUploadHandler()
+	userID := uuid.New()
+
+	// The Observability middleware wraps the writer in its status recorder; the upload deadline
+	// must still reach the connection through it.
+	withUser := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), middleware.UserContextKey, &services.Session{UserID: userID})
+		handler.UploadImage(w, r.WithContext(ctx))
+	})
+	server := httptest.NewUnstartedServer(middleware.Observability(withUser))
+	server.Config.ReadTimeout = 100 * time.Millisecond
+	server.Config.WriteTimeout = 100 * time.Millisecond
+	server.Start()
+	defer server.Close()
+
+	// Stall mid-body for longer than the server-wide timeouts.
+	bodyReader, bodyWriter := io.Pipe()
+	multipartWriter := multipart.NewWriter(bodyWriter)
+	go func() {
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Disposition", `form-data; name="file"; filename="slow.png"`)
+		header.Set("Content-Type", "image/png")
+		part, err := multipartWriter.CreatePart(header)
+		if err != nil {
+			_ = bodyWriter.CloseWithError(err)
+			return
+		}
+		if _, err := part.Write([]byte{0x89, 0x50, 0x4E, 0x47, 0x0D, 0x0A, 0x1A, 0x0A}); err != nil {
+			_ = bodyWriter.CloseWithError(err)
+			return
+		}
+		time.Sleep(300 * time.Millisecond)
+		if _, err := part.Write([]byte{0x00, 0x00}); err != nil {
+			_ = bodyWriter.CloseWithError(err)
+			return
+		}
+		_ = multipartWriter.Close()
+		_ = bodyWriter.Close()
+	}()
+
+	req, err := http.NewRequest(http.MethodPost, server.URL+"/api/v1/uploads", bodyReader)
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+	req.Header.Set("Content-Type", multipartWriter.FormDataContentType())
+
+	resp, err := server.Client().Do(req)
+	if err != nil {
+		t.Fatalf("expected slow upload to complete, got %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, resp.StatusCode, body)
+	}
+	var response models.ImageUploadResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if !strings.HasPrefix(response.URL, "/api/v1/uploads/") {
+		t.Fatalf("expected upload URL, got %q", response.URL)
+	}
+}
+
 func newMultipartRequest(t *testing.T, fieldName, filename, contentType string, payload []byte) *http.Request {
 	t.Helper()
 
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/sanderginn/clubhouse/internal/middleware"
@@ -54,7 +55,10 @@ func (h *UserHandler) GetProfile(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	profile, err := h.userService.GetUserProfile(r.Context(), userID)
+	// Without a session the viewer is uuid.Nil and only sees public activity
+	viewerID, _ := middleware.GetUserIDFromContext(r.Context())
+
+	profile, err := h.userService.GetUserProfile(r.Context(), userID, viewerID)
 	if err != nil {
 		if err.Error() == "user not found" {
 			writeError(r.Context(), w, http.StatusNotFound, "USER_NOT_FOUND", "User not found")
@@ -119,8 +123,14 @@ func (h *UserHandler) GetUserPosts(w http.ResponseWriter, r *http.Request) {
 		cursorPtr = &cursor
 	}
 
+	includeCommentCounts, err := parseIncludeCommentCounts(r)
+	if err != nil {
+		writeError(r.Context(), w, http.StatusBadRequest, "INVALID_INCLUDE_COMMENT_COUNTS", "include_comment_counts must be a boolean")
+		return
+	}
+
 	viewerID, _ := middleware.GetUserIDFromContext(r.Context())
-	feed, err := h.postService.GetPostsByUserID(r.Context(), userID, cursorPtr, limit, viewerID)
+	feed, err := h.postService.GetPostsByUserID(r.Context(), userID, cursorPtr, limit, viewerID, includeCommentCounts)
 	if err != nil {
 		writeError(r.Context(), w, http.StatusInternalServerError, "GET_USER_POSTS_FAILED", "Failed to get user posts")
 		return
@@ -138,6 +148,87 @@ func (h *UserHandler) GetUserPosts(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// GetUserTopPosts handles GET /api/v1/users/{id}/top-posts
+func (h *UserHandler) GetUserTopPosts(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(r.Context(), w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "Only GET requests are allowed")
+		return
+	}
+
+	// Extract user ID from URL path: /api/v1/users/{id}/top-posts
+	pathParts := strings.Split(strings.TrimSuffix(r.URL.Path, "/"), "/")
+	if len(pathParts) < 6 || pathParts[5] != "top-posts" {
+		writeError(r.Context(), w, http.StatusBadRequest, "INVALID_REQUEST", "User ID is required")
+		return
+	}
+
+	userID, err := uuid.Parse(pathParts[4])
+	if err != nil {
+		writeError(r.Context(), w, http.StatusBadRequest, "INVALID_USER_ID", "Invalid user ID format")
+		return
+	}
+
+	viewerID, err := middleware.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeError(r.Context(), w, http.StatusUnauthorized, "UNAUTHORIZED", "Authentication required")
+		return
+	}
+
+	window := r.URL.Query().Get("window")
+	now := time.Now()
+	var since *time.Time
+	switch window {
+	case "", "all":
+		window = "all"
+	case "week":
+		start := now.AddDate(0, 0, -7)
+		since = &start
+	case "month":
+		start := now.AddDate(0, -1, 0)
+		since = &start
+	case "year":
+		start := now.AddDate(-1, 0, 0)
+		since = &start
+	default:
+		writeError(r.Context(), w, http.StatusBadRequest, "INVALID_WINDOW", "window must be week, month, year, or all")
+		return
+	}
+
+	limit := 0
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		if parsedLimit, err := strconv.Atoi(limitStr); err == nil && parsedLimit > 0 {
+			limit = parsedLimit
+		}
+	}
+
+	topPosts, err := h.postService.GetTopPostsByUser(r.Context(), userID, viewerID, since, limit)
+	if err != nil {
+		if err.Error() == "user not found" {
+			writeError(r.Context(), w, http.StatusNotFound, "USER_NOT_FOUND", "User not found")
+			return
+		}
+		writeError(r.Context(), w, http.StatusInternalServerError, "GET_USER_TOP_POSTS_FAILED", "Failed to get user top posts")
+		return
+	}
+
+	response := models.UserTopPostsResponse{
+		Window: window,
+		Posts:  topPosts,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		observability.LogError(r.Context(), observability.ErrorLog{
+			Message:    "failed to encode user top posts response",
+			Code:       "ENCODE_FAILED",
+			StatusCode: http.StatusOK,
+			UserID:     viewerID.String(),
+			Err:        err,
+		})
+	}
+}
+
 // GetUserComments handles GET /api/v1/users/{id}/comments
 func (h *UserHandler) GetUserComments(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodGet {
@@ -323,10 +414,18 @@ func (h *UserHandler) UpdateMe(w http.ResponseWriter, r *http.Request) {
 	// Update profile
 	response, err := h.userService.UpdateProfile(r.Context(), userID, &req)
 	if err != nil {
+		if errors.Is(err, services.ErrBioTooLong) {
+			writeError(r.Context(), w, http.StatusBadRequest, "BIO_TOO_LONG", err.Error())
+			return
+		}
+		if errors.Is(err, services.ErrProfilePictureURLTooLong) {
+			writeError(r.Context(), w, http.StatusBadRequest, "PROFILE_PICTURE_URL_TOO_LONG", err.Error())
+			return
+		}
 		switch err.Error() {
 		case "user not found":
 			writeError(r.Context(), w, http.StatusNotFound, "USER_NOT_FOUND", err.Error())
-		case "at least one field (bio or profile_picture_url) is required":
+		case "at least one field (bio, profile_picture_url, or activity_private) is required":
 			writeError(r.Context(), w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
 		case "invalid profile picture URL":
 			writeError(r.Context(), w, http.StatusBadRequest, "INVALID_URL", err.Error())
@@ -585,6 +684,415 @@ func (h *UserHandler) logUserAudit(ctx context.Context, action string, targetUse
 	}
 }
 
+// GetMyConfig handles GET /api/v1/me/config
+func (h *UserHandler) GetMyConfig(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(r.Context(), w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "Only GET requests are allowed")
+		return
+	}
+
+	userID, err := middleware.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeError(r.Context(), w, http.StatusUnauthorized, "UNAUTHORIZED", "Authentication required")
+		return
+	}
+
+	config, err := h.userService.GetEffectiveConfig(r.Context(), userID)
+	if err != nil {
+		if err.Error() == "user not found" {
+			writeError(r.Context(), w, http.StatusNotFound, "USER_NOT_FOUND", "User not found")
+			return
+		}
+		writeError(r.Context(), w, http.StatusInternalServerError, "GET_CONFIG_FAILED", "Failed to get config")
+		return
+	}
+
+	response := models.GetUserConfigResponse{
+		Config: *config,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		observability.LogError(r.Context(), observability.ErrorLog{
+			Message:    "failed to encode user config response",
+			Code:       "ENCODE_FAILED",
+			StatusCode: http.StatusOK,
+			Err:        err,
+		})
+	}
+}
+
+// GetUserRatingBias handles GET /api/v1/users/{id}/rating-bias
+func (h *UserHandler) GetUserRatingBias(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(r.Context(), w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "Only GET requests are allowed")
+		return
+	}
+
+	viewerID, err := middleware.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeError(r.Context(), w, http.StatusUnauthorized, "UNAUTHORIZED", "Authentication required")
+		return
+	}
+
+	// Extract user ID from URL path: /api/v1/users/{id}/rating-bias
+	pathParts := strings.Split(strings.TrimSuffix(r.URL.Path, "/"), "/")
+	if len(pathParts) < 6 || pathParts[5] != "rating-bias" {
+		writeError(r.Context(), w, http.StatusBadRequest, "INVALID_REQUEST", "User ID is required")
+		return
+	}
+	targetUserID, err := uuid.Parse(pathParts[4])
+	if err != nil {
+		writeError(r.Context(), w, http.StatusBadRequest, "INVALID_USER_ID", "Invalid user ID format")
+		return
+	}
+
+	if targetUserID != viewerID {
+		activityPrivate, err := h.userService.IsActivityPrivate(r.Context(), targetUserID)
+		if err != nil {
+			if err.Error() == "user not found" {
+				writeError(r.Context(), w, http.StatusNotFound, "USER_NOT_FOUND", "User not found")
+				return
+			}
+			writeError(r.Context(), w, http.StatusInternalServerError, "GET_RATING_BIAS_FAILED", "Failed to get rating bias")
+			return
+		}
+		if activityPrivate {
+			writeRatingBiasResponse(w, r, models.UserRatingBias{
+				UserID:   targetUserID,
+				Sections: []models.SectionRatingBias{},
+			})
+			return
+		}
+	}
+
+	bias, err := h.userService.GetRatingBias(r.Context(), targetUserID)
+	if err != nil {
+		if err.Error() == "user not found" {
+			writeError(r.Context(), w, http.StatusNotFound, "USER_NOT_FOUND", "User not found")
+			return
+		}
+		writeError(r.Context(), w, http.StatusInternalServerError, "GET_RATING_BIAS_FAILED", "Failed to get rating bias")
+		return
+	}
+
+	writeRatingBiasResponse(w, r, *bias)
+}
+
+func writeRatingBiasResponse(w http.ResponseWriter, r *http.Request, bias models.UserRatingBias) {
+	response := models.GetUserRatingBiasResponse{
+		RatingBias: bias,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		observability.LogError(r.Context(), observability.ErrorLog{
+			Message:    "failed to encode rating bias response",
+			Code:       "ENCODE_FAILED",
+			StatusCode: http.StatusOK,
+			Err:        err,
+		})
+	}
+}
+
+// GetUserLastActive handles GET /api/v1/users/{id}/last-active
+func (h *UserHandler) GetUserLastActive(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(r.Context(), w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "Only GET requests are allowed")
+		return
+	}
+
+	viewerID, err := middleware.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeError(r.Context(), w, http.StatusUnauthorized, "UNAUTHORIZED", "Authentication required")
+		return
+	}
+
+	// Extract user ID from URL path: /api/v1/users/{id}/last-active
+	pathParts := strings.Split(strings.TrimSuffix(r.URL.Path, "/"), "/")
+	if len(pathParts) < 6 || pathParts[5] != "last-active" {
+		writeError(r.Context(), w, http.StatusBadRequest, "INVALID_REQUEST", "User ID is required")
+		return
+	}
+	targetUserID, err := uuid.Parse(pathParts[4])
+	if err != nil {
+		writeError(r.Context(), w, http.StatusBadRequest, "INVALID_USER_ID", "Invalid user ID format")
+		return
+	}
+
+	lastActive, err := h.userService.GetLastActive(r.Context(), targetUserID, viewerID)
+	if err != nil {
+		if err.Error() == "user not found" {
+			writeError(r.Context(), w, http.StatusNotFound, "USER_NOT_FOUND", "User not found")
+			return
+		}
+		writeError(r.Context(), w, http.StatusInternalServerError, "GET_LAST_ACTIVE_FAILED", "Failed to get last active time")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(lastActive); err != nil {
+		observability.LogError(r.Context(), observability.ErrorLog{
+			Message:    "failed to encode last active response",
+			Code:       "ENCODE_FAILED",
+			StatusCode: http.StatusOK,
+			UserID:     viewerID.String(),
+			Err:        err,
+		})
+	}
+}
+
+// GetMyDigestPreferences handles GET /api/v1/users/me/digest-preferences
+func (h *UserHandler) GetMyDigestPreferences(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(r.Context(), w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "Only GET requests are allowed")
+		return
+	}
+
+	userID, err := middleware.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeError(r.Context(), w, http.StatusUnauthorized, "UNAUTHORIZED", "Authentication required")
+		return
+	}
+
+	preferences, err := h.userService.GetDigestPreferences(r.Context(), userID)
+	if err != nil {
+		if err.Error() == "user not found" {
+			writeError(r.Context(), w, http.StatusNotFound, "USER_NOT_FOUND", "User not found")
+			return
+		}
+		writeError(r.Context(), w, http.StatusInternalServerError, "GET_DIGEST_PREFERENCES_FAILED", "Failed to get digest preferences")
+		return
+	}
+
+	writeDigestPreferencesResponse(w, r, preferences)
+}
+
+// UpdateMyDigestPreferences handles PATCH /api/v1/users/me/digest-preferences
+func (h *UserHandler) UpdateMyDigestPreferences(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPatch {
+		writeError(r.Context(), w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "Only PATCH requests are allowed")
+		return
+	}
+
+	userID, err := middleware.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeError(r.Context(), w, http.StatusUnauthorized, "UNAUTHORIZED", "Authentication required")
+		return
+	}
+
+	var req models.UpdateDigestPreferencesRequest
+	if err := decodeJSONBody(w, r, &req); err != nil {
+		if isRequestBodyTooLarge(err) {
+			writeError(r.Context(), w, http.StatusRequestEntityTooLarge, "REQUEST_TOO_LARGE", "Request body too large")
+			return
+		}
+		writeError(r.Context(), w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+		return
+	}
+
+	preferences, err := h.userService.UpdateDigestPreferences(r.Context(), userID, req.Frequency)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidDigestFrequency) {
+			writeError(r.Context(), w, http.StatusBadRequest, "INVALID_DIGEST_FREQUENCY", err.Error())
+			return
+		}
+		if err.Error() == "user not found" {
+			writeError(r.Context(), w, http.StatusNotFound, "USER_NOT_FOUND", "User not found")
+			return
+		}
+		writeError(r.Context(), w, http.StatusInternalServerError, "UPDATE_DIGEST_PREFERENCES_FAILED", "Failed to update digest preferences")
+		return
+	}
+
+	writeDigestPreferencesResponse(w, r, preferences)
+}
+
+func writeDigestPreferencesResponse(w http.ResponseWriter, r *http.Request, preferences *models.DigestPreferences) {
+	response := models.DigestPreferencesResponse{
+		DigestPreferences: *preferences,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		observability.LogError(r.Context(), observability.ErrorLog{
+			Message:    "failed to encode digest preferences response",
+			Code:       "ENCODE_FAILED",
+			StatusCode: http.StatusOK,
+			Err:        err,
+		})
+	}
+}
+
+// GetMyNotificationPreferences handles GET /api/v1/users/me/notification-preferences
+func (h *UserHandler) GetMyNotificationPreferences(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(r.Context(), w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "Only GET requests are allowed")
+		return
+	}
+
+	userID, err := middleware.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeError(r.Context(), w, http.StatusUnauthorized, "UNAUTHORIZED", "Authentication required")
+		return
+	}
+
+	preferences, err := h.userService.GetNotificationPreferences(r.Context(), userID)
+	if err != nil {
+		if err.Error() == "user not found" {
+			writeError(r.Context(), w, http.StatusNotFound, "USER_NOT_FOUND", "User not found")
+			return
+		}
+		writeError(r.Context(), w, http.StatusInternalServerError, "GET_NOTIFICATION_PREFERENCES_FAILED", "Failed to get notification preferences")
+		return
+	}
+
+	writeNotificationPreferencesResponse(w, r, preferences)
+}
+
+// UpdateMyNotificationPreferences handles PATCH /api/v1/users/me/notification-preferences
+func (h *UserHandler) UpdateMyNotificationPreferences(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPatch {
+		writeError(r.Context(), w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "Only PATCH requests are allowed")
+		return
+	}
+
+	userID, err := middleware.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeError(r.Context(), w, http.StatusUnauthorized, "UNAUTHORIZED", "Authentication required")
+		return
+	}
+
+	var req models.UpdateNotificationPreferencesRequest
+	if err := decodeJSONBody(w, r, &req); err != nil {
+		if isRequestBodyTooLarge(err) {
+			writeError(r.Context(), w, http.StatusRequestEntityTooLarge, "REQUEST_TOO_LARGE", "Request body too large")
+			return
+		}
+		writeError(r.Context(), w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+		return
+	}
+
+	preferences, err := h.userService.UpdateNotificationPreferences(r.Context(), userID, &req)
+	if err != nil {
+		switch err.Error() {
+		case "comment_reactions is required":
+			writeError(r.Context(), w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		case "user not found":
+			writeError(r.Context(), w, http.StatusNotFound, "USER_NOT_FOUND", "User not found")
+		default:
+			writeError(r.Context(), w, http.StatusInternalServerError, "UPDATE_NOTIFICATION_PREFERENCES_FAILED", "Failed to update notification preferences")
+		}
+		return
+	}
+
+	writeNotificationPreferencesResponse(w, r, preferences)
+}
+
+func writeNotificationPreferencesResponse(w http.ResponseWriter, r *http.Request, preferences *models.NotificationPreferences) {
+	response := models.NotificationPreferencesResponse{
+		NotificationPreferences: *preferences,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		observability.LogError(r.Context(), observability.ErrorLog{
+			Message:    "failed to encode notification preferences response",
+			Code:       "ENCODE_FAILED",
+			StatusCode: http.StatusOK,
+			Err:        err,
+		})
+	}
+}
+
+// GetMyTimezone handles GET /api/v1/users/me/timezone
+func (h *UserHandler) GetMyTimezone(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(r.Context(), w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "Only GET requests are allowed")
+		return
+	}
+
+	userID, err := middleware.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeError(r.Context(), w, http.StatusUnauthorized, "UNAUTHORIZED", "Authentication required")
+		return
+	}
+
+	preference, err := h.userService.GetTimezone(r.Context(), userID)
+	if err != nil {
+		if err.Error() == "user not found" {
+			writeError(r.Context(), w, http.StatusNotFound, "USER_NOT_FOUND", "User not found")
+			return
+		}
+		writeError(r.Context(), w, http.StatusInternalServerError, "GET_TIMEZONE_FAILED", "Failed to get timezone")
+		return
+	}
+
+	writeTimezonePreferenceResponse(w, r, preference)
+}
+
+// UpdateMyTimezone handles PATCH /api/v1/users/me/timezone
+func (h *UserHandler) UpdateMyTimezone(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPatch {
+		writeError(r.Context(), w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "Only PATCH requests are allowed")
+		return
+	}
+
+	userID, err := middleware.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeError(r.Context(), w, http.StatusUnauthorized, "UNAUTHORIZED", "Authentication required")
+		return
+	}
+
+	var req models.UpdateTimezoneRequest
+	if err := decodeJSONBody(w, r, &req); err != nil {
+		if isRequestBodyTooLarge(err) {
+			writeError(r.Context(), w, http.StatusRequestEntityTooLarge, "REQUEST_TOO_LARGE", "Request body too large")
+			return
+		}
+		writeError(r.Context(), w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+		return
+	}
+
+	preference, err := h.userService.UpdateTimezone(r.Context(), userID, req.Timezone)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidTimezone) {
+			writeError(r.Context(), w, http.StatusBadRequest, "INVALID_TIMEZONE", err.Error())
+			return
+		}
+		if err.Error() == "user not found" {
+			writeError(r.Context(), w, http.StatusNotFound, "USER_NOT_FOUND", "User not found")
+			return
+		}
+		writeError(r.Context(), w, http.StatusInternalServerError, "UPDATE_TIMEZONE_FAILED", "Failed to update timezone")
+		return
+	}
+
+	writeTimezonePreferenceResponse(w, r, preference)
+}
+
+func writeTimezonePreferenceResponse(w http.ResponseWriter, r *http.Request, preference *models.TimezonePreference) {
+	response := models.TimezonePreferenceResponse{
+		TimezonePreference: *preference,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		observability.LogError(r.Context(), observability.ErrorLog{
+			Message:    "failed to encode timezone response",
+			Code:       "ENCODE_FAILED",
+			StatusCode: http.StatusOK,
+			Err:        err,
+		})
+	}
+}
+
 // GetMySectionSubscriptions handles GET /api/v1/users/me/section-subscriptions
 func (h *UserHandler) GetMySectionSubscriptions(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodGet {
@@ -1098,6 +1098,34 @@ func TestUpdateMeEmptyBody(t *testing.T) {
 	}
 }
 
+// TestUpdateMeBioTooLong tests that an over-long bio is rejected before touching the database
+func TestUpdateMeBioTooLong(t *testing.T) {
+	handler := NewUserHandler(nil)
+
+	reqBody, err := json.Marshal(map[string]string{"bio": strings.Repeat("a", services.GetConfigService().GetSettings().MaxBioLength+1)})
+	if err != nil {
+		t.Fatalf("failed to marshal request: %v", err)
+	}
+	req := httptest.NewRequest("PATCH", "/api/v1/users/me", strings.NewReader(string(reqBody)))
+	req.Header.Set("Content-Type", "application/json")
+	req = req.WithContext(createTestUserContext(req.Context(), uuid.New(), "longbiouser", false))
+
+	w := httptest.NewRecorder()
+	handler.UpdateMe(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d. Body: %s", http.StatusBadRequest, w.Code, w.Body.String())
+	}
+
+	var response models.ErrorResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Errorf("failed to decode response: %v", err)
+	}
+	if response.Code != "BIO_TOO_LONG" {
+		t.Errorf("expected code BIO_TOO_LONG, got %s", response.Code)
+	}
+}
+
 // TestUpdateMeMethodNotAllowed tests with non-PATCH method
 func TestUpdateMeMethodNotAllowed(t *testing.T) {
 	db := testutil.RequireTestDB(t)
@@ -1157,6 +1185,93 @@ func TestUpdateMeNoAuth(t *testing.T) {
 	}
 }
 
+func TestGetMyConfigReflectsAdminConfigAndUserOverrides(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	services.ResetConfigServiceForTests()
+	t.Cleanup(func() { services.ResetConfigServiceForTests() })
+
+	mfaRequired := true
+	timezone := "Europe/Amsterdam"
+	if _, err := services.GetConfigService().UpdateConfig(context.Background(), services.ConfigUpdate{MFARequired: &mfaRequired, DisplayTimezone: &timezone}); err != nil {
+		t.Fatalf("failed to update config: %v", err)
+	}
+
+	plainUserID := uuid.MustParse(testutil.CreateTestUser(t, db, "configplain", "configplain@test.com", false, true))
+	mfaUserID := uuid.MustParse(testutil.CreateTestUser(t, db, "configmfa", "configmfa@test.com", false, true))
+	sectionID := uuid.MustParse(testutil.CreateTestSection(t, db, "Config Section", "general"))
+
+	if _, err := db.Exec(`UPDATE users SET totp_enabled = true, activity_private = true WHERE id = $1`, mfaUserID); err != nil {
+		t.Fatalf("failed to update user settings: %v", err)
+	}
+	if _, err := db.Exec(`
+		INSERT INTO section_subscriptions (user_id, section_id, opted_out_at)
+		VALUES ($1, $2, now())
+	`, mfaUserID, sectionID); err != nil {
+		t.Fatalf("failed to create section subscription: %v", err)
+	}
+
+	handler := NewUserHandler(db)
+	getConfig := func(userID uuid.UUID, username string) models.UserConfig {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/me/config", nil)
+		req = req.WithContext(createTestUserContext(req.Context(), userID, username, false))
+		w := httptest.NewRecorder()
+
+		handler.GetMyConfig(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d. Body: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+		var response models.GetUserConfigResponse
+		if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		return response.Config
+	}
+
+	plainConfig := getConfig(plainUserID, "configplain")
+	if plainConfig.DisplayTimezone != timezone {
+		t.Errorf("expected timezone %s, got %s", timezone, plainConfig.DisplayTimezone)
+	}
+	if !plainConfig.MFARequired || !plainConfig.MFASetupRequired {
+		t.Errorf("expected MFA setup to be required, got %+v", plainConfig)
+	}
+	if plainConfig.ActivityPrivate {
+		t.Error("expected activity to be public by default")
+	}
+	if len(plainConfig.MutedSectionIDs) != 0 {
+		t.Errorf("expected no muted sections, got %v", plainConfig.MutedSectionIDs)
+	}
+
+	mfaConfig := getConfig(mfaUserID, "configmfa")
+	if !mfaConfig.MFARequired || !mfaConfig.MFAEnabled {
+		t.Errorf("expected MFA required and enabled, got %+v", mfaConfig)
+	}
+	if mfaConfig.MFASetupRequired {
+		t.Error("expected MFA setup not to be required for enrolled user")
+	}
+	if !mfaConfig.ActivityPrivate {
+		t.Error("expected activity private override")
+	}
+	if len(mfaConfig.MutedSectionIDs) != 1 || mfaConfig.MutedSectionIDs[0] != sectionID {
+		t.Errorf("expected muted section %s, got %v", sectionID, mfaConfig.MutedSectionIDs)
+	}
+}
+
+func TestGetMyConfigRequiresAuth(t *testing.T) {
+	handler := NewUserHandler(nil)
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/me/config", nil)
+	w := httptest.NewRecorder()
+
+	handler.GetMyConfig(w, req)
+
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("expected status %d, got %d", http.StatusUnauthorized, w.Code)
+	}
+}
+
 func TestGetMySectionSubscriptionsSuccess(t *testing.T) {
 	db := testutil.RequireTestDB(t)
 	t.Cleanup(func() { testutil.CleanupTables(t, db) })
@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sanderginn/clubhouse/internal/buildinfo"
+	"github.com/sanderginn/clubhouse/internal/models"
+)
+
+func TestGetVersionReturnsInjectedBuildInfo(t *testing.T) {
+	previousVersion, previousCommit := buildinfo.Version, buildinfo.Commit
+	buildinfo.Version = "1.4.2"
+	buildinfo.Commit = "abc1234"
+	t.Cleanup(func() {
+		buildinfo.Version = previousVersion
+		buildinfo.Commit = previousCommit
+	})
+
+	handler := NewVersionHandler()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/version", nil)
+	w := httptest.NewRecorder()
+
+	handler.GetVersion(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d. Body: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+
+	var response models.VersionResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if response.Version != "1.4.2" {
+		t.Fatalf("expected version 1.4.2, got %q", response.Version)
+	}
+	if response.Commit != "abc1234" {
+		t.Fatalf("expected commit abc1234, got %q", response.Commit)
+	}
+	if response.APIVersion != buildinfo.APIVersion {
+		t.Fatalf("expected api version %q, got %q", buildinfo.APIVersion, response.APIVersion)
+	}
+}
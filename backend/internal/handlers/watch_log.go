@@ -3,6 +3,7 @@ package handlers
 import (
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"strconv"
 
@@ -72,6 +73,10 @@ func (h *WatchLogHandler) LogWatch(w http.ResponseWriter, r *http.Request) {
 
 	watchLog, err := h.watchLogService.LogWatchAt(r.Context(), userID, postID, req.Rating, notes, &req.WatchedAt)
 	if err != nil {
+		if errors.Is(err, services.ErrLogNoteTooLong) {
+			writeError(r.Context(), w, http.StatusBadRequest, "NOTES_TOO_LONG", err.Error())
+			return
+		}
 		switch err.Error() {
 		case "rating must be between 1 and 5":
 			writeError(r.Context(), w, http.StatusBadRequest, "INVALID_RATING", err.Error())
@@ -160,6 +165,10 @@ func (h *WatchLogHandler) UpdateWatchLog(w http.ResponseWriter, r *http.Request)
 
 	watchLog, err := h.watchLogService.UpdateWatchLog(r.Context(), userID, postID, req.Rating, req.Notes)
 	if err != nil {
+		if errors.Is(err, services.ErrLogNoteTooLong) {
+			writeError(r.Context(), w, http.StatusBadRequest, "NOTES_TOO_LONG", err.Error())
+			return
+		}
 		switch err.Error() {
 		case "no fields to update":
 			writeError(r.Context(), w, http.StatusBadRequest, "NO_FIELDS_TO_UPDATE", err.Error())
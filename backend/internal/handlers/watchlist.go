@@ -124,6 +124,76 @@ func (h *WatchlistHandler) AddToWatchlist(w http.ResponseWriter, r *http.Request
 	}
 }
 
+// MoveWatchlistItem handles PATCH /api/v1/posts/{postId}/watchlist/category.
+func (h *WatchlistHandler) MoveWatchlistItem(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPatch {
+		writeError(r.Context(), w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "Only PATCH requests are allowed")
+		return
+	}
+
+	userID, err := middleware.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeError(r.Context(), w, http.StatusUnauthorized, "UNAUTHORIZED", "Missing or invalid user ID")
+		return
+	}
+
+	postID, err := extractPostIDFromPath(r.URL.Path)
+	if err != nil {
+		writeError(r.Context(), w, http.StatusBadRequest, "INVALID_POST_ID", "Invalid post ID format")
+		return
+	}
+
+	var req models.MoveWatchlistItemRequest
+	if err := decodeJSONBody(w, r, &req); err != nil {
+		if isRequestBodyTooLarge(err) {
+			writeError(r.Context(), w, http.StatusRequestEntityTooLarge, "REQUEST_TOO_LARGE", "Request body too large")
+			return
+		}
+		writeError(r.Context(), w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+		return
+	}
+
+	items, err := h.watchlistService.MoveWatchlistItem(r.Context(), userID, postID, req.FromCategory, req.ToCategories)
+	if err != nil {
+		switch err.Error() {
+		case "movie or series post not found":
+			writeError(r.Context(), w, http.StatusNotFound, "POST_NOT_FOUND", "Post not found")
+		case "watchlist item not found":
+			writeError(r.Context(), w, http.StatusNotFound, "WATCHLIST_ITEM_NOT_FOUND", "Watchlist item not found")
+		case "category not found":
+			writeError(r.Context(), w, http.StatusNotFound, "CATEGORY_NOT_FOUND", "Category not found")
+		case "target category is required":
+			writeError(r.Context(), w, http.StatusBadRequest, "TARGET_CATEGORY_REQUIRED", "At least one target category is required")
+		case "category name must be 100 characters or less":
+			writeError(r.Context(), w, http.StatusBadRequest, "CATEGORY_NAME_TOO_LONG", err.Error())
+		default:
+			writeError(r.Context(), w, http.StatusInternalServerError, "WATCHLIST_MOVE_FAILED", "Failed to move watchlist item")
+		}
+		return
+	}
+
+	observability.LogInfo(r.Context(), "watchlist item moved",
+		"user_id", userID.String(),
+		"post_id", postID.String(),
+	)
+
+	response := models.MoveWatchlistItemResponse{
+		WatchlistItems: items,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		observability.LogError(r.Context(), observability.ErrorLog{
+			Message:    "failed to encode move watchlist item response",
+			Code:       "ENCODE_FAILED",
+			StatusCode: http.StatusOK,
+			UserID:     userID.String(),
+			Err:        err,
+		})
+	}
+}
+
 // RemoveFromWatchlist handles DELETE /api/v1/posts/{postId}/watchlist.
 func (h *WatchlistHandler) RemoveFromWatchlist(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodDelete {
@@ -300,6 +370,120 @@ func (h *WatchlistHandler) ListWatchlistCategories(w http.ResponseWriter, r *htt
 	}
 }
 
+// ListWatchlistCategoryCounts handles GET /api/v1/me/watchlist/categories.
+func (h *WatchlistHandler) ListWatchlistCategoryCounts(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(r.Context(), w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "Only GET requests are allowed")
+		return
+	}
+
+	userID, err := middleware.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeError(r.Context(), w, http.StatusUnauthorized, "UNAUTHORIZED", "Missing or invalid user ID")
+		return
+	}
+
+	counts, err := h.watchlistService.GetUserWatchlistCategoryCounts(r.Context(), userID)
+	if err != nil {
+		writeError(r.Context(), w, http.StatusInternalServerError, "GET_WATCHLIST_CATEGORY_COUNTS_FAILED", "Failed to get watchlist category counts")
+		return
+	}
+
+	response := models.ListWatchlistCategoryCountsResponse{
+		Categories: counts,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		observability.LogError(r.Context(), observability.ErrorLog{
+			Message:    "failed to encode watchlist category counts response",
+			Code:       "ENCODE_FAILED",
+			StatusCode: http.StatusOK,
+			Err:        err,
+		})
+	}
+}
+
+// GetUserMovies handles GET /api/v1/users/{id}/movies.
+func (h *WatchlistHandler) GetUserMovies(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(r.Context(), w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "Only GET requests are allowed")
+		return
+	}
+
+	viewerID, err := middleware.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeError(r.Context(), w, http.StatusUnauthorized, "UNAUTHORIZED", "Missing or invalid user ID")
+		return
+	}
+
+	// Extract user ID from URL path: /api/v1/users/{id}/movies
+	pathParts := strings.Split(strings.TrimSuffix(r.URL.Path, "/"), "/")
+	if len(pathParts) < 6 || pathParts[5] != "movies" {
+		writeError(r.Context(), w, http.StatusBadRequest, "INVALID_REQUEST", "User ID is required")
+		return
+	}
+	targetUserID, err := uuid.Parse(pathParts[4])
+	if err != nil {
+		writeError(r.Context(), w, http.StatusBadRequest, "INVALID_USER_ID", "Invalid user ID format")
+		return
+	}
+
+	limit := 20
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		if parsed, err := strconv.Atoi(limitStr); err == nil && parsed > 0 {
+			limit = parsed
+		}
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	cursor := r.URL.Query().Get("cursor")
+	var cursorPtr *string
+	if cursor != "" {
+		cursorPtr = &cursor
+	}
+
+	filter := services.UserMoviesFilter{
+		Status:   r.URL.Query().Get("status"),
+		Category: r.URL.Query().Get("category"),
+	}
+
+	items, nextCursor, err := h.watchlistService.GetUserMovies(r.Context(), targetUserID, viewerID, filter, limit, cursorPtr)
+	if err != nil {
+		switch err.Error() {
+		case "user not found":
+			writeError(r.Context(), w, http.StatusNotFound, "USER_NOT_FOUND", "User not found")
+		case "invalid status":
+			writeError(r.Context(), w, http.StatusBadRequest, "INVALID_STATUS", "status must be either watchlist or watched")
+		case "invalid cursor":
+			writeError(r.Context(), w, http.StatusBadRequest, "INVALID_CURSOR", err.Error())
+		default:
+			writeError(r.Context(), w, http.StatusInternalServerError, "GET_USER_MOVIES_FAILED", "Failed to get user movies")
+		}
+		return
+	}
+
+	response := models.ListUserMoviesResponse{
+		Items:      items,
+		NextCursor: nextCursor,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		observability.LogError(r.Context(), observability.ErrorLog{
+			Message:    "failed to encode user movies response",
+			Code:       "ENCODE_FAILED",
+			StatusCode: http.StatusOK,
+			UserID:     viewerID.String(),
+			Err:        err,
+		})
+	}
+}
+
 // CreateWatchlistCategory handles POST /api/v1/me/watchlist-categories.
 func (h *WatchlistHandler) CreateWatchlistCategory(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
@@ -32,6 +32,8 @@ const (
 	wsPing                = "ping"
 	wsCloseReplacedCode   = 4000
 	wsCloseReplacedReason = "replaced"
+	wsShutdownReason      = "server shutting down"
+	wsDrainPollInterval   = 50 * time.Millisecond
 	userMentions          = "user:%s:mentions"
 	userNotify            = "user:%s:notifications"
 	sectionPrefix         = "section:%s"
@@ -130,6 +132,38 @@ func (h *WebSocketHandler) HandleWS(w http.ResponseWriter, r *http.Request) {
 	h.readLoop(ctx, wsConn)
 }
 
+// Shutdown closes every active connection and waits for their handlers to finish.
+// It returns the context error if connections are still open when ctx is done.
+func (h *WebSocketHandler) Shutdown(ctx context.Context) error {
+	h.mu.RLock()
+	active := make([]*wsConnection, 0, len(h.connections))
+	for _, wsConn := range h.connections {
+		active = append(active, wsConn)
+	}
+	h.mu.RUnlock()
+
+	for _, wsConn := range active {
+		h.closeConnection(wsConn, websocket.CloseGoingAway, wsShutdownReason)
+	}
+
+	ticker := time.NewTicker(wsDrainPollInterval)
+	defer ticker.Stop()
+	for {
+		h.mu.RLock()
+		remaining := len(h.connections)
+		h.mu.RUnlock()
+		if remaining == 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
 func (h *WebSocketHandler) registerConnection(ctx context.Context, userID uuid.UUID, wsConn *wsConnection) {
 	h.mu.Lock()
 	if existing := h.connections[userID]; existing != nil {
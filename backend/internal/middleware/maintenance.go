@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/sanderginn/clubhouse/internal/services"
+)
+
+// maintenanceExemptPrefixes lists paths that keep accepting writes during maintenance so
+// admins can still sign in, manage the instance, and turn maintenance mode back off.
+var maintenanceExemptPrefixes = []string{
+	"/api/v1/admin/",
+	"/api/v1/auth/login",
+	"/api/v1/auth/logout",
+	"/api/v1/auth/csrf",
+}
+
+// MaintenanceMode middleware rejects state-changing requests while maintenance mode is enabled.
+// Reads and admin endpoints are always allowed through.
+func MaintenanceMode(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !isWriteMethod(r.Method) || isMaintenanceExemptPath(r.URL.Path) || !services.GetConfigService().IsMaintenanceMode() {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Set("Retry-After", "300")
+		writeAuthError(r.Context(), w, http.StatusServiceUnavailable, "MAINTENANCE_MODE", "Clubhouse is in maintenance mode; changes are temporarily disabled")
+	})
+}
+
+func isWriteMethod(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+func isMaintenanceExemptPath(path string) bool {
+	for _, prefix := range maintenanceExemptPrefixes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
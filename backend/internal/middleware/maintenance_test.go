@@ -0,0 +1,98 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sanderginn/clubhouse/internal/services"
+)
+
+func enableMaintenanceMode(t *testing.T) {
+	t.Helper()
+	services.ResetConfigServiceForTests()
+	t.Cleanup(services.ResetConfigServiceForTests)
+
+	enabled := true
+	if _, err := services.GetConfigService().UpdateConfig(context.Background(), services.ConfigUpdate{MaintenanceMode: &enabled}); err != nil {
+		t.Fatalf("failed to enable maintenance mode: %v", err)
+	}
+}
+
+func TestMaintenanceModeBlocksWrites(t *testing.T) {
+	enableMaintenanceMode(t)
+
+	called := false
+	handler := MaintenanceMode(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusCreated)
+	}))
+
+	for _, method := range []string{http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete} {
+		called = false
+		req := httptest.NewRequest(method, "/api/v1/posts", nil)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusServiceUnavailable {
+			t.Fatalf("%s: expected status 503, got %d", method, rec.Code)
+		}
+		if called {
+			t.Fatalf("%s: expected handler not to be called", method)
+		}
+
+		var response map[string]string
+		if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+			t.Fatalf("%s: failed to decode response: %v", method, err)
+		}
+		if response["code"] != "MAINTENANCE_MODE" {
+			t.Fatalf("%s: expected MAINTENANCE_MODE code, got %q", method, response["code"])
+		}
+	}
+}
+
+func TestMaintenanceModeAllowsReadsAndAdminWrites(t *testing.T) {
+	enableMaintenanceMode(t)
+
+	handler := MaintenanceMode(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	tests := []struct {
+		method string
+		path   string
+	}{
+		{http.MethodGet, "/api/v1/posts/123"},
+		{http.MethodHead, "/api/v1/sections"},
+		{http.MethodPatch, "/api/v1/admin/config"},
+		{http.MethodPost, "/api/v1/auth/login"},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest(tt.method, tt.path, nil)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("%s %s: expected status 200, got %d", tt.method, tt.path, rec.Code)
+		}
+	}
+}
+
+func TestMaintenanceModeDisabledAllowsWrites(t *testing.T) {
+	services.ResetConfigServiceForTests()
+
+	handler := MaintenanceMode(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/posts", nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("expected status 201, got %d", rec.Code)
+	}
+}
@@ -88,6 +88,11 @@ func (r *statusRecorder) WriteHeader(code int) {
 	r.ResponseWriter.WriteHeader(code)
 }
 
+// Unwrap exposes the underlying writer so http.ResponseController can adjust deadlines.
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
 func (r *statusRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
 	hijacker, ok := r.ResponseWriter.(http.Hijacker)
 	if !ok {
@@ -159,6 +164,13 @@ func RequireAuth(redis *redis.Client, db *sql.DB) Middleware {
 					writeAuthError(r.Context(), w, http.StatusForbidden, "USER_SUSPENDED", "User account suspended")
 					return
 				}
+
+				if err := userService.RecordActivity(r.Context(), redis, session.UserID); err != nil {
+					observability.LogWarn(r.Context(), "failed to record user activity",
+						"user_id", session.UserID.String(),
+						"error", err.Error(),
+					)
+				}
 			}
 
 			// Inject session and user into context
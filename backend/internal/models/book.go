@@ -49,6 +49,14 @@ type BookshelfCategory struct {
 	CreatedAt time.Time `json:"created_at"`
 }
 
+// BookshelfCategoryCount represents a bookshelf category with its active item count.
+// ID is nil for the implicit Uncategorized bucket.
+type BookshelfCategoryCount struct {
+	ID        *uuid.UUID `json:"id,omitempty"`
+	Name      string     `json:"name"`
+	ItemCount int        `json:"item_count"`
+}
+
 type BookshelfUserInfo struct {
 	ID                uuid.UUID `json:"id"`
 	Username          string    `json:"username"`
@@ -76,6 +84,17 @@ type AddToBookshelfRequest struct {
 	Categories []string `json:"categories,omitempty"`
 }
 
+// MoveBookshelfItemRequest represents the request body for moving a bookshelf item between categories.
+type MoveBookshelfItemRequest struct {
+	FromCategory string `json:"from_category"`
+	ToCategory   string `json:"to_category"`
+}
+
+// MoveBookshelfItemResponse represents the response for moving a bookshelf item.
+type MoveBookshelfItemResponse struct {
+	Item BookshelfItem `json:"item"`
+}
+
 // ReorderBookshelfCategoriesRequest represents the request body for reordering categories.
 type ReorderBookshelfCategoriesRequest struct {
 	CategoryIDs []uuid.UUID `json:"category_ids"`
@@ -101,6 +120,11 @@ type ListBookshelfCategoriesResponse struct {
 	Categories []BookshelfCategory `json:"categories"`
 }
 
+// ListBookshelfCategoryCountsResponse represents the response for bookshelf category counts.
+type ListBookshelfCategoryCountsResponse struct {
+	Categories []BookshelfCategoryCount `json:"categories"`
+}
+
 // ListBookshelfItemsResponse represents a paginated bookshelf item response.
 type ListBookshelfItemsResponse struct {
 	BookshelfItems []BookshelfItem `json:"bookshelf_items"`
@@ -61,6 +61,18 @@ type GetCommentResponse struct {
 	Comment *Comment `json:"comment"`
 }
 
+// CommentThreadContext is a comment together with the ancestors needed to show it in its thread.
+type CommentThreadContext struct {
+	Comment            *Comment  `json:"comment"`
+	Ancestors          []Comment `json:"ancestors"`
+	AncestorsTruncated bool      `json:"ancestors_truncated"`
+}
+
+// GetCommentContextResponse represents the response for jumping to a comment
+type GetCommentContextResponse struct {
+	Context CommentThreadContext `json:"context"`
+}
+
 // UpdateCommentResponse represents the response for updating a comment
 type UpdateCommentResponse struct {
 	Comment Comment `json:"comment"`
@@ -72,10 +84,13 @@ type PageMeta struct {
 	HasMore bool    `json:"has_more"`
 }
 
-// GetThreadResponse represents the response for getting comments on a post
+// GetThreadResponse represents the response for getting comments on a post.
+// Sort is the order actually applied to top-level comments.
 type GetThreadResponse struct {
-	Comments []Comment `json:"comments"`
-	Meta     PageMeta  `json:"meta"`
+	Comments  []Comment `json:"comments"`
+	Sort      string    `json:"sort"`
+	Truncated bool      `json:"truncated"`
+	Meta      PageMeta  `json:"meta"`
 }
 
 // DeleteCommentResponse represents the response for deleting a comment
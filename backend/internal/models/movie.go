@@ -61,6 +61,30 @@ type WatchlistCategory struct {
 	CreatedAt time.Time `json:"created_at"`
 }
 
+// WatchlistCategoryCount represents a watchlist category with its active item count.
+type WatchlistCategoryCount struct {
+	Name      string `json:"name"`
+	ItemCount int    `json:"item_count"`
+}
+
+// UserMovieItem is one movie or series on a user's profile, merging their watchlist saves
+// and watch log for the same post. Status is "watched" once a watch log exists.
+type UserMovieItem struct {
+	PostID     uuid.UUID  `json:"post_id"`
+	Status     string     `json:"status"`
+	Categories []string   `json:"categories,omitempty"`
+	Rating     *int       `json:"rating,omitempty"`
+	WatchedAt  *time.Time `json:"watched_at,omitempty"`
+	ActivityAt time.Time  `json:"activity_at"`
+	Post       *Post      `json:"post,omitempty"`
+}
+
+// ListUserMoviesResponse represents the response for a user's movie profile tab.
+type ListUserMoviesResponse struct {
+	Items      []UserMovieItem `json:"items"`
+	NextCursor *string         `json:"next_cursor,omitempty"`
+}
+
 // PostWatchlistInfo represents watchlist tooltip data for a post.
 type PostWatchlistInfo struct {
 	SaveCount        int            `json:"save_count"`
@@ -89,6 +113,17 @@ type AddToWatchlistResponse struct {
 	WatchlistItems []WatchlistItem `json:"watchlist_items"`
 }
 
+// MoveWatchlistItemRequest represents the request body for moving a watchlist item between categories.
+type MoveWatchlistItemRequest struct {
+	FromCategory string   `json:"from_category"`
+	ToCategories []string `json:"to_categories"`
+}
+
+// MoveWatchlistItemResponse represents the response for moving a watchlist item.
+type MoveWatchlistItemResponse struct {
+	WatchlistItems []WatchlistItem `json:"watchlist_items"`
+}
+
 // WatchlistResponse represents watchlist items grouped by category.
 type WatchlistResponse struct {
 	Categories []WatchlistCategoryGroup `json:"categories"`
@@ -119,3 +154,8 @@ type UpdateWatchlistCategoryResponse struct {
 type ListWatchlistCategoriesResponse struct {
 	Categories []WatchlistCategory `json:"categories"`
 }
+
+// ListWatchlistCategoryCountsResponse represents the response for watchlist category counts.
+type ListWatchlistCategoryCountsResponse struct {
+	Categories []WatchlistCategoryCount `json:"categories"`
+}
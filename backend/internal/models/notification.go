@@ -42,3 +42,55 @@ type UpdateNotificationResponse struct {
 type MarkAllNotificationsReadResponse struct {
 	UnreadCount int `json:"unread_count"`
 }
+
+// GetNotificationStatesRequest represents the request body for bulk-fetching notification read states.
+type GetNotificationStatesRequest struct {
+	NotificationIDs []string `json:"notification_ids"`
+}
+
+// NotificationReadState is the read state of a single notification.
+type NotificationReadState struct {
+	ID     uuid.UUID  `json:"id"`
+	Read   bool       `json:"read"`
+	ReadAt *time.Time `json:"read_at,omitempty"`
+}
+
+// GetNotificationStatesResponse represents the response for bulk-fetching notification read states.
+// IDs that do not exist or belong to another user are omitted.
+type GetNotificationStatesResponse struct {
+	States []NotificationReadState `json:"states"`
+}
+
+// NotificationDigest summarizes a user's activity over a time window for digest emails.
+// UnreadNotificationCount does not include mentions, which are listed in Mentions.
+type NotificationDigest struct {
+	UserID                  uuid.UUID             `json:"user_id"`
+	WindowStart             time.Time             `json:"window_start"`
+	WindowEnd               time.Time             `json:"window_end"`
+	UnreadNotificationCount int                   `json:"unread_notification_count"`
+	UnreadNotifications     []Notification        `json:"unread_notifications"`
+	Mentions                []Notification        `json:"mentions"`
+	NewPostCount            int                   `json:"new_post_count"`
+	Sections                []DigestSectionUpdate `json:"sections"`
+}
+
+// DigestSectionUpdate groups new posts from one subscribed section in a digest.
+type DigestSectionUpdate struct {
+	SectionID   uuid.UUID    `json:"section_id"`
+	SectionName string       `json:"section_name"`
+	SectionType string       `json:"section_type"`
+	Posts       []DigestPost `json:"posts"`
+}
+
+// DigestPost is a compact post summary for digest rendering.
+type DigestPost struct {
+	ID             uuid.UUID   `json:"id"`
+	Author         UserSummary `json:"author"`
+	ContentExcerpt *string     `json:"content_excerpt,omitempty"`
+	CreatedAt      time.Time   `json:"created_at"`
+}
+
+// GetNotificationDigestResponse represents the response for a notification digest.
+type GetNotificationDigestResponse struct {
+	Digest NotificationDigest `json:"digest"`
+}
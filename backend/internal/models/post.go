@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"path"
 	"strings"
 	"time"
 
@@ -18,9 +19,12 @@ type Post struct {
 	UserID          uuid.UUID      `json:"user_id"`
 	SectionID       uuid.UUID      `json:"section_id"`
 	Content         string         `json:"content"`
+	Slug            string         `json:"slug"`
+	DisplayTitle    string         `json:"display_title,omitempty"`
+	SectionType     string         `json:"section_type,omitempty"`
 	Links           []Link         `json:"links,omitempty"`
 	Images          []PostImage    `json:"images,omitempty"`
-	CommentCount    int            `json:"comment_count"`
+	CommentCount    *int           `json:"comment_count,omitempty"`
 	CreatedAt       time.Time      `json:"created_at"`
 	UpdatedAt       *time.Time     `json:"updated_at,omitempty"`
 	DeletedAt       *time.Time     `json:"deleted_at,omitempty"`
@@ -59,7 +63,10 @@ type Link struct {
 	Metadata   map[string]interface{} `json:"metadata,omitempty"`
 	Highlights []Highlight            `json:"highlights,omitempty"`
 	Podcast    *PodcastMetadata       `json:"podcast,omitempty"`
-	CreatedAt  time.Time              `json:"created_at"`
+	// IsDead is set when the link's last reachability check found it gone (404/410).
+	IsDead        bool       `json:"is_dead,omitempty"`
+	LastCheckedAt *time.Time `json:"last_checked_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
 }
 
 // PostImage represents an image attached to a post.
@@ -91,11 +98,28 @@ type LinkRequest struct {
 
 // Highlight represents a timestamped highlight for a link.
 type Highlight struct {
-	ID            string `json:"id,omitempty"`
-	Timestamp     int    `json:"timestamp"`
-	Label         string `json:"label,omitempty"`
-	HeartCount    int    `json:"heart_count,omitempty"`
-	ViewerReacted bool   `json:"viewer_reacted,omitempty"`
+	ID        string `json:"id,omitempty"`
+	Timestamp int    `json:"timestamp"`
+	// FormattedTimestamp is Timestamp rendered for display; it is derived on read and never stored.
+	FormattedTimestamp string `json:"formatted_timestamp,omitempty"`
+	Label              string `json:"label,omitempty"`
+	HeartCount         int    `json:"heart_count,omitempty"`
+	ViewerReacted      bool   `json:"viewer_reacted,omitempty"`
+}
+
+// FormatHighlightTimestamp renders a highlight offset in seconds as mm:ss, or hh:mm:ss once
+// it reaches an hour, so every client displays the same string.
+func FormatHighlightTimestamp(seconds int) string {
+	if seconds < 0 {
+		seconds = 0
+	}
+	hours := seconds / 3600
+	minutes := (seconds % 3600) / 60
+	secs := seconds % 60
+	if hours > 0 {
+		return fmt.Sprintf("%02d:%02d:%02d", hours, minutes, secs)
+	}
+	return fmt.Sprintf("%02d:%02d", minutes, secs)
 }
 
 type PodcastMetadata struct {
@@ -210,6 +234,116 @@ func isValidHTTPURL(raw string) bool {
 	return parsed.Scheme == "http" || parsed.Scheme == "https"
 }
 
+const (
+	postSlugMaxLength         = 60
+	postSlugFallback          = "post"
+	postDisplayTitleMaxLength = 100
+)
+
+// PostSlug derives a URL-safe, human-readable slug for a post. The slug is
+// cosmetic: clients may append it to permalinks but lookups only use the ID.
+// Posts without text fall back to the first link's metadata title, then the
+// link URL itself.
+func PostSlug(content string, links []Link) string {
+	if slug := slugify(stripURLs(content)); slug != "" {
+		return slug
+	}
+	for _, link := range links {
+		if title, ok := link.Metadata["title"].(string); ok {
+			if slug := slugify(title); slug != "" {
+				return slug
+			}
+		}
+	}
+	for _, link := range links {
+		if slug := slugify(slugSourceFromURL(link.URL)); slug != "" {
+			return slug
+		}
+	}
+	return postSlugFallback
+}
+
+// PostDisplayTitle returns the title clients show for a post: the first link's metadata
+// title when available, otherwise the first line of the content. Returns "" when neither exists.
+func PostDisplayTitle(content string, links []Link) string {
+	for _, link := range links {
+		if title, ok := link.Metadata["title"].(string); ok && strings.TrimSpace(title) != "" {
+			return truncateDisplayTitle(strings.TrimSpace(title))
+		}
+	}
+	for _, line := range strings.Split(content, "\n") {
+		if line = stripURLs(line); line != "" {
+			return truncateDisplayTitle(line)
+		}
+	}
+	return ""
+}
+
+func truncateDisplayTitle(title string) string {
+	runes := []rune(title)
+	if len(runes) <= postDisplayTitleMaxLength {
+		return title
+	}
+	return strings.TrimSpace(string(runes[:postDisplayTitleMaxLength-1])) + "…"
+}
+
+func stripURLs(content string) string {
+	fields := strings.Fields(content)
+	kept := fields[:0]
+	for _, field := range fields {
+		lower := strings.ToLower(field)
+		if strings.HasPrefix(lower, "http://") || strings.HasPrefix(lower, "https://") {
+			continue
+		}
+		kept = append(kept, field)
+	}
+	return strings.Join(kept, " ")
+}
+
+func slugSourceFromURL(raw string) string {
+	parsed, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || parsed.Host == "" {
+		return ""
+	}
+	host := strings.TrimPrefix(parsed.Hostname(), "www.")
+	segments := strings.Split(strings.Trim(parsed.Path, "/"), "/")
+	last := segments[len(segments)-1]
+	if last == "" {
+		return host
+	}
+	return host + " " + strings.TrimSuffix(last, path.Ext(last))
+}
+
+func slugify(value string) string {
+	var builder strings.Builder
+	pendingDash := false
+	for _, r := range strings.ToLower(value) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			if pendingDash && builder.Len() > 0 {
+				builder.WriteByte('-')
+			}
+			pendingDash = false
+			builder.WriteRune(r)
+			continue
+		}
+		if r == '\'' || r == '’' {
+			// Keep contractions together: "don't" -> "dont"
+			continue
+		}
+		pendingDash = true
+	}
+
+	slug := builder.String()
+	if len(slug) <= postSlugMaxLength {
+		return slug
+	}
+	slug = slug[:postSlugMaxLength]
+	if cut := strings.LastIndexByte(slug, '-'); cut > postSlugMaxLength/2 {
+		slug = slug[:cut]
+	}
+	return strings.TrimSuffix(slug, "-")
+}
+
 // PostImageRequest represents an image in the request.
 type PostImageRequest struct {
 	URL     string  `json:"url"`
@@ -238,6 +372,37 @@ type GetPostResponse struct {
 	Post *Post `json:"post"`
 }
 
+// PostCounts represents lightweight engagement counts for a post
+type PostCounts struct {
+	PostID         uuid.UUID      `json:"post_id"`
+	CommentCount   int            `json:"comment_count"`
+	ReactionCount  int            `json:"reaction_count"`
+	ReactionCounts map[string]int `json:"reaction_counts"`
+}
+
+// GetPostCountsResponse represents the response for getting post engagement counts
+type GetPostCountsResponse struct {
+	Counts PostCounts `json:"counts"`
+}
+
+// RatingDistribution represents how many times each rating value was given to a post
+type RatingDistribution struct {
+	PostID       uuid.UUID   `json:"post_id"`
+	SectionType  string      `json:"section_type"`
+	TotalRatings int         `json:"total_ratings"`
+	Counts       map[int]int `json:"counts"`
+}
+
+// GetRatingDistributionResponse represents the response for getting a post's rating distribution
+type GetRatingDistributionResponse struct {
+	Distribution RatingDistribution `json:"distribution"`
+}
+
+// MoreFromAuthorResponse represents the author's other recent posts in the same section
+type MoreFromAuthorResponse struct {
+	Posts []*Post `json:"posts"`
+}
+
 // UpdatePostResponse represents the response for updating a post
 type UpdatePostResponse struct {
 	Post Post `json:"post"`
@@ -267,6 +432,20 @@ type HardDeletePostResponse struct {
 	Message string    `json:"message"`
 }
 
+// RefreshLinkMetadataResponse represents the response for queueing a forced link metadata refresh
+type RefreshLinkMetadataResponse struct {
+	LinkID  uuid.UUID `json:"link_id"`
+	Message string    `json:"message"`
+}
+
+// MetadataQueueStatus represents the health of the link metadata pipeline
+type MetadataQueueStatus struct {
+	QueueLength      int64      `json:"queue_length"`
+	ProcessingLength int64      `json:"processing_length"`
+	DeadLetterLength int64      `json:"dead_letter_length"`
+	LastSuccessAt    *time.Time `json:"last_success_at,omitempty"`
+}
+
 // JSONMap is a custom type for storing JSON metadata
 type JSONMap map[string]interface{}
 
@@ -283,3 +462,55 @@ func (j *JSONMap) Scan(value interface{}) error {
 	}
 	return json.Unmarshal(bytes, &j)
 }
+
+// EngagementCounts represents engagement received on an author's posts
+type EngagementCounts struct {
+	PostCount  int `json:"post_count"`
+	Reactions  int `json:"reactions"`
+	Comments   int `json:"comments"`
+	Saves      int `json:"saves"`
+	Watchlists int `json:"watchlists"`
+}
+
+// SectionEngagement represents engagement received on an author's posts within one section
+type SectionEngagement struct {
+	SectionID   uuid.UUID `json:"section_id"`
+	SectionName string    `json:"section_name"`
+	SectionType string    `json:"section_type"`
+	EngagementCounts
+}
+
+// AuthorEngagementResponse represents the response for the author engagement dashboard
+type AuthorEngagementResponse struct {
+	Totals   EngagementCounts    `json:"totals"`
+	Sections []SectionEngagement `json:"sections"`
+}
+
+// PostTimelineEvent represents a single edit or moderation action in a post's history
+type PostTimelineEvent struct {
+	ID            uuid.UUID  `json:"id"`
+	Type          string     `json:"type"`
+	Action        string     `json:"action"`
+	ActorUserID   *uuid.UUID `json:"actor_user_id,omitempty"`
+	ActorUsername string     `json:"actor_username,omitempty"`
+	Metadata      JSONMap    `json:"metadata,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+// PostTimelineResponse represents the response for a post's edit and moderation timeline
+type PostTimelineResponse struct {
+	PostID uuid.UUID           `json:"post_id"`
+	Events []PostTimelineEvent `json:"events"`
+}
+
+// TopPost is one of a user's posts ranked by the reactions it received
+type TopPost struct {
+	Post          *Post `json:"post"`
+	ReactionCount int   `json:"reaction_count"`
+}
+
+// UserTopPostsResponse represents the response for a user's most-reacted posts
+type UserTopPostsResponse struct {
+	Window string    `json:"window"`
+	Posts  []TopPost `json:"posts"`
+}
@@ -235,3 +235,119 @@ func TestValidatePodcastMetadata(t *testing.T) {
 		})
 	}
 }
+
+func TestPostSlug(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		links   []Link
+		want    string
+	}{
+		{
+			name:    "content post",
+			content: "Don't miss: The Best Ramen in Tokyo!!",
+			want:    "dont-miss-the-best-ramen-in-tokyo",
+		},
+		{
+			name:    "urls in content are ignored",
+			content: "Loved this https://example.com/watch?v=123 album",
+			want:    "loved-this-album",
+		},
+		{
+			name:    "long content is cut on a word boundary",
+			content: strings.Repeat("clubhouse ", 20),
+			want:    "clubhouse-clubhouse-clubhouse-clubhouse-clubhouse-clubhouse",
+		},
+		{
+			name:    "link-only post uses metadata title",
+			content: "   ",
+			links: []Link{
+				{URL: "https://example.com/a", Metadata: map[string]interface{}{"title": "Blade Runner (1982)"}},
+			},
+			want: "blade-runner-1982",
+		},
+		{
+			name:    "link-only post without metadata uses url",
+			content: "https://www.example.com/articles/great-read.html",
+			links:   []Link{{URL: "https://www.example.com/articles/great-read.html"}},
+			want:    "example-com-great-read",
+		},
+		{
+			name:    "empty post falls back",
+			content: "🎉🎉",
+			want:    "post",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := PostSlug(tt.content, tt.links)
+			if got != tt.want {
+				t.Fatalf("PostSlug() = %q, want %q", got, tt.want)
+			}
+			if len(got) > postSlugMaxLength {
+				t.Fatalf("PostSlug() length = %d, want <= %d", len(got), postSlugMaxLength)
+			}
+		})
+	}
+}
+
+func TestPostDisplayTitle(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		links   []Link
+		want    string
+	}{
+		{
+			name:    "link metadata title wins",
+			content: "You have to see this",
+			links:   []Link{{URL: "https://example.com", Metadata: map[string]interface{}{"title": " Arrival "}}},
+			want:    "Arrival",
+		},
+		{
+			name:    "first content line without urls",
+			content: "https://example.com/x\nWeekend plans\nmore text",
+			want:    "Weekend plans",
+		},
+		{
+			name:    "long titles are truncated",
+			content: strings.Repeat("a", 150),
+			want:    strings.Repeat("a", postDisplayTitleMaxLength-1) + "…",
+		},
+		{
+			name:    "empty post",
+			content: "",
+			want:    "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PostDisplayTitle(tt.content, tt.links); got != tt.want {
+				t.Fatalf("PostDisplayTitle() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatHighlightTimestamp(t *testing.T) {
+	tests := []struct {
+		seconds int
+		want    string
+	}{
+		{seconds: 0, want: "00:00"},
+		{seconds: 65, want: "01:05"},
+		{seconds: 3599, want: "59:59"},
+		{seconds: 3600, want: "01:00:00"},
+		{seconds: 3725, want: "01:02:05"},
+		{seconds: 36000, want: "10:00:00"},
+		{seconds: -5, want: "00:00"},
+	}
+
+	for _, tt := range tests {
+		if got := FormatHighlightTimestamp(tt.seconds); got != tt.want {
+			t.Errorf("FormatHighlightTimestamp(%d) = %q, want %q", tt.seconds, got, tt.want)
+		}
+	}
+}
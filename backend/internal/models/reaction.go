@@ -44,3 +44,18 @@ type CreateReactionRequest struct {
 type CreateReactionResponse struct {
 	Reaction Reaction `json:"reaction"`
 }
+
+// ReactionOption is an emoji offered in the reaction picker with its accessible name.
+type ReactionOption struct {
+	Emoji string `json:"emoji"`
+	Name  string `json:"name"`
+}
+
+// AllowedReactionsResponse represents the resolved reaction set for a post's section.
+// When Restricted is false the reactions are suggestions and any emoji is accepted.
+type AllowedReactionsResponse struct {
+	PostID      uuid.UUID        `json:"post_id"`
+	SectionType string           `json:"section_type"`
+	Reactions   []ReactionOption `json:"reactions"`
+	Restricted  bool             `json:"restricted"`
+}
@@ -51,6 +51,17 @@ type CreateSavedRecipeResponse struct {
 	SavedRecipes []SavedRecipe `json:"saved_recipes"`
 }
 
+// MoveSavedRecipeRequest represents the request body for moving a saved recipe between categories.
+type MoveSavedRecipeRequest struct {
+	FromCategory string   `json:"from_category"`
+	ToCategories []string `json:"to_categories"`
+}
+
+// MoveSavedRecipeResponse represents the response for moving a saved recipe.
+type MoveSavedRecipeResponse struct {
+	SavedRecipes []SavedRecipe `json:"saved_recipes"`
+}
+
 // DeleteSavedRecipeResponse represents the response for removing a saved recipe.
 type DeleteSavedRecipeResponse struct {
 	SavedRecipe *SavedRecipe `json:"saved_recipe"`
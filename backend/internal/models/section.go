@@ -1,6 +1,10 @@
 package models
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type Section struct {
 	ID   uuid.UUID `json:"id"`
@@ -15,3 +19,34 @@ type ListSectionsResponse struct {
 type GetSectionResponse struct {
 	Section Section `json:"section"`
 }
+
+// SectionReadPosition records how far a user has read in a section feed.
+type SectionReadPosition struct {
+	SectionID  uuid.UUID `json:"section_id"`
+	LastReadAt time.Time `json:"last_read_at"`
+}
+
+// UpdateSectionReadPositionRequest represents the request to move a section read position.
+// When LastReadAt is omitted the position is set to the current time.
+type UpdateSectionReadPositionRequest struct {
+	LastReadAt *time.Time `json:"last_read_at,omitempty"`
+}
+
+// UpdateSectionReadPositionResponse represents the response for updating a section read position.
+type UpdateSectionReadPositionResponse struct {
+	ReadPosition SectionReadPosition `json:"read_position"`
+}
+
+// SectionActivity summarizes recent posting activity in a section the user is subscribed to.
+type SectionActivity struct {
+	SectionID    uuid.UUID  `json:"section_id"`
+	SectionName  string     `json:"section_name"`
+	SectionType  string     `json:"section_type"`
+	LatestPostAt *time.Time `json:"latest_post_at,omitempty"`
+	UnreadCount  int        `json:"unread_count"`
+}
+
+// SectionActivityResponse represents the response for GET /api/v1/me/sections/activity.
+type SectionActivityResponse struct {
+	Sections []SectionActivity `json:"sections"`
+}
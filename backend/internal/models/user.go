@@ -15,6 +15,7 @@ type User struct {
 	ProfilePictureURL   *string    `json:"profile_picture_url,omitempty"`
 	Bio                 *string    `json:"bio,omitempty"`
 	IsAdmin             bool       `json:"is_admin"`
+	ActivityPrivate     bool       `json:"activity_private"`
 	TotpEnabled         bool       `json:"-"`
 	TotpSecretEncrypted []byte     `json:"-"`
 	ApprovedAt          *time.Time `json:"approved_at,omitempty"`
@@ -129,6 +130,8 @@ type PromoteUserResponse struct {
 type RejectUserResponse struct {
 	ID      uuid.UUID `json:"id"`
 	Message string    `json:"message"`
+	// RetainedUntil is set when the user is soft-rejected and kept for appeal until this time.
+	RetainedUntil *time.Time `json:"retained_until,omitempty"`
 }
 
 // SuspendUserRequest represents the request body to suspend a user
@@ -158,6 +161,7 @@ type MeResponse struct {
 	Bio               *string   `json:"bio,omitempty"`
 	IsAdmin           bool      `json:"is_admin"`
 	TotpEnabled       bool      `json:"totp_enabled"`
+	ActivityPrivate   bool      `json:"activity_private"`
 }
 
 // UserStats represents user activity statistics
@@ -168,18 +172,27 @@ type UserStats struct {
 
 // UserProfileResponse represents the response from /users/{id} endpoint
 type UserProfileResponse struct {
-	ID                uuid.UUID `json:"id"`
-	Username          string    `json:"username"`
-	Bio               *string   `json:"bio,omitempty"`
-	ProfilePictureUrl *string   `json:"profile_picture_url,omitempty"`
-	CreatedAt         time.Time `json:"created_at"`
-	Stats             UserStats `json:"stats"`
+	ID                uuid.UUID  `json:"id"`
+	Username          string     `json:"username"`
+	Bio               *string    `json:"bio,omitempty"`
+	ProfilePictureUrl *string    `json:"profile_picture_url,omitempty"`
+	CreatedAt         time.Time  `json:"created_at"`
+	LastActiveAt      *time.Time `json:"last_active_at,omitempty"`
+	Stats             UserStats  `json:"stats"`
+}
+
+// UserLastActiveResponse represents a user's coarse last-active timestamp.
+// LastActiveAt is null when the user has never been seen or hides their activity.
+type UserLastActiveResponse struct {
+	UserID       uuid.UUID  `json:"user_id"`
+	LastActiveAt *time.Time `json:"last_active_at"`
 }
 
 // UpdateUserRequest represents the request to update user profile
 type UpdateUserRequest struct {
 	Bio               *string `json:"bio,omitempty"`
 	ProfilePictureUrl *string `json:"profile_picture_url,omitempty"`
+	ActivityPrivate   *bool   `json:"activity_private,omitempty"`
 }
 
 // UpdateUserResponse represents the response from updating user profile
@@ -190,6 +203,7 @@ type UpdateUserResponse struct {
 	ProfilePictureUrl *string   `json:"profile_picture_url,omitempty"`
 	Bio               *string   `json:"bio,omitempty"`
 	IsAdmin           bool      `json:"is_admin"`
+	ActivityPrivate   bool      `json:"activity_private"`
 }
 
 // SectionSubscription represents an opt-out entry for a section.
@@ -198,6 +212,68 @@ type SectionSubscription struct {
 	OptedOutAt time.Time `json:"opted_out_at"`
 }
 
+// Digest email frequencies.
+const (
+	DigestFrequencyOff    = "off"
+	DigestFrequencyDaily  = "daily"
+	DigestFrequencyWeekly = "weekly"
+)
+
+// DigestPreferences represents a user's digest email preferences.
+type DigestPreferences struct {
+	Frequency string `json:"frequency"`
+}
+
+// UpdateDigestPreferencesRequest represents the request to change digest email preferences.
+type UpdateDigestPreferencesRequest struct {
+	Frequency string `json:"frequency"`
+}
+
+// DigestPreferencesResponse represents the response for digest email preferences.
+type DigestPreferencesResponse struct {
+	DigestPreferences DigestPreferences `json:"digest_preferences"`
+}
+
+// NotificationPreferences represents which optional notifications a user receives.
+type NotificationPreferences struct {
+	CommentReactions bool `json:"comment_reactions"`
+}
+
+// UpdateNotificationPreferencesRequest represents the request to change notification preferences.
+type UpdateNotificationPreferencesRequest struct {
+	CommentReactions *bool `json:"comment_reactions"`
+}
+
+// NotificationPreferencesResponse represents the response for notification preferences.
+type NotificationPreferencesResponse struct {
+	NotificationPreferences NotificationPreferences `json:"notification_preferences"`
+}
+
+// UserConfig is the effective configuration for a single user, merging the admin config
+// with the user's own settings. Keys follow the public config response.
+type UserConfig struct {
+	DisplayTimezone            string      `json:"displayTimezone"`
+	LinkMetadataEnabled        bool        `json:"linkMetadataEnabled"`
+	MFARequired                bool        `json:"mfaRequired"`
+	MFAEnabled                 bool        `json:"mfaEnabled"`
+	MFASetupRequired           bool        `json:"mfaSetupRequired"`
+	ActivityPrivate            bool        `json:"activityPrivate"`
+	EditGracePeriodSeconds     int         `json:"editGracePeriodSeconds"`
+	MaxBioLength               int         `json:"maxBioLength"`
+	MaxProfilePictureURLLength int         `json:"maxProfilePictureUrlLength"`
+	MaxLogNoteLength           int         `json:"maxLogNoteLength"`
+	MutedSectionIDs            []uuid.UUID `json:"mutedSectionIds"`
+	// AllowedReactions and SectionReactions mirror the admin reaction sets; an empty
+	// AllowedReactions list means any emoji is accepted outside overridden sections.
+	AllowedReactions []string            `json:"allowedReactions"`
+	SectionReactions map[string][]string `json:"sectionReactions"`
+}
+
+// GetUserConfigResponse represents the response for the current user's effective config.
+type GetUserConfigResponse struct {
+	Config UserConfig `json:"config"`
+}
+
 // GetSectionSubscriptionsResponse represents the response from listing section opt-outs.
 type GetSectionSubscriptionsResponse struct {
 	SectionSubscriptions []SectionSubscription `json:"section_subscriptions"`
@@ -237,3 +313,42 @@ type RedeemPasswordResetTokenRequest struct {
 type RedeemPasswordResetTokenResponse struct {
 	Message string `json:"message"`
 }
+
+// SectionRatingBias is how a user's ratings in one section type compare to the community average
+// for the same posts. A positive AverageDifference means the user rates higher than others.
+type SectionRatingBias struct {
+	SectionType       string  `json:"section_type"`
+	RatedPosts        int     `json:"rated_posts"`
+	AverageDifference float64 `json:"average_difference"`
+}
+
+// UserRatingBias summarizes a user's rating calibration across section types.
+type UserRatingBias struct {
+	UserID            uuid.UUID           `json:"user_id"`
+	RatedPosts        int                 `json:"rated_posts"`
+	AverageDifference *float64            `json:"average_difference,omitempty"`
+	Sections          []SectionRatingBias `json:"sections"`
+}
+
+// GetUserRatingBiasResponse represents the response for a user's rating calibration
+type GetUserRatingBiasResponse struct {
+	RatingBias UserRatingBias `json:"rating_bias"`
+}
+
+// TimezonePreference represents a user's timezone setting. Timezone is empty when the user
+// has not set one; EffectiveTimezone is the timezone actually used for the user.
+type TimezonePreference struct {
+	Timezone          string `json:"timezone"`
+	EffectiveTimezone string `json:"effective_timezone"`
+}
+
+// UpdateTimezoneRequest represents the request to change a user's timezone.
+// An empty timezone clears the setting so the global display timezone applies.
+type UpdateTimezoneRequest struct {
+	Timezone string `json:"timezone"`
+}
+
+// TimezonePreferenceResponse represents the response for a user's timezone setting.
+type TimezonePreferenceResponse struct {
+	TimezonePreference TimezonePreference `json:"timezone_preference"`
+}
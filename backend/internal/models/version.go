@@ -0,0 +1,9 @@
+package models
+
+// VersionResponse describes the running server build.
+type VersionResponse struct {
+	Version    string `json:"version"`
+	Commit     string `json:"commit"`
+	BuildTime  string `json:"build_time,omitempty"`
+	APIVersion string `json:"api_version"`
+}
@@ -0,0 +1,114 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/sanderginn/clubhouse/internal/models"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// GetAuthorEngagement aggregates the reactions, comments, saves and watchlist adds received on an
+// author's posts, grouped by section. Deleted posts and deleted engagement are excluded, as is
+// engagement the author left on their own posts. Saves combine saved recipes, bookshelf entries and
+// podcast saves, counting each user once per post.
+func (s *PostService) GetAuthorEngagement(ctx context.Context, userID uuid.UUID) (*models.AuthorEngagementResponse, error) {
+	ctx, span := otel.Tracer("clubhouse.posts").Start(ctx, "PostService.GetAuthorEngagement")
+	span.SetAttributes(attribute.String("user_id", userID.String()))
+	defer span.End()
+
+	query := `
+		WITH author_posts AS (
+			SELECT id, section_id
+			FROM posts
+			WHERE user_id = $1 AND deleted_at IS NULL
+		),
+		post_counts AS (
+			SELECT section_id, COUNT(*) AS total
+			FROM author_posts
+			GROUP BY section_id
+		),
+		reaction_counts AS (
+			SELECT ap.section_id, COUNT(*) AS total
+			FROM reactions r
+			JOIN author_posts ap ON ap.id = r.post_id
+			WHERE r.deleted_at IS NULL AND r.user_id <> $1
+			GROUP BY ap.section_id
+		),
+		comment_counts AS (
+			SELECT ap.section_id, COUNT(*) AS total
+			FROM comments c
+			JOIN author_posts ap ON ap.id = c.post_id
+			WHERE c.deleted_at IS NULL AND c.user_id <> $1
+			GROUP BY ap.section_id
+		),
+		save_counts AS (
+			SELECT ap.section_id, COUNT(*) AS total
+			FROM (
+				SELECT DISTINCT user_id, post_id FROM saved_recipes WHERE deleted_at IS NULL
+				UNION ALL
+				SELECT user_id, post_id FROM bookshelf_items WHERE deleted_at IS NULL
+				UNION ALL
+				SELECT user_id, post_id FROM podcast_saves WHERE deleted_at IS NULL
+			) saves
+			JOIN author_posts ap ON ap.id = saves.post_id
+			WHERE saves.user_id <> $1
+			GROUP BY ap.section_id
+		),
+		watchlist_counts AS (
+			SELECT ap.section_id, COUNT(*) AS total
+			FROM (
+				SELECT DISTINCT user_id, post_id FROM watchlist_items WHERE deleted_at IS NULL
+			) watchlists
+			JOIN author_posts ap ON ap.id = watchlists.post_id
+			WHERE watchlists.user_id <> $1
+			GROUP BY ap.section_id
+		)
+		SELECT
+			s.id, s.name, s.type, pc.total,
+			COALESCE(rc.total, 0), COALESCE(cc.total, 0), COALESCE(sc.total, 0), COALESCE(wc.total, 0)
+		FROM post_counts pc
+		JOIN sections s ON s.id = pc.section_id
+		LEFT JOIN reaction_counts rc ON rc.section_id = pc.section_id
+		LEFT JOIN comment_counts cc ON cc.section_id = pc.section_id
+		LEFT JOIN save_counts sc ON sc.section_id = pc.section_id
+		LEFT JOIN watchlist_counts wc ON wc.section_id = pc.section_id
+		ORDER BY s.name ASC
+	`
+
+	rows, err := s.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, fmt.Errorf("failed to query author engagement: %w", err)
+	}
+	defer rows.Close()
+
+	response := &models.AuthorEngagementResponse{
+		Sections: []models.SectionEngagement{},
+	}
+	for rows.Next() {
+		var section models.SectionEngagement
+		if err := rows.Scan(
+			&section.SectionID, &section.SectionName, &section.SectionType, &section.PostCount,
+			&section.Reactions, &section.Comments, &section.Saves, &section.Watchlists,
+		); err != nil {
+			recordSpanError(span, err)
+			return nil, fmt.Errorf("failed to scan author engagement: %w", err)
+		}
+		response.Totals.PostCount += section.PostCount
+		response.Totals.Reactions += section.Reactions
+		response.Totals.Comments += section.Comments
+		response.Totals.Saves += section.Saves
+		response.Totals.Watchlists += section.Watchlists
+		response.Sections = append(response.Sections, section)
+	}
+	if err := rows.Err(); err != nil {
+		recordSpanError(span, err)
+		return nil, fmt.Errorf("failed to iterate author engagement: %w", err)
+	}
+
+	span.SetAttributes(attribute.Int("section_count", len(response.Sections)))
+	return response, nil
+}
@@ -0,0 +1,77 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sanderginn/clubhouse/internal/testutil"
+)
+
+func TestGetAuthorEngagementAggregatesBySection(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	authorID := testutil.CreateTestUser(t, db, "engagementauthor", "engagementauthor@test.com", false, true)
+	fanID := testutil.CreateTestUser(t, db, "engagementfan", "engagementfan@test.com", false, true)
+	otherFanID := testutil.CreateTestUser(t, db, "engagementfan2", "engagementfan2@test.com", false, true)
+	recipeSectionID := testutil.CreateTestSection(t, db, "Engagement Recipes", "recipe")
+	movieSectionID := testutil.CreateTestSection(t, db, "Engagement Movies", "movie")
+
+	recipePostID := testutil.CreateTestPost(t, db, authorID, recipeSectionID, "Soup recipe")
+	moviePostID := testutil.CreateTestPost(t, db, authorID, movieSectionID, "Movie pick")
+	deletedPostID := testutil.CreateTestPost(t, db, authorID, movieSectionID, "Deleted pick")
+	fanPostID := testutil.CreateTestPost(t, db, fanID, recipeSectionID, "Not the author's post")
+
+	exec := func(query string, args ...interface{}) {
+		t.Helper()
+		if _, err := db.Exec(query, args...); err != nil {
+			t.Fatalf("failed to seed engagement: %v", err)
+		}
+	}
+
+	// Recipe section: 2 reactions, 1 comment, 1 save (two categories count once)
+	exec(`INSERT INTO reactions (user_id, post_id, emoji) VALUES ($1, $3, '👍'), ($2, $3, '❤️')`, fanID, otherFanID, recipePostID)
+	exec(`INSERT INTO reactions (user_id, post_id, emoji) VALUES ($1, $2, '🎉')`, authorID, recipePostID)
+	exec(`INSERT INTO reactions (user_id, post_id, emoji, deleted_at) VALUES ($1, $2, '😂', now())`, fanID, recipePostID)
+	testutil.CreateTestComment(t, db, fanID, recipePostID, "Looks tasty")
+	exec(`INSERT INTO comments (user_id, post_id, content, deleted_at) VALUES ($1, $2, 'removed', now())`, otherFanID, recipePostID)
+	exec(`INSERT INTO saved_recipes (user_id, post_id, category) VALUES ($1, $2, 'Dinner'), ($1, $2, 'Soups')`, fanID, recipePostID)
+	testutil.CreateTestComment(t, db, otherFanID, fanPostID, "Engagement on someone else")
+
+	// Movie section: 1 reaction, 2 comments, 2 watchlists; deleted post is ignored
+	exec(`INSERT INTO reactions (user_id, post_id, emoji) VALUES ($1, $2, '🔥')`, fanID, moviePostID)
+	testutil.CreateTestComment(t, db, fanID, moviePostID, "Great film")
+	testutil.CreateTestComment(t, db, otherFanID, moviePostID, "Agreed")
+	exec(`INSERT INTO watchlist_items (user_id, post_id) VALUES ($1, $3), ($2, $3)`, fanID, otherFanID, moviePostID)
+	exec(`INSERT INTO reactions (user_id, post_id, emoji) VALUES ($1, $2, '👍')`, fanID, deletedPostID)
+	exec(`UPDATE posts SET deleted_at = now() WHERE id = $1`, deletedPostID)
+
+	service := NewPostService(db)
+	engagement, err := service.GetAuthorEngagement(context.Background(), uuid.MustParse(authorID))
+	if err != nil {
+		t.Fatalf("GetAuthorEngagement failed: %v", err)
+	}
+
+	if len(engagement.Sections) != 2 {
+		t.Fatalf("expected 2 sections, got %d", len(engagement.Sections))
+	}
+	bySection := map[string]int{}
+	for i, section := range engagement.Sections {
+		bySection[section.SectionID.String()] = i
+	}
+
+	recipe := engagement.Sections[bySection[recipeSectionID]]
+	if recipe.PostCount != 1 || recipe.Reactions != 2 || recipe.Comments != 1 || recipe.Saves != 1 || recipe.Watchlists != 0 {
+		t.Errorf("unexpected recipe engagement: %+v", recipe.EngagementCounts)
+	}
+	movie := engagement.Sections[bySection[movieSectionID]]
+	if movie.PostCount != 1 || movie.Reactions != 1 || movie.Comments != 2 || movie.Saves != 0 || movie.Watchlists != 2 {
+		t.Errorf("unexpected movie engagement: %+v", movie.EngagementCounts)
+	}
+
+	totals := engagement.Totals
+	if totals.PostCount != 2 || totals.Reactions != 3 || totals.Comments != 3 || totals.Saves != 1 || totals.Watchlists != 2 {
+		t.Errorf("unexpected totals: %+v", totals)
+	}
+}
@@ -121,6 +121,66 @@ func (s *BookshelfService) GetCategories(ctx context.Context, userID uuid.UUID)
 	return categories, nil
 }
 
+// GetCategoryCounts returns each bookshelf category with its active item count in display order.
+// Items without a category are reported under Uncategorized when there are any.
+func (s *BookshelfService) GetCategoryCounts(ctx context.Context, userID uuid.UUID) ([]models.BookshelfCategoryCount, error) {
+	ctx, span := otel.Tracer("clubhouse.bookshelf").Start(ctx, "BookshelfService.GetCategoryCounts")
+	span.SetAttributes(attribute.String("user_id", userID.String()))
+	defer span.End()
+
+	rows, err := s.db.QueryContext(ctx, `
+		WITH item_counts AS (
+			SELECT bi.category_id, COUNT(*) AS item_count
+			FROM bookshelf_items bi
+			JOIN posts p ON bi.post_id = p.id
+			WHERE bi.user_id = $1 AND bi.deleted_at IS NULL AND p.deleted_at IS NULL
+			GROUP BY bi.category_id
+		)
+		SELECT id, name, item_count
+		FROM (
+			SELECT bc.id, bc.name, COALESCE(ic.item_count, 0) AS item_count, 0 AS sort_group, bc.position, bc.created_at
+			FROM bookshelf_categories bc
+			LEFT JOIN item_counts ic ON ic.category_id = bc.id
+			WHERE bc.user_id = $1
+			UNION ALL
+			SELECT NULL, NULL, ic.item_count, 1, 0, NULL
+			FROM item_counts ic
+			WHERE ic.category_id IS NULL
+		) counts
+		ORDER BY sort_group ASC, position ASC, created_at ASC
+	`, userID)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, fmt.Errorf("failed to query bookshelf category counts: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make([]models.BookshelfCategoryCount, 0)
+	for rows.Next() {
+		var (
+			categoryID uuid.NullUUID
+			name       sql.NullString
+			count      models.BookshelfCategoryCount
+		)
+		if err := rows.Scan(&categoryID, &name, &count.ItemCount); err != nil {
+			recordSpanError(span, err)
+			return nil, err
+		}
+		if categoryID.Valid {
+			id := categoryID.UUID
+			count.ID = &id
+		}
+		count.Name = bookshelfDisplayCategoryName(name)
+		counts = append(counts, count)
+	}
+	if err := rows.Err(); err != nil {
+		recordSpanError(span, err)
+		return nil, fmt.Errorf("failed to iterate bookshelf category counts: %w", err)
+	}
+
+	return counts, nil
+}
+
 // UpdateCategory updates a bookshelf category's name and position.
 func (s *BookshelfService) UpdateCategory(
 	ctx context.Context,
@@ -616,9 +676,10 @@ func (s *BookshelfService) GetPostBookshelfInfo(ctx context.Context, postID uuid
 		FROM bookshelf_items bi
 		JOIN users u ON bi.user_id = u.id
 		WHERE bi.post_id = $1 AND bi.deleted_at IS NULL
+			AND (u.activity_private = false OR u.id = $2)
 		GROUP BY u.id, u.username, u.profile_picture_url
 		ORDER BY first_saved ASC
-	`, postID)
+	`, postID, uuidPointerValue(viewerID))
 	if err != nil {
 		recordSpanError(span, err)
 		return nil, fmt.Errorf("failed to query bookshelf users: %w", err)
@@ -748,8 +809,8 @@ func (s *BookshelfService) GetBookshelfStatsForPosts(
 			FROM bookshelf_items bi
 			LEFT JOIN bookshelf_categories bc ON bc.id = bi.category_id
 			WHERE bi.post_id = ANY($1) AND bi.user_id = $2 AND bi.deleted_at IS NULL
-			ORDER BY bi.post_id, bi.created_at ASC, bi.id ASC
-		`, pq.Array(postIDs), *viewerID)
+			ORDER BY `+viewerCategoryOrderBy(ViewerCategoryStatBookshelf, "bc.name", "bi.created_at", "bc.position"),
+			pq.Array(postIDs), *viewerID)
 		if err != nil {
 			recordSpanError(span, err)
 			return nil, fmt.Errorf("failed to query viewer bookshelf categories for stats: %w", err)
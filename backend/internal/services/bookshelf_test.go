@@ -160,6 +160,54 @@ func TestBookshelfCategoryCRUDWithDeleteRecategorization(t *testing.T) {
 	}
 }
 
+func TestBookshelfCategoryCounts(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	userID := uuid.MustParse(testutil.CreateTestUser(t, db, "bookshelfcounts", "bookshelfcounts@test.com", false, true))
+	sectionID := testutil.CreateTestSection(t, db, "Books", "book")
+	postA := uuid.MustParse(testutil.CreateTestPost(t, db, userID.String(), sectionID, "Book A"))
+	postB := uuid.MustParse(testutil.CreateTestPost(t, db, userID.String(), sectionID, "Book B"))
+	postC := uuid.MustParse(testutil.CreateTestPost(t, db, userID.String(), sectionID, "Book C"))
+
+	service := NewBookshelfService(db)
+	reading, err := service.CreateCategory(context.Background(), userID, "Reading")
+	if err != nil {
+		t.Fatalf("CreateCategory Reading failed: %v", err)
+	}
+	empty, err := service.CreateCategory(context.Background(), userID, "Abandoned")
+	if err != nil {
+		t.Fatalf("CreateCategory Abandoned failed: %v", err)
+	}
+
+	for _, postID := range []uuid.UUID{postA, postB} {
+		if err := service.AddToBookshelf(context.Background(), userID, postID, []string{"Reading"}); err != nil {
+			t.Fatalf("AddToBookshelf Reading failed: %v", err)
+		}
+	}
+	if err := service.AddToBookshelf(context.Background(), userID, postC, nil); err != nil {
+		t.Fatalf("AddToBookshelf uncategorized failed: %v", err)
+	}
+
+	counts, err := service.GetCategoryCounts(context.Background(), userID)
+	if err != nil {
+		t.Fatalf("GetCategoryCounts failed: %v", err)
+	}
+	if len(counts) != 3 {
+		t.Fatalf("expected 3 categories, got %+v", counts)
+	}
+
+	if counts[0].ID == nil || *counts[0].ID != reading.ID || counts[0].ItemCount != 2 {
+		t.Fatalf("expected Reading with 2 items first, got %+v", counts[0])
+	}
+	if counts[1].ID == nil || *counts[1].ID != empty.ID || counts[1].ItemCount != 0 {
+		t.Fatalf("expected Abandoned with 0 items second, got %+v", counts[1])
+	}
+	if counts[2].ID != nil || counts[2].Name != defaultBookshelfCategoryName || counts[2].ItemCount != 1 {
+		t.Fatalf("expected Uncategorized with 1 item last, got %+v", counts[2])
+	}
+}
+
 func TestBookshelfReorderCategories(t *testing.T) {
 	db := testutil.RequireTestDB(t)
 	t.Cleanup(func() { testutil.CleanupTables(t, db) })
@@ -7,9 +7,9 @@ import (
 	"errors"
 	"fmt"
 	"strings"
-	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/sanderginn/clubhouse/internal/models"
 	"github.com/sanderginn/clubhouse/internal/observability"
 	"go.opentelemetry.io/otel"
@@ -408,9 +408,10 @@ func (s *CommentService) UpdateComment(ctx context.Context, commentID uuid.UUID,
 
 	_, err = tx.ExecContext(ctx, `
 		UPDATE comments
-		SET content = $1, contains_spoiler = $2, updated_at = now()
+		SET content = $1, contains_spoiler = $2,
+			updated_at = CASE WHEN created_at > now() - make_interval(secs => $4) THEN updated_at ELSE now() END
 		WHERE id = $3
-	`, trimmedContent, containsSpoiler, commentID)
+	`, trimmedContent, containsSpoiler, commentID, editGracePeriodSeconds())
 	if err != nil {
 		recordSpanError(span, err)
 		return nil, fmt.Errorf("failed to update comment: %w", err)
@@ -619,6 +620,54 @@ func (s *CommentService) getCommentLinks(ctx context.Context, commentID uuid.UUI
 	return links, nil
 }
 
+// getCommentLinksForComments retrieves the links for several comments in one query, keyed by
+// comment ID. Comments without links have no entry.
+func (s *CommentService) getCommentLinksForComments(ctx context.Context, commentIDs []uuid.UUID) (map[uuid.UUID][]models.Link, error) {
+	linksByComment := make(map[uuid.UUID][]models.Link, len(commentIDs))
+	if len(commentIDs) == 0 {
+		return linksByComment, nil
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT comment_id, id, url, metadata, created_at
+		FROM links
+		WHERE comment_id = ANY($1)
+		ORDER BY created_at ASC
+	`, pq.Array(commentIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var commentID uuid.UUID
+		var link models.Link
+		var metadataJSON sql.NullString
+
+		err := rows.Scan(&commentID, &link.ID, &link.URL, &metadataJSON, &link.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		// Parse metadata if present
+		if metadataJSON.Valid {
+			err := json.Unmarshal([]byte(metadataJSON.String), &link.Metadata)
+			if err != nil {
+				// If metadata is invalid JSON, just skip it
+				link.Metadata = nil
+			}
+		}
+
+		linksByComment[commentID] = append(linksByComment[commentID], link)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return linksByComment, nil
+}
+
 func getCommentLinkURLs(ctx context.Context, queryer interface {
 	QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
 }, commentID uuid.UUID) ([]string, error) {
@@ -645,52 +694,66 @@ func getCommentLinkURLs(ctx context.Context, queryer interface {
 	return urls, rows.Err()
 }
 
+// commentReactions holds the reaction counts on a comment and the emojis the viewer reacted with.
+type commentReactions struct {
+	counts          map[string]int
+	viewerReactions []string
+}
+
 // getCommentReactions retrieves reaction counts and viewer reactions for a comment
 func (s *CommentService) getCommentReactions(ctx context.Context, commentID uuid.UUID, viewerID uuid.UUID) (map[string]int, []string, error) {
-	// Get counts
+	reactionsByComment, err := s.getCommentReactionsForComments(ctx, []uuid.UUID{commentID}, viewerID)
+	if err != nil {
+		return nil, nil, err
+	}
+	reactions := reactionsByComment[commentID]
+	return reactions.counts, reactions.viewerReactions, nil
+}
+
+// getCommentReactionsForComments retrieves reaction counts and viewer reactions for several
+// comments in one query. Every requested comment has an entry, even without reactions.
+func (s *CommentService) getCommentReactionsForComments(ctx context.Context, commentIDs []uuid.UUID, viewerID uuid.UUID) (map[uuid.UUID]*commentReactions, error) {
+	reactionsByComment := make(map[uuid.UUID]*commentReactions, len(commentIDs))
+	for _, commentID := range commentIDs {
+		reactionsByComment[commentID] = &commentReactions{counts: make(map[string]int)}
+	}
+	if len(commentIDs) == 0 {
+		return reactionsByComment, nil
+	}
+
 	rows, err := s.db.QueryContext(ctx, `
-		SELECT emoji, COUNT(*)
+		SELECT comment_id, emoji, COUNT(*), bool_or(user_id = $2)
 		FROM reactions
-		WHERE comment_id = $1 AND deleted_at IS NULL
-		GROUP BY emoji
-	`, commentID)
+		WHERE comment_id = ANY($1) AND deleted_at IS NULL
+		GROUP BY comment_id, emoji
+	`, pq.Array(commentIDs), viewerID)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 	defer rows.Close()
 
-	counts := make(map[string]int)
 	for rows.Next() {
+		var commentID uuid.UUID
 		var emoji string
 		var count int
-		if err := rows.Scan(&emoji, &count); err != nil {
-			return nil, nil, err
+		var viewerReacted bool
+		if err := rows.Scan(&commentID, &emoji, &count, &viewerReacted); err != nil {
+			return nil, err
 		}
-		counts[emoji] = count
-	}
-
-	var viewerReactions []string
-	if viewerID != uuid.Nil {
-		rows, err := s.db.QueryContext(ctx, `
-			SELECT emoji
-			FROM reactions
-			WHERE comment_id = $1 AND user_id = $2 AND deleted_at IS NULL
-		`, commentID, viewerID)
-		if err != nil {
-			return nil, nil, err
+		reactions, ok := reactionsByComment[commentID]
+		if !ok {
+			continue
 		}
-		defer rows.Close()
-
-		for rows.Next() {
-			var emoji string
-			if err := rows.Scan(&emoji); err != nil {
-				return nil, nil, err
-			}
-			viewerReactions = append(viewerReactions, emoji)
+		reactions.counts[emoji] = count
+		if viewerReacted && viewerID != uuid.Nil {
+			reactions.viewerReactions = append(reactions.viewerReactions, emoji)
 		}
 	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
 
-	return counts, viewerReactions, nil
+	return reactionsByComment, nil
 }
 
 // validateCreateCommentInput validates comment creation input
@@ -729,33 +792,55 @@ func validateCreateCommentInput(req *models.CreateCommentRequest) error {
 	return nil
 }
 
-// GetThreadComments retrieves all comments for a post with cursor-based pagination
-func (s *CommentService) GetThreadComments(ctx context.Context, postID uuid.UUID, limit int, cursor *string, userID uuid.UUID) ([]models.Comment, *string, bool, error) {
+// GetThreadComments retrieves all comments for a post with cursor-based pagination.
+// Top-level comments are ordered by sort; an empty sort uses the default of the post's section.
+// The sort that was applied is returned with the page.
+func (s *CommentService) GetThreadComments(ctx context.Context, postID uuid.UUID, limit int, cursor *string, sort string, userID uuid.UUID) ([]models.Comment, *string, bool, bool, string, error) {
 	ctx, span := otel.Tracer("clubhouse.comments").Start(ctx, "CommentService.GetThreadComments")
 	span.SetAttributes(
 		attribute.String("post_id", postID.String()),
 		attribute.String("user_id", userID.String()),
 		attribute.Int("limit", limit),
 		attribute.Bool("has_cursor", cursor != nil && *cursor != ""),
+		attribute.String("sort", sort),
 	)
 	defer span.End()
 
 	if limit <= 0 || limit > 100 {
 		limit = 50
 	}
+	maxComments := GetConfigService().GetSettings().ThreadMaxComments
+	if limit > maxComments {
+		limit = maxComments
+	}
 
-	// Validate post exists and is not deleted
-	var postExists bool
-	err := s.db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM posts WHERE id = $1 AND deleted_at IS NULL)", postID).Scan(&postExists)
+	sort, err := normalizeCommentSort(sort)
 	if err != nil {
 		recordSpanError(span, err)
-		return nil, nil, false, fmt.Errorf("failed to check post existence: %w", err)
+		return nil, nil, false, false, "", err
 	}
-	if !postExists {
+
+	// Validate post exists and is not deleted, and look up its section's default sort
+	var sectionSort string
+	err = s.db.QueryRowContext(ctx, `
+		SELECT COALESCE(s.default_comment_sort, $2)
+		FROM posts p
+		JOIN sections s ON p.section_id = s.id
+		WHERE p.id = $1 AND p.deleted_at IS NULL
+	`, postID, CommentSortNew).Scan(&sectionSort)
+	if errors.Is(err, sql.ErrNoRows) {
 		notFoundErr := errors.New("post not found")
 		recordSpanError(span, notFoundErr)
-		return nil, nil, false, notFoundErr
+		return nil, nil, false, false, "", notFoundErr
 	}
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, nil, false, false, "", fmt.Errorf("failed to check post existence: %w", err)
+	}
+	if sort == "" {
+		sort = sectionSort
+	}
+	span.SetAttributes(attribute.String("resolved_sort", sort))
 
 	// Build query for top-level comments
 	query := `
@@ -776,33 +861,44 @@ func (s *CommentService) GetThreadComments(ctx context.Context, postID uuid.UUID
 		if err != nil {
 			invalidErr := errors.New("invalid cursor")
 			recordSpanError(span, invalidErr)
-			return nil, nil, false, invalidErr
+			return nil, nil, false, false, "", invalidErr
 		}
 
-		// Get cursor comment's creation time
+		// Get cursor comment's creation time and reaction count
 		var cursorTime sql.NullTime
-		err = s.db.QueryRowContext(ctx, "SELECT created_at FROM comments WHERE id = $1", cursorID).Scan(&cursorTime)
+		var cursorReactions int
+		err = s.db.QueryRowContext(ctx, "SELECT c.created_at, "+commentReactionCountSQL+" FROM comments c WHERE c.id = $1", cursorID).Scan(&cursorTime, &cursorReactions)
 		if err == sql.ErrNoRows {
 			cursorErr := errors.New("cursor not found")
 			recordSpanError(span, cursorErr)
-			return nil, nil, false, cursorErr
+			return nil, nil, false, false, "", cursorErr
 		}
 		if err != nil {
 			recordSpanError(span, err)
-			return nil, nil, false, fmt.Errorf("failed to get cursor time: %w", err)
+			return nil, nil, false, false, "", fmt.Errorf("failed to get cursor time: %w", err)
+		}
+
+		if sort == CommentSortTop {
+			query += " AND (" + commentReactionCountSQL + ", c.created_at) < ($2, $3)"
+			args = append(args, cursorReactions)
+		} else {
+			query += " AND c.created_at < $2"
 		}
+		args = append(args, cursorTime.Time)
+	}
 
-		query += " AND c.created_at < $2 ORDER BY c.created_at DESC LIMIT $3"
-		args = append(args, cursorTime.Time, limit+1)
+	if sort == CommentSortTop {
+		query += " ORDER BY " + commentReactionCountSQL + " DESC, c.created_at DESC"
 	} else {
-		query += " ORDER BY c.created_at DESC LIMIT $2"
-		args = append(args, limit+1)
+		query += " ORDER BY c.created_at DESC"
 	}
+	query += fmt.Sprintf(" LIMIT $%d", len(args)+1)
+	args = append(args, limit+1)
 
 	rows, err := s.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		recordSpanError(span, err)
-		return nil, nil, false, fmt.Errorf("failed to query comments: %w", err)
+		return nil, nil, false, false, "", fmt.Errorf("failed to query comments: %w", err)
 	}
 	defer rows.Close()
 
@@ -824,7 +920,7 @@ func (s *CommentService) GetThreadComments(ctx context.Context, postID uuid.UUID
 		)
 		if err != nil {
 			recordSpanError(span, err)
-			return nil, nil, false, fmt.Errorf("failed to scan comment: %w", err)
+			return nil, nil, false, false, "", fmt.Errorf("failed to scan comment: %w", err)
 		}
 
 		if parentID.Valid {
@@ -857,25 +953,32 @@ func (s *CommentService) GetThreadComments(ctx context.Context, postID uuid.UUID
 		links, err := s.getCommentLinks(ctx, c.ID)
 		if err != nil {
 			recordSpanError(span, err)
-			return nil, nil, false, fmt.Errorf("failed to get comment links: %w", err)
+			return nil, nil, false, false, "", fmt.Errorf("failed to get comment links: %w", err)
 		}
 		c.Links = links
 
-		// Fetch reactions
-		counts, viewerReactions, err := s.getCommentReactions(ctx, c.ID, userID)
-		if err != nil {
-			recordSpanError(span, err)
-			return nil, nil, false, fmt.Errorf("failed to get comment reactions: %w", err)
-		}
-		c.ReactionCounts = counts
-		c.ViewerReactions = viewerReactions
-
 		comments = append(comments, c)
 	}
 
 	if err = rows.Err(); err != nil {
 		recordSpanError(span, err)
-		return nil, nil, false, fmt.Errorf("error iterating rows: %w", err)
+		return nil, nil, false, false, "", fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	// Fetch reactions for all top-level comments at once
+	commentIDs := make([]uuid.UUID, len(comments))
+	for i := range comments {
+		commentIDs[i] = comments[i].ID
+	}
+	reactionsByComment, err := s.getCommentReactionsForComments(ctx, commentIDs, userID)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, nil, false, false, "", fmt.Errorf("failed to get comment reactions: %w", err)
+	}
+	for i := range comments {
+		reactions := reactionsByComment[comments[i].ID]
+		comments[i].ReactionCounts = reactions.counts
+		comments[i].ViewerReactions = reactions.viewerReactions
 	}
 
 	// Check if there are more results
@@ -888,21 +991,51 @@ func (s *CommentService) GetThreadComments(ctx context.Context, postID uuid.UUID
 		nextCursor = &nextCursorID
 	}
 
-	// Fetch replies for each top-level comment
+	// Fetch replies for each top-level comment, loading no more than the remaining cap allows.
+	// A comment whose replies do not fit is left for the next page so its replies are not split,
+	// unless it is the first comment on the page.
+	remaining := maxComments
+	kept := 0
+	truncated := false
 	for i := range comments {
-		replies, err := s.getCommentReplies(ctx, comments[i].ID, userID)
+		if remaining <= 0 {
+			truncated = true
+			break
+		}
+		remaining--
+		replies, err := s.getCommentReplies(ctx, comments[i].ID, userID, remaining+1)
 		if err != nil {
 			recordSpanError(span, err)
-			return nil, nil, false, fmt.Errorf("failed to get comment replies: %w", err)
+			return nil, nil, false, false, "", fmt.Errorf("failed to get comment replies: %w", err)
+		}
+		if len(replies) > remaining {
+			truncated = true
+			if i > 0 {
+				break
+			}
+			replies = replies[:remaining]
 		}
 		comments[i].Replies = replies
+		remaining -= len(replies)
+		kept = i + 1
+		if truncated {
+			break
+		}
+	}
+	if kept < len(comments) {
+		// Resume pagination after the last top-level comment that made it into the response
+		comments = comments[:kept]
+		hasMore = true
+		nextCursorID := comments[kept-1].ID.String()
+		nextCursor = &nextCursorID
 	}
+	span.SetAttributes(attribute.Bool("truncated", truncated))
 
-	return comments, nextCursor, hasMore, nil
+	return comments, nextCursor, hasMore, truncated, sort, nil
 }
 
-// getCommentReplies retrieves all replies to a comment
-func (s *CommentService) getCommentReplies(ctx context.Context, parentCommentID uuid.UUID, userID uuid.UUID) ([]models.Comment, error) {
+// getCommentReplies retrieves up to limit replies to a comment, oldest first
+func (s *CommentService) getCommentReplies(ctx context.Context, parentCommentID uuid.UUID, userID uuid.UUID, limit int) ([]models.Comment, error) {
 	query := `
 		SELECT
 			c.id, c.user_id, c.post_id, c.parent_comment_id, c.image_id, c.timestamp_seconds, c.content, c.contains_spoiler,
@@ -912,9 +1045,10 @@ func (s *CommentService) getCommentReplies(ctx context.Context, parentCommentID
 		JOIN users u ON c.user_id = u.id
 		WHERE c.parent_comment_id = $1 AND c.deleted_at IS NULL
 		ORDER BY c.created_at ASC
+		LIMIT $2
 	`
 
-	rows, err := s.db.QueryContext(ctx, query, parentCommentID)
+	rows, err := s.db.QueryContext(ctx, query, parentCommentID, limit)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query replies: %w", err)
 	}
@@ -973,18 +1107,27 @@ func (s *CommentService) getCommentReplies(ctx context.Context, parentCommentID
 		}
 		c.Links = links
 
-		// Fetch reactions
-		counts, viewerReactions, err := s.getCommentReactions(ctx, c.ID, userID)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get reply reactions: %w", err)
-		}
-		c.ReactionCounts = counts
-		c.ViewerReactions = viewerReactions
-
 		replies = append(replies, c)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	replyIDs := make([]uuid.UUID, len(replies))
+	for i := range replies {
+		replyIDs[i] = replies[i].ID
+	}
+	reactionsByComment, err := s.getCommentReactionsForComments(ctx, replyIDs, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reply reactions: %w", err)
+	}
+	for i := range replies {
+		reactions := reactionsByComment[replies[i].ID]
+		replies[i].ReactionCounts = reactions.counts
+		replies[i].ViewerReactions = reactions.viewerReactions
+	}
 
-	return replies, rows.Err()
+	return replies, nil
 }
 
 // DeleteComment soft deletes a comment by setting deleted_at and deleted_by_user_id
@@ -1093,7 +1236,7 @@ func (s *CommentService) DeleteComment(ctx context.Context, commentID uuid.UUID,
 }
 
 // RestoreComment restores a soft-deleted comment
-// Only the comment owner (within 7 days) or an admin can restore
+// Only the comment owner (within the restore window) or an admin can restore
 func (s *CommentService) RestoreComment(ctx context.Context, commentID uuid.UUID, userID uuid.UUID, isAdmin bool) (*models.Comment, error) {
 	ctx, span := otel.Tracer("clubhouse.comments").Start(ctx, "CommentService.RestoreComment")
 	span.SetAttributes(
@@ -1165,8 +1308,7 @@ func (s *CommentService) RestoreComment(ctx context.Context, commentID uuid.UUID
 	}
 
 	if !isAdmin && comment.DeletedAt != nil {
-		sevenDaysAgo := time.Now().AddDate(0, 0, -7)
-		if comment.DeletedAt.Before(sevenDaysAgo) {
+		if restoreWindowExpired(*comment.DeletedAt) {
 			permanentErr := errors.New("comment permanently deleted")
 			recordSpanError(span, permanentErr)
 			return nil, permanentErr
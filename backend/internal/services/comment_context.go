@@ -0,0 +1,139 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/sanderginn/clubhouse/internal/models"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// DefaultCommentContextMaxDepth is how many ancestors the comment context endpoint returns by default.
+const DefaultCommentContextMaxDepth = 20
+
+// GetCommentThreadContext returns a comment with its nearest ancestors, ordered from the
+// outermost returned ancestor down to the direct parent. Threads deeper than the configured
+// maximum are cut off at the top and flagged as truncated. Deleted ancestors are skipped.
+func (s *CommentService) GetCommentThreadContext(ctx context.Context, commentID uuid.UUID, userID uuid.UUID) (*models.CommentThreadContext, error) {
+	ctx, span := otel.Tracer("clubhouse.comments").Start(ctx, "CommentService.GetCommentThreadContext")
+	maxDepth := GetConfigService().GetSettings().CommentContextMaxDepth
+	span.SetAttributes(
+		attribute.String("comment_id", commentID.String()),
+		attribute.String("user_id", userID.String()),
+		attribute.Int("max_depth", maxDepth),
+	)
+	defer span.End()
+
+	comment, err := s.GetCommentByID(ctx, commentID, userID)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+
+	// Walk one level past the cap so truncation can be detected without counting the whole chain.
+	// Deleted ancestors are still walked through so the chain above them is kept.
+	rows, err := s.db.QueryContext(ctx, `
+		WITH RECURSIVE ancestors AS (
+			SELECT c.parent_comment_id AS id, 1 AS depth
+			FROM comments c
+			WHERE c.id = $1 AND c.parent_comment_id IS NOT NULL
+			UNION ALL
+			SELECT parent.parent_comment_id, a.depth + 1
+			FROM ancestors a
+			JOIN comments parent ON parent.id = a.id
+			WHERE parent.parent_comment_id IS NOT NULL AND a.depth <= $2
+		)
+		SELECT
+			a.depth,
+			c.id, c.user_id, c.post_id, p.section_id, c.parent_comment_id, c.image_id, c.timestamp_seconds, c.content, c.contains_spoiler,
+			c.created_at, c.updated_at, c.deleted_at, c.deleted_by_user_id,
+			u.id, u.username, COALESCE(u.email, '') as email, u.profile_picture_url, u.bio, u.is_admin, u.created_at
+		FROM ancestors a
+		JOIN comments c ON c.id = a.id
+		JOIN posts p ON c.post_id = p.id
+		JOIN users u ON c.user_id = u.id
+		ORDER BY a.depth DESC
+	`, commentID, maxDepth)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, fmt.Errorf("failed to query comment ancestors: %w", err)
+	}
+	defer rows.Close()
+
+	threadContext := &models.CommentThreadContext{
+		Comment:   comment,
+		Ancestors: []models.Comment{},
+	}
+	for rows.Next() {
+		var depth int
+		var ancestor models.Comment
+		var user models.User
+		var sectionID uuid.UUID
+		var imageID sql.NullString
+		var timestampSeconds sql.NullInt32
+		if err := rows.Scan(
+			&depth,
+			&ancestor.ID, &ancestor.UserID, &ancestor.PostID, &sectionID, &ancestor.ParentCommentID, &imageID, &timestampSeconds, &ancestor.Content, &ancestor.ContainsSpoiler,
+			&ancestor.CreatedAt, &ancestor.UpdatedAt, &ancestor.DeletedAt, &ancestor.DeletedByUserID,
+			&user.ID, &user.Username, &user.Email, &user.ProfilePictureURL, &user.Bio, &user.IsAdmin, &user.CreatedAt,
+		); err != nil {
+			recordSpanError(span, err)
+			return nil, fmt.Errorf("failed to scan comment ancestor: %w", err)
+		}
+		if depth > maxDepth {
+			threadContext.AncestorsTruncated = true
+			continue
+		}
+		if ancestor.DeletedAt != nil {
+			continue
+		}
+
+		ancestor.User = &user
+		ancestor.SectionID = &sectionID
+		if imageID.Valid {
+			parsedID, _ := uuid.Parse(imageID.String)
+			ancestor.ImageID = &parsedID
+		}
+		if timestampSeconds.Valid {
+			value := int(timestampSeconds.Int32)
+			ancestor.TimestampSeconds = &value
+			applyCommentTimestampDisplay(&ancestor)
+		}
+		threadContext.Ancestors = append(threadContext.Ancestors, ancestor)
+	}
+	if err := rows.Err(); err != nil {
+		recordSpanError(span, err)
+		return nil, fmt.Errorf("failed to iterate comment ancestors: %w", err)
+	}
+
+	ancestorIDs := make([]uuid.UUID, 0, len(threadContext.Ancestors))
+	for _, ancestor := range threadContext.Ancestors {
+		ancestorIDs = append(ancestorIDs, ancestor.ID)
+	}
+
+	linksByComment, err := s.getCommentLinksForComments(ctx, ancestorIDs)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+	reactionsByComment, err := s.getCommentReactionsForComments(ctx, ancestorIDs, userID)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+	for i := range threadContext.Ancestors {
+		threadContext.Ancestors[i].Links = linksByComment[threadContext.Ancestors[i].ID]
+		reactions := reactionsByComment[threadContext.Ancestors[i].ID]
+		threadContext.Ancestors[i].ReactionCounts = reactions.counts
+		threadContext.Ancestors[i].ViewerReactions = reactions.viewerReactions
+	}
+
+	span.SetAttributes(
+		attribute.Int("ancestor_count", len(threadContext.Ancestors)),
+		attribute.Bool("ancestors_truncated", threadContext.AncestorsTruncated),
+	)
+	return threadContext, nil
+}
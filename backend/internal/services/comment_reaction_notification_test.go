@@ -0,0 +1,87 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sanderginn/clubhouse/internal/models"
+	"github.com/sanderginn/clubhouse/internal/testutil"
+)
+
+func countCommentReactionNotifications(t *testing.T, db *sql.DB, userID, commentID string) int {
+	t.Helper()
+	var count int
+	if err := db.QueryRow(`
+		SELECT COUNT(*) FROM notifications
+		WHERE user_id = $1 AND type = 'reaction' AND related_comment_id = $2
+	`, userID, commentID).Scan(&count); err != nil {
+		t.Fatalf("failed to count notifications: %v", err)
+	}
+	return count
+}
+
+func TestCommentReactionNotifiesAuthor(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	authorID := testutil.CreateTestUser(t, db, "commentauthor", "commentauthor@test.com", false, true)
+	reactorID := testutil.CreateTestUser(t, db, "commentreactor", "commentreactor@test.com", false, true)
+	sectionID := testutil.CreateTestSection(t, db, "Reaction Section", "general")
+	postID := testutil.CreateTestPost(t, db, authorID, sectionID, "Post")
+	commentID := testutil.CreateTestComment(t, db, authorID, postID, "Nice comment")
+
+	ctx := context.Background()
+	reactions := NewReactionService(db)
+	notifications := NewNotificationService(db, nil, nil)
+
+	for _, emoji := range []string{"👍", "🎉"} {
+		if _, err := reactions.AddReactionToComment(ctx, uuid.MustParse(commentID), uuid.MustParse(reactorID), emoji); err != nil {
+			t.Fatalf("AddReactionToComment failed: %v", err)
+		}
+		if err := notifications.CreateNotificationForCommentReaction(ctx, uuid.MustParse(commentID), uuid.MustParse(reactorID)); err != nil {
+			t.Fatalf("CreateNotificationForCommentReaction failed: %v", err)
+		}
+	}
+
+	// The second reaction is coalesced into the author's unread notification.
+	if got := countCommentReactionNotifications(t, db, authorID, commentID); got != 1 {
+		t.Fatalf("expected 1 reaction notification for the author, got %d", got)
+	}
+
+	// Reacting to your own comment does not notify you.
+	if err := notifications.CreateNotificationForCommentReaction(ctx, uuid.MustParse(commentID), uuid.MustParse(authorID)); err != nil {
+		t.Fatalf("CreateNotificationForCommentReaction failed: %v", err)
+	}
+	if got := countCommentReactionNotifications(t, db, authorID, commentID); got != 1 {
+		t.Fatalf("expected self-reaction to be ignored, got %d notifications", got)
+	}
+}
+
+func TestCommentReactionNotificationsDisabled(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	authorID := testutil.CreateTestUser(t, db, "quietauthor", "quietauthor@test.com", false, true)
+	reactorID := testutil.CreateTestUser(t, db, "quietreactor", "quietreactor@test.com", false, true)
+	sectionID := testutil.CreateTestSection(t, db, "Quiet Section", "general")
+	postID := testutil.CreateTestPost(t, db, authorID, sectionID, "Post")
+	commentID := testutil.CreateTestComment(t, db, authorID, postID, "Quiet comment")
+
+	ctx := context.Background()
+	disabled := false
+	if _, err := NewUserService(db).UpdateNotificationPreferences(ctx, uuid.MustParse(authorID), &models.UpdateNotificationPreferencesRequest{CommentReactions: &disabled}); err != nil {
+		t.Fatalf("UpdateNotificationPreferences failed: %v", err)
+	}
+	if _, err := NewReactionService(db).AddReactionToComment(ctx, uuid.MustParse(commentID), uuid.MustParse(reactorID), "👍"); err != nil {
+		t.Fatalf("AddReactionToComment failed: %v", err)
+	}
+	if err := NewNotificationService(db, nil, nil).CreateNotificationForCommentReaction(ctx, uuid.MustParse(commentID), uuid.MustParse(reactorID)); err != nil {
+		t.Fatalf("CreateNotificationForCommentReaction failed: %v", err)
+	}
+
+	if got := countCommentReactionNotifications(t, db, authorID, commentID); got != 0 {
+		t.Fatalf("expected no reaction notifications when disabled, got %d", got)
+	}
+}
@@ -0,0 +1,27 @@
+package services
+
+import (
+	"errors"
+	"strings"
+)
+
+// Comment thread sort orders. Sections choose their default in sections.default_comment_sort,
+// where NULL means CommentSortNew.
+const (
+	// CommentSortNew lists top-level comments newest first.
+	CommentSortNew = "new"
+	// CommentSortTop lists top-level comments with the most reactions first.
+	CommentSortTop = "top"
+)
+
+// commentReactionCountSQL counts the active reactions on the comment aliased as c.
+const commentReactionCountSQL = "(SELECT COUNT(*) FROM reactions r WHERE r.comment_id = c.id AND r.deleted_at IS NULL)"
+
+func normalizeCommentSort(sort string) (string, error) {
+	switch sort = strings.ToLower(strings.TrimSpace(sort)); sort {
+	case "", CommentSortNew, CommentSortTop:
+		return sort, nil
+	default:
+		return "", errors.New("invalid sort")
+	}
+}
@@ -0,0 +1,101 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sanderginn/clubhouse/internal/testutil"
+)
+
+func TestGetThreadCommentsTopSortSectionDefault(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	userID := testutil.CreateTestUser(t, db, "topsortuser", "topsortuser@test.com", false, true)
+	fanID := testutil.CreateTestUser(t, db, "topsortfan", "topsortfan@test.com", false, true)
+	otherFanID := testutil.CreateTestUser(t, db, "topsortfan2", "topsortfan2@test.com", false, true)
+	sectionID := testutil.CreateTestSection(t, db, "Q&A Section", "general")
+	postID := testutil.CreateTestPost(t, db, userID, sectionID, "Which lens should I buy?")
+
+	popularID := testutil.CreateTestComment(t, db, userID, postID, "popular answer")
+	likedID := testutil.CreateTestComment(t, db, userID, postID, "liked answer")
+	newestID := testutil.CreateTestComment(t, db, userID, postID, "newest answer")
+
+	exec := func(query string, args ...interface{}) {
+		t.Helper()
+		if _, err := db.Exec(query, args...); err != nil {
+			t.Fatalf("setup query failed: %v", err)
+		}
+	}
+	exec(`UPDATE sections SET default_comment_sort = 'top' WHERE id = $1`, sectionID)
+	exec(`UPDATE comments SET created_at = now() - interval '2 hours' WHERE id = $1`, popularID)
+	exec(`UPDATE comments SET created_at = now() - interval '1 hour' WHERE id = $1`, likedID)
+	exec(`INSERT INTO reactions (user_id, comment_id, emoji) VALUES ($1, $3, '👍'), ($2, $3, '❤️')`, fanID, otherFanID, popularID)
+	exec(`INSERT INTO reactions (user_id, comment_id, emoji) VALUES ($1, $2, '👍')`, fanID, likedID)
+	exec(`INSERT INTO reactions (user_id, comment_id, emoji, deleted_at) VALUES ($1, $2, '🎉', now())`, otherFanID, likedID)
+
+	service := NewCommentService(db)
+	ctx := context.Background()
+
+	comments, _, _, _, sort, err := service.GetThreadComments(ctx, uuid.MustParse(postID), 50, nil, "", uuid.MustParse(userID))
+	if err != nil {
+		t.Fatalf("GetThreadComments failed: %v", err)
+	}
+	if sort != CommentSortTop {
+		t.Fatalf("expected default sort %q, got %q", CommentSortTop, sort)
+	}
+	want := []string{popularID, likedID, newestID}
+	if len(comments) != len(want) {
+		t.Fatalf("expected %d comments, got %d", len(want), len(comments))
+	}
+	for i, id := range want {
+		if comments[i].ID.String() != id {
+			t.Fatalf("expected comment %d to be %s, got %s", i, id, comments[i].ID)
+		}
+	}
+
+	// A cursor continues in reaction order.
+	cursor := popularID
+	page, _, _, _, _, err := service.GetThreadComments(ctx, uuid.MustParse(postID), 1, &cursor, "", uuid.MustParse(userID))
+	if err != nil {
+		t.Fatalf("GetThreadComments with cursor failed: %v", err)
+	}
+	if len(page) != 1 || page[0].ID.String() != likedID {
+		t.Fatalf("expected cursor page to start at %s", likedID)
+	}
+
+	// An explicit sort overrides the section default.
+	comments, _, _, _, sort, err = service.GetThreadComments(ctx, uuid.MustParse(postID), 50, nil, "new", uuid.MustParse(userID))
+	if err != nil {
+		t.Fatalf("GetThreadComments failed: %v", err)
+	}
+	if sort != CommentSortNew {
+		t.Fatalf("expected requested sort %q, got %q", CommentSortNew, sort)
+	}
+	if len(comments) != 3 || comments[0].ID.String() != newestID {
+		t.Fatalf("expected newest comment first with sort=new")
+	}
+
+	// Sections without a configured default list newest first.
+	exec(`UPDATE sections SET default_comment_sort = NULL WHERE id = $1`, sectionID)
+	if _, _, _, _, sort, err = service.GetThreadComments(ctx, uuid.MustParse(postID), 50, nil, "", uuid.MustParse(userID)); err != nil || sort != CommentSortNew {
+		t.Fatalf("expected sort %q without a section default, got %q (%v)", CommentSortNew, sort, err)
+	}
+
+	if _, _, _, _, _, err := service.GetThreadComments(ctx, uuid.MustParse(postID), 50, nil, "oldest", uuid.MustParse(userID)); err == nil || err.Error() != "invalid sort" {
+		t.Fatalf("expected invalid sort error, got %v", err)
+	}
+}
+
+func TestNormalizeCommentSort(t *testing.T) {
+	for input, want := range map[string]string{"": "", " Top ": CommentSortTop, "new": CommentSortNew} {
+		got, err := normalizeCommentSort(input)
+		if err != nil || got != want {
+			t.Fatalf("normalizeCommentSort(%q) = %q, %v; want %q", input, got, err, want)
+		}
+	}
+	if _, err := normalizeCommentSort("oldest"); err == nil {
+		t.Fatal("expected an error for an unknown sort")
+	}
+}
@@ -2,9 +2,11 @@ package services
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/sanderginn/clubhouse/internal/models"
@@ -315,6 +317,43 @@ func TestUpdateCommentToggleSpoiler(t *testing.T) {
 	}
 }
 
+func TestUpdateCommentEditGracePeriod(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	setSettingsForTest(t, func(settings *Settings) { settings.EditGracePeriod = 5 * time.Minute })
+
+	userID := testutil.CreateTestUser(t, db, "gracecommentuser", "gracecomment@test.com", false, true)
+	sectionID := testutil.CreateTestSection(t, db, "Grace Comment Section", "general")
+	postID := testutil.CreateTestPost(t, db, userID, sectionID, "Post")
+	commentID := testutil.CreateTestComment(t, db, userID, postID, "Original comment")
+
+	service := NewCommentService(db)
+	comment, err := service.UpdateComment(context.Background(), uuid.MustParse(commentID), uuid.MustParse(userID), &models.UpdateCommentRequest{
+		Content: "Fixed typo comment",
+	})
+	if err != nil {
+		t.Fatalf("UpdateComment failed: %v", err)
+	}
+	if comment.UpdatedAt != nil {
+		t.Fatalf("expected updated_at to stay null within grace period, got %v", comment.UpdatedAt)
+	}
+
+	if _, err := db.Exec(`UPDATE comments SET created_at = now() - interval '10 minutes' WHERE id = $1`, commentID); err != nil {
+		t.Fatalf("failed to backdate comment: %v", err)
+	}
+
+	comment, err = service.UpdateComment(context.Background(), uuid.MustParse(commentID), uuid.MustParse(userID), &models.UpdateCommentRequest{
+		Content: "Later edit comment",
+	})
+	if err != nil {
+		t.Fatalf("UpdateComment failed: %v", err)
+	}
+	if comment.UpdatedAt == nil {
+		t.Fatal("expected updated_at to be set after grace period")
+	}
+}
+
 func TestValidateCreateCommentInput(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -656,6 +695,210 @@ func TestAdminRestoreCommentCreatesAuditLogWithMetadata(t *testing.T) {
 	}
 }
 
+func TestRestoreCommentOwnerWithinWindow(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	userID := testutil.CreateTestUser(t, db, "commentselfrestore", "commentselfrestore@test.com", false, true)
+	sectionID := testutil.CreateTestSection(t, db, "Self Restore Section", "general")
+	postID := testutil.CreateTestPost(t, db, userID, sectionID, "Post for self restore")
+	commentID := testutil.CreateTestComment(t, db, userID, postID, "Comment to self restore")
+
+	service := NewCommentService(db)
+	if _, err := service.DeleteComment(context.Background(), uuid.MustParse(commentID), uuid.MustParse(userID), false); err != nil {
+		t.Fatalf("DeleteComment failed: %v", err)
+	}
+
+	comment, err := service.RestoreComment(context.Background(), uuid.MustParse(commentID), uuid.MustParse(userID), false)
+	if err != nil {
+		t.Fatalf("RestoreComment failed: %v", err)
+	}
+	if comment.DeletedAt != nil {
+		t.Fatalf("expected deleted_at to be cleared")
+	}
+	if comment.DeletedByUserID != nil {
+		t.Fatalf("expected deleted_by_user_id to be cleared")
+	}
+}
+
+func TestRestoreCommentOwnerAfterWindowFails(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+	setSettingsForTest(t, func(settings *Settings) { settings.RestoreWindow = time.Hour })
+
+	userID := testutil.CreateTestUser(t, db, "commentlaterestore", "commentlaterestore@test.com", false, true)
+	sectionID := testutil.CreateTestSection(t, db, "Late Restore Section", "general")
+	postID := testutil.CreateTestPost(t, db, userID, sectionID, "Post for late restore")
+	commentID := testutil.CreateTestComment(t, db, userID, postID, "Comment deleted long ago")
+
+	if _, err := db.Exec(`
+		UPDATE comments
+		SET deleted_at = now() - interval '2 hours', deleted_by_user_id = $2
+		WHERE id = $1
+	`, commentID, userID); err != nil {
+		t.Fatalf("failed to backdate comment deletion: %v", err)
+	}
+
+	service := NewCommentService(db)
+	_, err := service.RestoreComment(context.Background(), uuid.MustParse(commentID), uuid.MustParse(userID), false)
+	if err == nil || err.Error() != "comment permanently deleted" {
+		t.Fatalf("expected comment permanently deleted error, got %v", err)
+	}
+
+	var deletedAt sql.NullTime
+	if err := db.QueryRow("SELECT deleted_at FROM comments WHERE id = $1", commentID).Scan(&deletedAt); err != nil {
+		t.Fatalf("failed to query comment: %v", err)
+	}
+	if !deletedAt.Valid {
+		t.Fatalf("expected comment to remain deleted")
+	}
+}
+
+func TestGetThreadCommentsTruncatesAtCap(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+	setSettingsForTest(t, func(settings *Settings) { settings.ThreadMaxComments = 4 })
+
+	userID := testutil.CreateTestUser(t, db, "threadcapuser", "threadcapuser@test.com", false, true)
+	sectionID := testutil.CreateTestSection(t, db, "Thread Cap Section", "general")
+	postID := testutil.CreateTestPost(t, db, userID, sectionID, "Busy thread")
+
+	olderID := testutil.CreateTestComment(t, db, userID, postID, "older top-level")
+	newerID := testutil.CreateTestComment(t, db, userID, postID, "newer top-level")
+	if _, err := db.Exec("UPDATE comments SET created_at = now() - interval '1 hour' WHERE id = $1", olderID); err != nil {
+		t.Fatalf("failed to backdate comment: %v", err)
+	}
+	for i := 0; i < 3; i++ {
+		if _, err := db.Exec(`
+			INSERT INTO comments (id, user_id, post_id, parent_comment_id, content, created_at)
+			VALUES (gen_random_uuid(), $1, $2, $3, $4, now())
+		`, userID, postID, newerID, "reply"); err != nil {
+			t.Fatalf("failed to create reply: %v", err)
+		}
+	}
+
+	service := NewCommentService(db)
+	comments, nextCursor, hasMore, truncated, _, err := service.GetThreadComments(context.Background(), uuid.MustParse(postID), 50, nil, CommentSortNew, uuid.MustParse(userID))
+	if err != nil {
+		t.Fatalf("GetThreadComments failed: %v", err)
+	}
+	if !truncated {
+		t.Fatalf("expected thread to be truncated")
+	}
+	if len(comments) != 1 || comments[0].ID.String() != newerID {
+		t.Fatalf("expected only the newest top-level comment, got %d comments", len(comments))
+	}
+	if len(comments[0].Replies) != 3 {
+		t.Fatalf("expected 3 replies, got %d", len(comments[0].Replies))
+	}
+	if !hasMore || nextCursor == nil || *nextCursor != newerID {
+		t.Fatalf("expected pagination to resume after %s, got hasMore=%v cursor=%v", newerID, hasMore, nextCursor)
+	}
+}
+
+func TestGetThreadCommentsDefersCommentWhoseRepliesDoNotFit(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+	setSettingsForTest(t, func(settings *Settings) { settings.ThreadMaxComments = 4 })
+
+	userID := testutil.CreateTestUser(t, db, "threaddeferuser", "threaddeferuser@test.com", false, true)
+	sectionID := testutil.CreateTestSection(t, db, "Thread Defer Section", "general")
+	postID := testutil.CreateTestPost(t, db, userID, sectionID, "Busy thread")
+
+	olderID := testutil.CreateTestComment(t, db, userID, postID, "older top-level")
+	newerID := testutil.CreateTestComment(t, db, userID, postID, "newer top-level")
+	if _, err := db.Exec("UPDATE comments SET created_at = now() - interval '1 hour' WHERE id = $1", olderID); err != nil {
+		t.Fatalf("failed to backdate comment: %v", err)
+	}
+	replyCounts := map[string]int{newerID: 1, olderID: 3}
+	for parentID, count := range replyCounts {
+		for i := 0; i < count; i++ {
+			if _, err := db.Exec(`
+				INSERT INTO comments (id, user_id, post_id, parent_comment_id, content, created_at)
+				VALUES (gen_random_uuid(), $1, $2, $3, $4, now())
+			`, userID, postID, parentID, "reply"); err != nil {
+				t.Fatalf("failed to create reply: %v", err)
+			}
+		}
+	}
+
+	service := NewCommentService(db)
+	comments, nextCursor, hasMore, truncated, _, err := service.GetThreadComments(context.Background(), uuid.MustParse(postID), 50, nil, CommentSortNew, uuid.MustParse(userID))
+	if err != nil {
+		t.Fatalf("GetThreadComments failed: %v", err)
+	}
+	if !truncated || !hasMore || nextCursor == nil || *nextCursor != newerID {
+		t.Fatalf("expected pagination to resume after %s, got truncated=%v hasMore=%v cursor=%v", newerID, truncated, hasMore, nextCursor)
+	}
+	if len(comments) != 1 || len(comments[0].Replies) != 1 {
+		t.Fatalf("expected only the newer comment with its reply, got %d comments", len(comments))
+	}
+
+	// The deferred comment arrives on the next page with all of its replies
+	comments, _, hasMore, truncated, _, err = service.GetThreadComments(context.Background(), uuid.MustParse(postID), 50, nextCursor, CommentSortNew, uuid.MustParse(userID))
+	if err != nil {
+		t.Fatalf("GetThreadComments next page failed: %v", err)
+	}
+	if truncated || hasMore {
+		t.Fatalf("expected the last page to be complete, got truncated=%v hasMore=%v", truncated, hasMore)
+	}
+	if len(comments) != 1 || comments[0].ID.String() != olderID || len(comments[0].Replies) != 3 {
+		t.Fatalf("expected the older comment with 3 replies, got %+v", comments)
+	}
+}
+
+func TestGetThreadCommentsIncludesReplyReactions(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	userID := testutil.CreateTestUser(t, db, "replyreactuser", "replyreactuser@test.com", false, true)
+	otherID := testutil.CreateTestUser(t, db, "replyreactother", "replyreactother@test.com", false, true)
+	sectionID := testutil.CreateTestSection(t, db, "Reply Reaction Section", "general")
+	postID := testutil.CreateTestPost(t, db, userID, sectionID, "Reacted thread")
+	parentID := testutil.CreateTestComment(t, db, userID, postID, "top-level")
+
+	var firstReplyID, secondReplyID string
+	for _, replyID := range []*string{&firstReplyID, &secondReplyID} {
+		if err := db.QueryRow(`
+			INSERT INTO comments (user_id, post_id, parent_comment_id, content)
+			VALUES ($1, $2, $3, 'reply')
+			RETURNING id
+		`, userID, postID, parentID).Scan(replyID); err != nil {
+			t.Fatalf("failed to create reply: %v", err)
+		}
+	}
+	if _, err := db.Exec(`
+		INSERT INTO reactions (user_id, comment_id, emoji)
+		VALUES ($1, $3, '👍'), ($2, $3, '👍'), ($2, $4, '🎉')
+	`, userID, otherID, firstReplyID, secondReplyID); err != nil {
+		t.Fatalf("failed to create reactions: %v", err)
+	}
+
+	service := NewCommentService(db)
+	comments, _, _, _, _, err := service.GetThreadComments(context.Background(), uuid.MustParse(postID), 50, nil, CommentSortNew, uuid.MustParse(userID))
+	if err != nil {
+		t.Fatalf("GetThreadComments failed: %v", err)
+	}
+	if len(comments) != 1 || len(comments[0].Replies) != 2 {
+		t.Fatalf("expected one comment with 2 replies, got %+v", comments)
+	}
+
+	for _, reply := range comments[0].Replies {
+		switch reply.ID.String() {
+		case firstReplyID:
+			if reply.ReactionCounts["👍"] != 2 || len(reply.ViewerReactions) != 1 || reply.ViewerReactions[0] != "👍" {
+				t.Fatalf("unexpected reactions on first reply: %v %v", reply.ReactionCounts, reply.ViewerReactions)
+			}
+		case secondReplyID:
+			if reply.ReactionCounts["🎉"] != 1 || len(reply.ViewerReactions) != 0 {
+				t.Fatalf("unexpected reactions on second reply: %v %v", reply.ReactionCounts, reply.ViewerReactions)
+			}
+		default:
+			t.Fatalf("unexpected reply %s", reply.ID)
+		}
+	}
+}
+
 func stringPtr(s string) *string {
 	return &s
 }
@@ -667,3 +910,74 @@ func intPtr(value int) *int {
 func boolPtr(value bool) *bool {
 	return &value
 }
+
+func TestGetCommentThreadContextTruncatesDeepChains(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+	setSettingsForTest(t, func(settings *Settings) { settings.CommentContextMaxDepth = 3 })
+
+	userID := testutil.CreateTestUser(t, db, "contextuser", "contextuser@test.com", false, true)
+	sectionID := testutil.CreateTestSection(t, db, "Context Section", "general")
+	postID := testutil.CreateTestPost(t, db, userID, sectionID, "Deep thread")
+
+	// Build a chain root -> reply1 -> ... -> reply5
+	chain := []string{testutil.CreateTestComment(t, db, userID, postID, "root")}
+	for i := 1; i <= 5; i++ {
+		var id string
+		if err := db.QueryRow(`
+			INSERT INTO comments (id, user_id, post_id, parent_comment_id, content, created_at)
+			VALUES (gen_random_uuid(), $1, $2, $3, $4, now())
+			RETURNING id
+		`, userID, postID, chain[len(chain)-1], "reply").Scan(&id); err != nil {
+			t.Fatalf("failed to create reply: %v", err)
+		}
+		chain = append(chain, id)
+	}
+
+	if _, err := db.Exec(`INSERT INTO reactions (user_id, comment_id, emoji) VALUES ($1, $2, '👍')`, userID, chain[3]); err != nil {
+		t.Fatalf("failed to create reaction: %v", err)
+	}
+	if _, err := db.Exec(`INSERT INTO links (comment_id, url, created_at) VALUES ($1, $2, now())`, chain[3], "https://example.com/ancestor"); err != nil {
+		t.Fatalf("failed to create link: %v", err)
+	}
+
+	service := NewCommentService(db)
+	threadContext, err := service.GetCommentThreadContext(context.Background(), uuid.MustParse(chain[5]), uuid.MustParse(userID))
+	if err != nil {
+		t.Fatalf("GetCommentThreadContext failed: %v", err)
+	}
+	if !threadContext.AncestorsTruncated {
+		t.Fatalf("expected ancestors to be truncated")
+	}
+	if len(threadContext.Ancestors) != 3 {
+		t.Fatalf("expected 3 ancestors, got %d", len(threadContext.Ancestors))
+	}
+	for i, want := range chain[2:5] {
+		if threadContext.Ancestors[i].ID.String() != want {
+			t.Fatalf("ancestor %d: expected %s, got %s", i, want, threadContext.Ancestors[i].ID)
+		}
+	}
+	if got := threadContext.Ancestors[1].ReactionCounts["👍"]; got != 1 {
+		t.Fatalf("expected ancestor reaction count 1, got %d", got)
+	}
+	if len(threadContext.Ancestors[1].ViewerReactions) != 1 {
+		t.Fatalf("expected viewer reaction on ancestor, got %v", threadContext.Ancestors[1].ViewerReactions)
+	}
+	if links := threadContext.Ancestors[1].Links; len(links) != 1 || links[0].URL != "https://example.com/ancestor" {
+		t.Fatalf("expected ancestor link, got %v", links)
+	}
+	if len(threadContext.Ancestors[0].Links) != 0 {
+		t.Fatalf("expected no links on ancestor without links, got %v", threadContext.Ancestors[0].Links)
+	}
+
+	shallow, err := service.GetCommentThreadContext(context.Background(), uuid.MustParse(chain[3]), uuid.MustParse(userID))
+	if err != nil {
+		t.Fatalf("GetCommentThreadContext failed: %v", err)
+	}
+	if shallow.AncestorsTruncated {
+		t.Fatalf("expected chain at the depth limit not to be truncated")
+	}
+	if len(shallow.Ancestors) != 3 || shallow.Ancestors[0].ID.String() != chain[0] {
+		t.Fatalf("expected full ancestry starting at root, got %d ancestors", len(shallow.Ancestors))
+	}
+}
@@ -0,0 +1,4 @@
+package services
+
+// DefaultThreadMaxComments is the default cap on comments (top-level plus replies) returned per thread request.
+const DefaultThreadMaxComments = 500
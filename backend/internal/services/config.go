@@ -3,8 +3,14 @@ package services
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"strings"
 	"sync"
+
+	"github.com/lib/pq"
+	linkmeta "github.com/sanderginn/clubhouse/internal/services/links"
 )
 
 // Config holds application configuration that can be toggled at runtime
@@ -12,13 +18,24 @@ type Config struct {
 	LinkMetadataEnabled bool   `json:"linkMetadataEnabled"`
 	MFARequired         bool   `json:"mfaRequired"`
 	DisplayTimezone     string `json:"displayTimezone"`
+	// AllowedImageHosts restricts externally hosted post images to these domains and their
+	// subdomains. An empty list allows any host. Uploaded images are always allowed.
+	AllowedImageHosts []string `json:"allowedImageHosts"`
+	// MaintenanceMode puts the instance into read-only mode for non-admin writes.
+	MaintenanceMode bool `json:"maintenanceMode"`
+	// AllowedReactions restricts reactions to these emojis in every section without an
+	// override. An empty list allows any emoji.
+	AllowedReactions []string `json:"allowedReactions"`
+	// SectionReactions replaces AllowedReactions for the listed section types.
+	SectionReactions map[string][]string `json:"sectionReactions"`
 }
 
 // ConfigService provides thread-safe access to runtime configuration
 type ConfigService struct {
-	mu     sync.RWMutex
-	config Config
-	db     *sql.DB
+	mu       sync.RWMutex
+	config   Config
+	settings Settings
+	db       *sql.DB
 }
 
 // Global config service instance
@@ -33,7 +50,11 @@ func GetConfigService() *ConfigService {
 				LinkMetadataEnabled: true, // Enabled by default
 				MFARequired:         false,
 				DisplayTimezone:     "UTC",
+				AllowedImageHosts:   []string{},
+				AllowedReactions:    []string{},
+				SectionReactions:    map[string][]string{},
 			},
+			settings: DefaultSettings(),
 		}
 	})
 	return globalConfigService
@@ -53,23 +74,60 @@ func InitConfigService(ctx context.Context, db *sql.DB) error {
 func (s *ConfigService) GetConfig() Config {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
-	return s.config
+	return s.config.copy()
+}
+
+// ConfigUpdate lists the settings to change in UpdateConfig. Nil fields keep their current value.
+type ConfigUpdate struct {
+	LinkMetadataEnabled *bool
+	MFARequired         *bool
+	DisplayTimezone     *string
+	AllowedImageHosts   *[]string
+	MaintenanceMode     *bool
+	// AllowedReactions replaces the global reaction allowlist.
+	AllowedReactions *[]string
+	// SectionReactions replaces every per-section-type reaction override.
+	SectionReactions *map[string][]string
 }
 
 // UpdateConfig updates the configuration with the provided values
-func (s *ConfigService) UpdateConfig(ctx context.Context, linkMetadataEnabled *bool, mfaRequired *bool, displayTimezone *string) (Config, error) {
+func (s *ConfigService) UpdateConfig(ctx context.Context, update ConfigUpdate) (Config, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	updated := s.config
-	if linkMetadataEnabled != nil {
-		updated.LinkMetadataEnabled = *linkMetadataEnabled
+	updated := s.config.copy()
+	if update.LinkMetadataEnabled != nil {
+		updated.LinkMetadataEnabled = *update.LinkMetadataEnabled
+	}
+	if update.MFARequired != nil {
+		updated.MFARequired = *update.MFARequired
+	}
+	if update.DisplayTimezone != nil {
+		updated.DisplayTimezone = *update.DisplayTimezone
+	}
+	if update.AllowedImageHosts != nil {
+		hosts, err := NormalizeAllowedImageHosts(*update.AllowedImageHosts)
+		if err != nil {
+			return s.config.copy(), err
+		}
+		updated.AllowedImageHosts = hosts
+	}
+	if update.MaintenanceMode != nil {
+		updated.MaintenanceMode = *update.MaintenanceMode
 	}
-	if mfaRequired != nil {
-		updated.MFARequired = *mfaRequired
+	if update.AllowedReactions != nil {
+		emojis, err := NormalizeReactionSet(*update.AllowedReactions)
+		if err != nil {
+			return s.config.copy(), err
+		}
+		updated.AllowedReactions = emojis
 	}
-	if displayTimezone != nil {
-		updated.DisplayTimezone = *displayTimezone
+	if update.SectionReactions != nil {
+		overrides, err := NormalizeSectionReactions(*update.SectionReactions)
+		if err != nil {
+			return s.config.copy(), err
+		}
+		updated.SectionReactions = overrides
 	}
 
 	if s.db != nil {
@@ -77,12 +135,30 @@ func (s *ConfigService) UpdateConfig(ctx context.Context, linkMetadataEnabled *b
 			ctx = context.Background()
 		}
 		if err := s.persistConfig(ctx, updated); err != nil {
-			return s.config, err
+			return s.config.copy(), err
 		}
 	}
 
 	s.config = updated
-	return s.config, nil
+	return s.config.copy(), nil
+}
+
+// ReactionSetForSectionType returns the emojis allowed in sections of the given type.
+// An empty result means any emoji is allowed.
+func (s *ConfigService) ReactionSetForSectionType(sectionType string) []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if set, ok := s.config.SectionReactions[strings.ToLower(strings.TrimSpace(sectionType))]; ok {
+		return append([]string{}, set...)
+	}
+	return append([]string{}, s.config.AllowedReactions...)
+}
+
+// HasReactionRestrictions reports whether any section restricts which emojis may be used.
+func (s *ConfigService) HasReactionRestrictions() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.config.AllowedReactions) > 0 || len(s.config.SectionReactions) > 0
 }
 
 // IsLinkMetadataEnabled returns whether link metadata fetching is enabled
@@ -99,7 +175,111 @@ func (s *ConfigService) IsMFARequired() bool {
 	return s.config.MFARequired
 }
 
-// ResetConfigServiceForTests resets the config service to defaults and clears the database handle.
+// IsMaintenanceMode returns whether the instance is in read-only maintenance mode.
+func (s *ConfigService) IsMaintenanceMode() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.config.MaintenanceMode
+}
+
+// IsImageHostAllowed reports whether an externally referenced image URL may be attached to a post.
+func (s *ConfigService) IsImageHostAllowed(rawURL string) bool {
+	if linkmeta.IsInternalUploadURL(rawURL) {
+		return true
+	}
+
+	s.mu.RLock()
+	allowed := s.config.AllowedImageHosts
+	s.mu.RUnlock()
+	if len(allowed) == 0 {
+		return true
+	}
+
+	host := linkmeta.ExtractDomain(rawURL)
+	if host == "" {
+		return false
+	}
+	for _, domain := range allowed {
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
+
+// NormalizeAllowedImageHosts lowercases, trims, and de-duplicates image host domains.
+// Entries must be bare domains such as "images.example.com".
+func NormalizeAllowedImageHosts(hosts []string) ([]string, error) {
+	normalized := make([]string, 0, len(hosts))
+	seen := make(map[string]struct{}, len(hosts))
+	for _, host := range hosts {
+		domain := strings.TrimPrefix(strings.ToLower(strings.TrimSpace(host)), "*.")
+		domain = strings.Trim(domain, ".")
+		if domain == "" || strings.ContainsAny(domain, "/:@ \t") {
+			return nil, fmt.Errorf("invalid image host %q", host)
+		}
+		if _, ok := seen[domain]; ok {
+			continue
+		}
+		seen[domain] = struct{}{}
+		normalized = append(normalized, domain)
+	}
+	return normalized, nil
+}
+
+// NormalizeReactionSet trims and de-duplicates emojis, rejecting entries that would fail
+// reaction validation.
+func NormalizeReactionSet(emojis []string) ([]string, error) {
+	normalized := make([]string, 0, len(emojis))
+	seen := make(map[string]struct{}, len(emojis))
+	for _, emoji := range emojis {
+		emoji = strings.TrimSpace(emoji)
+		if err := validateEmoji(emoji); err != nil {
+			return nil, fmt.Errorf("invalid reaction %q: %w", emoji, err)
+		}
+		key := reactionKey(emoji)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		normalized = append(normalized, emoji)
+	}
+	return normalized, nil
+}
+
+// NormalizeSectionReactions lowercases section types and normalizes each override set.
+// An override must list at least one emoji; remove the section type to fall back to the global set.
+func NormalizeSectionReactions(overrides map[string][]string) (map[string][]string, error) {
+	normalized := make(map[string][]string, len(overrides))
+	for sectionType, emojis := range overrides {
+		key := strings.ToLower(strings.TrimSpace(sectionType))
+		if key == "" {
+			return nil, errors.New("section type is required for reaction overrides")
+		}
+		set, err := NormalizeReactionSet(emojis)
+		if err != nil {
+			return nil, err
+		}
+		if len(set) == 0 {
+			return nil, fmt.Errorf("reaction override for %q must list at least one emoji", key)
+		}
+		normalized[key] = set
+	}
+	return normalized, nil
+}
+
+func (c Config) copy() Config {
+	c.AllowedImageHosts = append([]string{}, c.AllowedImageHosts...)
+	c.AllowedReactions = append([]string{}, c.AllowedReactions...)
+	overrides := make(map[string][]string, len(c.SectionReactions))
+	for sectionType, emojis := range c.SectionReactions {
+		overrides[sectionType] = append([]string{}, emojis...)
+	}
+	c.SectionReactions = overrides
+	return c
+}
+
+// ResetConfigServiceForTests resets the config and settings to defaults and clears the database handle.
 func ResetConfigServiceForTests() {
 	service := GetConfigService()
 	service.mu.Lock()
@@ -109,7 +289,11 @@ func ResetConfigServiceForTests() {
 		LinkMetadataEnabled: true,
 		MFARequired:         false,
 		DisplayTimezone:     "UTC",
+		AllowedImageHosts:   []string{},
+		AllowedReactions:    []string{},
+		SectionReactions:    map[string][]string{},
 	}
+	service.settings = DefaultSettings()
 }
 
 func (s *ConfigService) loadFromDB(ctx context.Context) error {
@@ -125,11 +309,14 @@ func (s *ConfigService) loadFromDB(ctx context.Context) error {
 	}
 
 	var config Config
+	var sectionReactions []byte
 	err := db.QueryRowContext(ctx, `
-		SELECT link_metadata_enabled, mfa_required, display_timezone
+		SELECT link_metadata_enabled, mfa_required, display_timezone, allowed_image_hosts, maintenance_mode,
+			allowed_reactions, section_reactions
 		FROM admin_config
 		WHERE id = 1
-	`).Scan(&config.LinkMetadataEnabled, &config.MFARequired, &config.DisplayTimezone)
+	`).Scan(&config.LinkMetadataEnabled, &config.MFARequired, &config.DisplayTimezone, pq.Array(&config.AllowedImageHosts), &config.MaintenanceMode,
+		pq.Array(&config.AllowedReactions), &sectionReactions)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			if err := s.persistConfig(ctx, defaults); err != nil {
@@ -145,6 +332,18 @@ func (s *ConfigService) loadFromDB(ctx context.Context) error {
 	if config.DisplayTimezone == "" {
 		config.DisplayTimezone = "UTC"
 	}
+	if config.AllowedImageHosts == nil {
+		config.AllowedImageHosts = []string{}
+	}
+	if config.AllowedReactions == nil {
+		config.AllowedReactions = []string{}
+	}
+	config.SectionReactions = map[string][]string{}
+	if len(sectionReactions) > 0 {
+		if err := json.Unmarshal(sectionReactions, &config.SectionReactions); err != nil {
+			return fmt.Errorf("failed to decode section reactions: %w", err)
+		}
+	}
 
 	s.mu.Lock()
 	s.config = config
@@ -153,14 +352,33 @@ func (s *ConfigService) loadFromDB(ctx context.Context) error {
 }
 
 func (s *ConfigService) persistConfig(ctx context.Context, config Config) error {
-	_, err := s.db.ExecContext(ctx, `
-		INSERT INTO admin_config (id, link_metadata_enabled, mfa_required, display_timezone)
-		VALUES (1, $1, $2, $3)
+	sectionReactions := config.SectionReactions
+	if sectionReactions == nil {
+		sectionReactions = map[string][]string{}
+	}
+	sectionReactionsJSON, err := json.Marshal(sectionReactions)
+	if err != nil {
+		return fmt.Errorf("failed to encode section reactions: %w", err)
+	}
+	allowedReactions := config.AllowedReactions
+	if allowedReactions == nil {
+		allowedReactions = []string{}
+	}
+
+	_, err = s.db.ExecContext(ctx, `
+		INSERT INTO admin_config (id, link_metadata_enabled, mfa_required, display_timezone, allowed_image_hosts, maintenance_mode,
+			allowed_reactions, section_reactions)
+		VALUES (1, $1, $2, $3, $4, $5, $6, $7)
 		ON CONFLICT (id) DO UPDATE
 		SET link_metadata_enabled = EXCLUDED.link_metadata_enabled,
 			mfa_required = EXCLUDED.mfa_required,
 			display_timezone = EXCLUDED.display_timezone,
+			allowed_image_hosts = EXCLUDED.allowed_image_hosts,
+			maintenance_mode = EXCLUDED.maintenance_mode,
+			allowed_reactions = EXCLUDED.allowed_reactions,
+			section_reactions = EXCLUDED.section_reactions,
 			updated_at = now()
-	`, config.LinkMetadataEnabled, config.MFARequired, config.DisplayTimezone)
+	`, config.LinkMetadataEnabled, config.MFARequired, config.DisplayTimezone, pq.Array(config.AllowedImageHosts), config.MaintenanceMode,
+		pq.Array(allowedReactions), sectionReactionsJSON)
 	return err
 }
@@ -0,0 +1,53 @@
+package services
+
+import (
+	"context"
+	"testing"
+)
+
+func TestIsImageHostAllowed(t *testing.T) {
+	ResetConfigServiceForTests()
+	t.Cleanup(ResetConfigServiceForTests)
+	config := GetConfigService()
+
+	if !config.IsImageHostAllowed("https://anywhere.example.org/cat.png") {
+		t.Fatalf("expected any host to be allowed when the allowlist is empty")
+	}
+
+	hosts := []string{" Images.Example.com ", "*.cdn.test", "images.example.com"}
+	updated, err := config.UpdateConfig(context.Background(), ConfigUpdate{AllowedImageHosts: &hosts})
+	if err != nil {
+		t.Fatalf("UpdateConfig failed: %v", err)
+	}
+	if len(updated.AllowedImageHosts) != 2 || updated.AllowedImageHosts[0] != "images.example.com" || updated.AllowedImageHosts[1] != "cdn.test" {
+		t.Fatalf("unexpected normalized hosts: %v", updated.AllowedImageHosts)
+	}
+
+	tests := []struct {
+		url  string
+		want bool
+	}{
+		{"https://images.example.com/a.jpg", true},
+		{"https://IMAGES.example.com/a.jpg", true},
+		{"https://eu.cdn.test/a.jpg", true},
+		{"https://example.com/a.jpg", false},
+		{"https://evilimages.example.com.attacker.net/a.jpg", false},
+		{"https://notcdn.test/a.jpg", false},
+		{"/api/v1/uploads/user/a.jpg", true},
+		{"https://clubhouse.example/api/v1/uploads/user/a.jpg", true},
+		{"not a url", false},
+	}
+	for _, tt := range tests {
+		if got := config.IsImageHostAllowed(tt.url); got != tt.want {
+			t.Errorf("IsImageHostAllowed(%q) = %v, want %v", tt.url, got, tt.want)
+		}
+	}
+
+	invalid := []string{"https://images.example.com/path"}
+	if _, err := config.UpdateConfig(context.Background(), ConfigUpdate{AllowedImageHosts: &invalid}); err == nil {
+		t.Fatalf("expected invalid host to be rejected")
+	}
+	if got := config.GetConfig().AllowedImageHosts; len(got) != 2 {
+		t.Fatalf("expected allowlist to be unchanged after invalid update, got %v", got)
+	}
+}
@@ -38,6 +38,12 @@ func (s *CookLogService) LogCook(ctx context.Context, userID, postID uuid.UUID,
 		recordSpanError(span, err)
 		return nil, err
 	}
+	if notes != nil {
+		if err := validateLogNotes(*notes); err != nil {
+			recordSpanError(span, err)
+			return nil, err
+		}
+	}
 
 	if err := s.verifyRecipePost(ctx, postID); err != nil {
 		recordSpanError(span, err)
@@ -101,6 +107,12 @@ func (s *CookLogService) UpdateCookLog(ctx context.Context, userID, postID uuid.
 		recordSpanError(span, err)
 		return nil, err
 	}
+	if notes != nil {
+		if err := validateLogNotes(*notes); err != nil {
+			recordSpanError(span, err)
+			return nil, err
+		}
+	}
 
 	if err := s.verifyRecipePost(ctx, postID); err != nil {
 		recordSpanError(span, err)
@@ -214,8 +226,9 @@ func (s *CookLogService) GetPostCookLogs(ctx context.Context, postID uuid.UUID,
 		FROM cook_logs cl
 		JOIN users u ON cl.user_id = u.id
 		WHERE cl.post_id = $1 AND cl.deleted_at IS NULL
+			AND (u.activity_private = false OR u.id = $2)
 		ORDER BY cl.created_at DESC
-	`, postID)
+	`, postID, uuidPointerValue(viewerID))
 	if err != nil {
 		recordSpanError(span, err)
 		return nil, fmt.Errorf("failed to query cook log users: %w", err)
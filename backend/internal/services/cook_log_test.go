@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"math"
 	"testing"
 	"time"
@@ -231,6 +232,53 @@ func TestGetPostCookLogs(t *testing.T) {
 	}
 }
 
+func TestGetPostCookLogsHidesPrivateUsers(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	viewerID := testutil.CreateTestUser(t, db, "cookpublicviewer", "cookpublicviewer@test.com", false, true)
+	privateUserID := testutil.CreateTestUser(t, db, "cookprivateuser", "cookprivateuser@test.com", false, true)
+	sectionID := testutil.CreateTestSection(t, db, "Recipes", "recipe")
+	postID := testutil.CreateTestPost(t, db, viewerID, sectionID, "Recipe post")
+
+	if _, err := db.Exec(`UPDATE users SET activity_private = true WHERE id = $1`, privateUserID); err != nil {
+		t.Fatalf("failed to mark user private: %v", err)
+	}
+
+	service := NewCookLogService(db)
+	if _, err := service.LogCook(context.Background(), uuid.MustParse(viewerID), uuid.MustParse(postID), 4, nil); err != nil {
+		t.Fatalf("LogCook failed: %v", err)
+	}
+	if _, err := service.LogCook(context.Background(), uuid.MustParse(privateUserID), uuid.MustParse(postID), 2, nil); err != nil {
+		t.Fatalf("LogCook failed: %v", err)
+	}
+
+	viewer := uuid.MustParse(viewerID)
+	info, err := service.GetPostCookLogs(context.Background(), uuid.MustParse(postID), &viewer)
+	if err != nil {
+		t.Fatalf("GetPostCookLogs failed: %v", err)
+	}
+
+	if info.CookCount != 2 {
+		t.Fatalf("expected cook count 2, got %d", info.CookCount)
+	}
+	if info.AvgRating == nil || math.Abs(*info.AvgRating-3.0) > 0.001 {
+		t.Fatalf("expected avg rating 3.0, got %v", info.AvgRating)
+	}
+	if len(info.Users) != 1 || info.Users[0].ID != viewer {
+		t.Fatalf("expected only the public viewer in users, got %+v", info.Users)
+	}
+
+	privateViewer := uuid.MustParse(privateUserID)
+	info, err = service.GetPostCookLogs(context.Background(), uuid.MustParse(postID), &privateViewer)
+	if err != nil {
+		t.Fatalf("GetPostCookLogs failed: %v", err)
+	}
+	if len(info.Users) != 2 {
+		t.Fatalf("expected private user to see their own entry, got %d users", len(info.Users))
+	}
+}
+
 func TestGetUserCookLogsPagination(t *testing.T) {
 	db := testutil.RequireTestDB(t)
 	t.Cleanup(func() { testutil.CleanupTables(t, db) })
@@ -306,3 +354,32 @@ func TestCookLogRatingValidation(t *testing.T) {
 		t.Fatalf("expected error for invalid rating")
 	}
 }
+
+func TestCookLogNotesLengthLimit(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+	setSettingsForTest(t, func(settings *Settings) { settings.MaxLogNoteLength = 10 })
+
+	userID := testutil.CreateTestUser(t, db, "cooklognotes", "cooklognotes@test.com", false, true)
+	sectionID := testutil.CreateTestSection(t, db, "Recipes", "recipe")
+	postID := testutil.CreateTestPost(t, db, userID, sectionID, "Recipe post")
+
+	service := NewCookLogService(db)
+	tooLong := "eleven char"
+	if _, err := service.LogCook(context.Background(), uuid.MustParse(userID), uuid.MustParse(postID), 4, &tooLong); !errors.Is(err, ErrLogNoteTooLong) {
+		t.Fatalf("expected ErrLogNoteTooLong, got %v", err)
+	}
+
+	withinLimit := "  yummy!  "
+	cookLog, err := service.LogCook(context.Background(), uuid.MustParse(userID), uuid.MustParse(postID), 4, &withinLimit)
+	if err != nil {
+		t.Fatalf("LogCook failed: %v", err)
+	}
+	if cookLog.Notes == nil || *cookLog.Notes != "yummy!" {
+		t.Fatalf("expected trimmed notes to be stored, got %v", cookLog.Notes)
+	}
+
+	if _, err := service.UpdateCookLog(context.Background(), uuid.MustParse(userID), uuid.MustParse(postID), 5, &tooLong); !errors.Is(err, ErrLogNoteTooLong) {
+		t.Fatalf("expected ErrLogNoteTooLong on update, got %v", err)
+	}
+}
@@ -0,0 +1,280 @@
+package services
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/sanderginn/clubhouse/internal/models"
+	"github.com/sanderginn/clubhouse/internal/observability"
+)
+
+// DefaultSMTPTimeout bounds the whole SMTP session for one digest, from dial to QUIT.
+const DefaultSMTPTimeout = 30 * time.Second
+
+// DigestMailer delivers a built digest to its recipient.
+type DigestMailer interface {
+	SendDigest(ctx context.Context, job DigestEmailJob) error
+}
+
+// SMTPConfig holds the settings for sending digest emails over SMTP.
+type SMTPConfig struct {
+	Host     string
+	Port     string
+	Username string
+	Password string
+	From     string
+	// Timeout bounds each SMTP session; non-positive values fall back to DefaultSMTPTimeout.
+	Timeout time.Duration
+}
+
+// SMTPDigestMailer sends digests through an SMTP relay.
+type SMTPDigestMailer struct {
+	config   SMTPConfig
+	sendMail func(ctx context.Context, addr string, a smtp.Auth, from string, to []string, msg []byte) error
+}
+
+// NewSMTPDigestMailer creates an SMTP mailer. It returns nil when no host or sender is configured,
+// in which case digest emails are disabled.
+func NewSMTPDigestMailer(config SMTPConfig) *SMTPDigestMailer {
+	config.Host = strings.TrimSpace(config.Host)
+	config.From = strings.TrimSpace(config.From)
+	if config.Host == "" || config.From == "" {
+		return nil
+	}
+	if strings.TrimSpace(config.Port) == "" {
+		config.Port = "587"
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = DefaultSMTPTimeout
+	}
+	mailer := &SMTPDigestMailer{config: config}
+	mailer.sendMail = mailer.send
+	return mailer
+}
+
+// SendDigest renders the digest as a plain-text email and sends it.
+func (m *SMTPDigestMailer) SendDigest(ctx context.Context, job DigestEmailJob) error {
+	if strings.TrimSpace(job.Email) == "" {
+		return errors.New("digest recipient has no email")
+	}
+	if strings.ContainsAny(job.Email, "\r\n") {
+		return errors.New("invalid digest recipient email")
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	var auth smtp.Auth
+	if m.config.Username != "" {
+		auth = smtp.PlainAuth("", m.config.Username, m.config.Password, m.config.Host)
+	}
+	addr := net.JoinHostPort(m.config.Host, m.config.Port)
+	return m.sendMail(ctx, addr, auth, m.config.From, []string{job.Email}, renderDigestEmail(m.config.From, job))
+}
+
+// send delivers msg like smtp.SendMail, but the session is bounded by the mailer timeout and
+// aborted when ctx is cancelled, so a stalled relay cannot block the delivery worker.
+func (m *SMTPDigestMailer) send(ctx context.Context, addr string, a smtp.Auth, from string, to []string, msg []byte) (err error) {
+	dialer := net.Dialer{Timeout: m.config.Timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to connect to SMTP server: %w", err)
+	}
+	deadline := time.Now().Add(m.config.Timeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to set SMTP deadline: %w", err)
+	}
+	stop := context.AfterFunc(ctx, func() {
+		conn.Close()
+	})
+	defer func() {
+		// When ctx closed the connection mid-session, report the cancellation instead of the I/O error.
+		if !stop() && err != nil {
+			err = ctx.Err()
+		}
+	}()
+
+	client, err := smtp.NewClient(conn, m.config.Host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to start SMTP session: %w", err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: m.config.Host}); err != nil {
+			return fmt.Errorf("failed to start TLS: %w", err)
+		}
+	}
+	if a != nil {
+		if ok, _ := client.Extension("AUTH"); !ok {
+			return errors.New("SMTP server does not support AUTH")
+		}
+		if err := client.Auth(a); err != nil {
+			return fmt.Errorf("failed to authenticate: %w", err)
+		}
+	}
+	if err := client.Mail(from); err != nil {
+		return err
+	}
+	for _, recipient := range to {
+		if err := client.Rcpt(recipient); err != nil {
+			return err
+		}
+	}
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := writer.Write(msg); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+func renderDigestEmail(from string, job DigestEmailJob) []byte {
+	subject := "Your Clubhouse daily digest"
+	if job.Frequency == models.DigestFrequencyWeekly {
+		subject = "Your Clubhouse weekly digest"
+	}
+
+	var body strings.Builder
+	fmt.Fprintf(&body, "Hi %s,\r\n\r\n", job.Username)
+	fmt.Fprintf(&body, "You have %d unread notifications and %d new mentions.\r\n", job.Digest.UnreadNotificationCount, len(job.Digest.Mentions))
+	if job.Digest.NewPostCount > 0 {
+		fmt.Fprintf(&body, "\r\n%d new posts in your sections:\r\n", job.Digest.NewPostCount)
+		for _, section := range job.Digest.Sections {
+			fmt.Fprintf(&body, "\r\n%s\r\n", section.SectionName)
+			for _, post := range section.Posts {
+				excerpt := ""
+				if post.ContentExcerpt != nil {
+					excerpt = ": " + *post.ContentExcerpt
+				}
+				fmt.Fprintf(&body, "  - %s%s\r\n", post.Author.Username, excerpt)
+			}
+		}
+	}
+
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "From: %s\r\n", from)
+	fmt.Fprintf(&msg, "To: %s\r\n", job.Email)
+	fmt.Fprintf(&msg, "Subject: %s\r\n", subject)
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	msg.WriteString(body.String())
+	return []byte(msg.String())
+}
+
+// DigestMailWorker delivers queued digests through a mailer. Failed deliveries are moved to a
+// bounded dead-letter list instead of being retried forever.
+type DigestMailWorker struct {
+	redis  *redis.Client
+	mailer DigestMailer
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+// NewDigestMailWorker creates a digest delivery worker.
+func NewDigestMailWorker(rdb *redis.Client, mailer DigestMailer) *DigestMailWorker {
+	return &DigestMailWorker{
+		redis:  rdb,
+		mailer: mailer,
+		stopCh: make(chan struct{}),
+	}
+}
+
+// Start runs the delivery loop in the background.
+func (w *DigestMailWorker) Start(ctx context.Context) {
+	if requeued, err := RequeueProcessingDigestEmails(ctx, w.redis); err != nil {
+		observability.LogWarn(ctx, "failed to requeue digest emails",
+			"error", err.Error(),
+		)
+	} else if requeued > 0 {
+		observability.LogInfo(ctx, "requeued digest emails from processing",
+			"count", fmt.Sprintf("%d", requeued),
+		)
+	}
+
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		for {
+			select {
+			case <-w.stopCh:
+				return
+			case <-ctx.Done():
+				return
+			default:
+			}
+
+			if _, err := w.ProcessNext(ctx, time.Second); err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				observability.LogError(ctx, observability.ErrorLog{
+					Message: "failed to dequeue digest email",
+					Code:    "DIGEST_DEQUEUE_FAILED",
+					Err:     err,
+				})
+			}
+		}
+	}()
+}
+
+// Stop shuts down the delivery loop.
+func (w *DigestMailWorker) Stop(ctx context.Context) {
+	close(w.stopCh)
+	w.wg.Wait()
+	observability.LogInfo(ctx, "digest mail worker stopped")
+}
+
+// ProcessNext delivers the next queued digest, waiting up to timeout for one to arrive.
+// It reports whether a digest was taken from the queue.
+func (w *DigestMailWorker) ProcessNext(ctx context.Context, timeout time.Duration) (bool, error) {
+	job, raw, err := DequeueDigestEmail(ctx, w.redis, timeout)
+	if err != nil {
+		return false, err
+	}
+	if job == nil {
+		return false, nil
+	}
+
+	if err := w.mailer.SendDigest(ctx, *job); err != nil {
+		observability.LogError(ctx, observability.ErrorLog{
+			Message: "failed to send digest email",
+			Code:    "DIGEST_SEND_FAILED",
+			UserID:  job.UserID.String(),
+			Err:     err,
+		})
+		if dlErr := DeadLetterDigestEmail(ctx, w.redis, raw); dlErr != nil {
+			observability.LogWarn(ctx, "failed to dead-letter digest email",
+				"user_id", job.UserID.String(),
+				"error", dlErr.Error(),
+			)
+		}
+	}
+
+	if err := AckDigestEmail(ctx, w.redis, raw); err != nil {
+		observability.LogError(ctx, observability.ErrorLog{
+			Message: "failed to acknowledge digest email",
+			Code:    "DIGEST_ACK_FAILED",
+			Err:     err,
+		})
+	}
+	return true, nil
+}
@@ -0,0 +1,269 @@
+package services
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/smtp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/sanderginn/clubhouse/internal/models"
+	"github.com/sanderginn/clubhouse/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeDigestMailer struct {
+	sent []DigestEmailJob
+	err  error
+}
+
+func (m *fakeDigestMailer) SendDigest(ctx context.Context, job DigestEmailJob) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.sent = append(m.sent, job)
+	return nil
+}
+
+func setupDigestQueueTestRedis(t *testing.T) *redis.Client {
+	client := testutil.GetTestRedis(t)
+
+	ctx := context.Background()
+	keys := []string{DigestQueueKey, DigestQueueProcessingKey, DigestQueueDeadLetterKey, DigestSchedulerLockKey}
+	client.Del(ctx, keys...)
+
+	t.Cleanup(func() {
+		client.Del(ctx, keys...)
+		testutil.CleanupRedis(t)
+	})
+
+	return client
+}
+
+func TestDigestMailWorkerDeliversQueuedDigest(t *testing.T) {
+	rdb := setupDigestQueueTestRedis(t)
+	ctx := context.Background()
+
+	job := DigestEmailJob{UserID: uuid.New(), Username: "reader", Email: "reader@example.com", Frequency: models.DigestFrequencyDaily}
+	require.NoError(t, EnqueueDigestEmail(ctx, rdb, job))
+
+	mailer := &fakeDigestMailer{}
+	worker := NewDigestMailWorker(rdb, mailer)
+	processed, err := worker.ProcessNext(ctx, time.Second)
+	require.NoError(t, err)
+	assert.True(t, processed)
+
+	require.Len(t, mailer.sent, 1)
+	assert.Equal(t, job.UserID, mailer.sent[0].UserID)
+
+	for _, key := range []string{DigestQueueKey, DigestQueueProcessingKey, DigestQueueDeadLetterKey} {
+		length, err := rdb.LLen(ctx, key).Result()
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), length, key)
+	}
+}
+
+func TestDigestMailWorkerDeadLettersFailedDelivery(t *testing.T) {
+	rdb := setupDigestQueueTestRedis(t)
+	ctx := context.Background()
+
+	require.NoError(t, EnqueueDigestEmail(ctx, rdb, DigestEmailJob{UserID: uuid.New(), Email: "bounce@example.com"}))
+
+	worker := NewDigestMailWorker(rdb, &fakeDigestMailer{err: errors.New("smtp unavailable")})
+	processed, err := worker.ProcessNext(ctx, time.Second)
+	require.NoError(t, err)
+	assert.True(t, processed)
+
+	processing, err := rdb.LLen(ctx, DigestQueueProcessingKey).Result()
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), processing)
+	deadLetters, err := rdb.LLen(ctx, DigestQueueDeadLetterKey).Result()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deadLetters)
+}
+
+func TestRequeueProcessingDigestEmails(t *testing.T) {
+	rdb := setupDigestQueueTestRedis(t)
+	ctx := context.Background()
+
+	require.NoError(t, rdb.LPush(ctx, DigestQueueProcessingKey, `{"email":"a@example.com"}`).Err())
+
+	requeued, err := RequeueProcessingDigestEmails(ctx, rdb)
+	require.NoError(t, err)
+	assert.Equal(t, 1, requeued)
+
+	length, err := rdb.LLen(ctx, DigestQueueKey).Result()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), length)
+}
+
+func TestDigestSchedulerSkipsRunWhenLockIsHeld(t *testing.T) {
+	rdb := setupDigestQueueTestRedis(t)
+	ctx := context.Background()
+
+	require.NoError(t, rdb.Set(ctx, DigestSchedulerLockKey, "other-instance", time.Minute).Err())
+
+	// The lock is checked before the database is touched, so no DB is needed here.
+	scheduler := NewDigestScheduler(nil, rdb, DefaultDigestSendHour, time.Minute)
+	queued, err := scheduler.RunOnce(ctx, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, 0, queued)
+
+	holder, err := rdb.Get(ctx, DigestSchedulerLockKey).Result()
+	require.NoError(t, err)
+	assert.Equal(t, "other-instance", holder, "another instance's lock must not be released")
+}
+
+func TestSMTPDigestMailerSendsPlainTextDigest(t *testing.T) {
+	assert.Nil(t, NewSMTPDigestMailer(SMTPConfig{From: "digest@example.com"}), "mailer requires a host")
+
+	mailer := NewSMTPDigestMailer(SMTPConfig{Host: "smtp.example.com", From: "digest@example.com"})
+	require.NotNil(t, mailer)
+
+	var gotAddr, gotFrom string
+	var gotTo []string
+	var gotMsg string
+	mailer.sendMail = func(ctx context.Context, addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+		gotAddr, gotFrom, gotTo, gotMsg = addr, from, to, string(msg)
+		return nil
+	}
+
+	excerpt := "Try this lasagna"
+	job := DigestEmailJob{
+		Username:  "reader",
+		Email:     "reader@example.com",
+		Frequency: models.DigestFrequencyWeekly,
+		Digest: models.NotificationDigest{
+			UnreadNotificationCount: 2,
+			NewPostCount:            1,
+			Sections: []models.DigestSectionUpdate{{
+				SectionName: "Recipes",
+				Posts:       []models.DigestPost{{Author: models.UserSummary{Username: "chef"}, ContentExcerpt: &excerpt}},
+			}},
+		},
+	}
+	require.NoError(t, mailer.SendDigest(context.Background(), job))
+
+	assert.Equal(t, "smtp.example.com:587", gotAddr)
+	assert.Equal(t, "digest@example.com", gotFrom)
+	assert.Equal(t, []string{job.Email}, gotTo)
+	assert.Contains(t, gotMsg, "Subject: Your Clubhouse weekly digest\r\n")
+	assert.Contains(t, gotMsg, "You have 2 unread notifications")
+	assert.Contains(t, gotMsg, "  - chef: Try this lasagna\r\n")
+	assert.True(t, strings.HasPrefix(gotMsg, "From: digest@example.com\r\nTo: reader@example.com\r\n"))
+
+	job.Email = "reader@example.com\r\nBcc: victim@example.com"
+	assert.Error(t, mailer.SendDigest(context.Background(), job), "recipient must not inject headers")
+}
+
+// startFakeSMTPServer accepts one connection and serves a minimal SMTP session, recording the
+// message data. With silent set it accepts the connection but never greets the client.
+func startFakeSMTPServer(t *testing.T, silent bool) (string, <-chan string) {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	received := make(chan string, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		if silent {
+			_, _ = io.Copy(io.Discard, conn)
+			return
+		}
+
+		reader := bufio.NewReader(conn)
+		reply := func(line string) { _, _ = conn.Write([]byte(line + "\r\n")) }
+		reply("220 fake ESMTP")
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			command := strings.ToUpper(strings.TrimSpace(line))
+			switch {
+			case strings.HasPrefix(command, "EHLO"):
+				reply("250 fake")
+			case command == "DATA":
+				reply("354 go ahead")
+				var data strings.Builder
+				for {
+					dataLine, err := reader.ReadString('\n')
+					if err != nil {
+						return
+					}
+					if dataLine == ".\r\n" {
+						break
+					}
+					data.WriteString(dataLine)
+				}
+				received <- data.String()
+				reply("250 queued")
+			case command == "QUIT":
+				reply("221 bye")
+				return
+			default:
+				reply("250 ok")
+			}
+		}
+	}()
+	return listener.Addr().String(), received
+}
+
+func newFakeSMTPMailer(t *testing.T, addr string, timeout time.Duration) *SMTPDigestMailer {
+	t.Helper()
+	host, port, err := net.SplitHostPort(addr)
+	require.NoError(t, err)
+	mailer := NewSMTPDigestMailer(SMTPConfig{Host: host, Port: port, From: "digest@example.com", Timeout: timeout})
+	require.NotNil(t, mailer)
+	return mailer
+}
+
+func TestSMTPDigestMailerDeliversOverSMTP(t *testing.T) {
+	addr, received := startFakeSMTPServer(t, false)
+	mailer := newFakeSMTPMailer(t, addr, time.Second)
+
+	job := DigestEmailJob{Username: "reader", Email: "reader@example.com", Frequency: models.DigestFrequencyDaily}
+	require.NoError(t, mailer.SendDigest(context.Background(), job))
+
+	select {
+	case data := <-received:
+		assert.Contains(t, data, "Subject: Your Clubhouse daily digest\r\n")
+	case <-time.After(time.Second):
+		t.Fatal("expected the fake server to receive the digest")
+	}
+}
+
+func TestSMTPDigestMailerTimesOutOnStalledServer(t *testing.T) {
+	addr, _ := startFakeSMTPServer(t, true)
+	mailer := newFakeSMTPMailer(t, addr, 100*time.Millisecond)
+
+	job := DigestEmailJob{Username: "reader", Email: "reader@example.com"}
+	start := time.Now()
+	err := mailer.SendDigest(context.Background(), job)
+	require.Error(t, err)
+	assert.Less(t, time.Since(start), 2*time.Second, "a stalled server must not block past the timeout")
+}
+
+func TestSMTPDigestMailerStopsWhenContextIsCancelled(t *testing.T) {
+	addr, _ := startFakeSMTPServer(t, true)
+	mailer := newFakeSMTPMailer(t, addr, time.Minute)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+
+	job := DigestEmailJob{Username: "reader", Email: "reader@example.com"}
+	err := mailer.SendDigest(ctx, job)
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,86 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// DigestQueueKey is the Redis key for digest emails waiting for the mailer
+	DigestQueueKey = "clubhouse:digest_queue"
+	// DigestQueueProcessingKey is the Redis key for digest emails being delivered
+	DigestQueueProcessingKey = "clubhouse:digest_queue:processing"
+	// DigestQueueDeadLetterKey is the Redis key for digest emails that failed delivery
+	DigestQueueDeadLetterKey = "clubhouse:digest_queue:dead_letter"
+
+	// maxDigestDeadLetterJobs bounds the dead-letter list so repeated failures cannot grow it forever
+	maxDigestDeadLetterJobs = 1000
+)
+
+// EnqueueDigestEmail adds a built digest to the Redis queue for the mailer
+func EnqueueDigestEmail(ctx context.Context, rdb *redis.Client, job DigestEmailJob) error {
+	data, err := json.Marshal(job)
+	if err != nil {
+		return err
+	}
+	return rdb.LPush(ctx, DigestQueueKey, data).Err()
+}
+
+// DequeueDigestEmail retrieves the next digest from the queue (blocking).
+// The raw payload is returned so the job can be acknowledged exactly as stored.
+// Returns nil, "", nil on timeout (no digest available).
+func DequeueDigestEmail(ctx context.Context, rdb *redis.Client, timeout time.Duration) (*DigestEmailJob, string, error) {
+	result, err := rdb.BRPopLPush(ctx, DigestQueueKey, DigestQueueProcessingKey, timeout).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, "", nil
+		}
+		return nil, "", err
+	}
+
+	var job DigestEmailJob
+	if err := json.Unmarshal([]byte(result), &job); err != nil {
+		// Invalid job data - acknowledge to remove from processing queue
+		rdb.LRem(ctx, DigestQueueProcessingKey, 1, result)
+		return nil, "", err
+	}
+
+	return &job, result, nil
+}
+
+// AckDigestEmail removes a handled digest from the processing queue
+func AckDigestEmail(ctx context.Context, rdb *redis.Client, raw string) error {
+	return rdb.LRem(ctx, DigestQueueProcessingKey, 1, raw).Err()
+}
+
+// RequeueProcessingDigestEmails moves any in-flight digests back to the pending queue.
+// This recovers digests left in processing after a crash.
+func RequeueProcessingDigestEmails(ctx context.Context, rdb *redis.Client) (int, error) {
+	requeued := 0
+
+	for {
+		result, err := rdb.RPopLPush(ctx, DigestQueueProcessingKey, DigestQueueKey).Result()
+		if err != nil {
+			if err == redis.Nil {
+				return requeued, nil
+			}
+			return requeued, err
+		}
+
+		if result != "" {
+			requeued++
+		}
+	}
+}
+
+// DeadLetterDigestEmail records an undeliverable digest, keeping only the most recent failures
+func DeadLetterDigestEmail(ctx context.Context, rdb *redis.Client, raw string) error {
+	pipe := rdb.TxPipeline()
+	pipe.LPush(ctx, DigestQueueDeadLetterKey, raw)
+	pipe.LTrim(ctx, DigestQueueDeadLetterKey, 0, maxDigestDeadLetterJobs-1)
+	_, err := pipe.Exec(ctx)
+	return err
+}
@@ -0,0 +1,270 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/sanderginn/clubhouse/internal/models"
+	"github.com/sanderginn/clubhouse/internal/observability"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	// DigestSchedulerLockKey is the Redis key that keeps several instances from queueing the same digests
+	DigestSchedulerLockKey = "clubhouse:digest_scheduler:lock"
+	// DefaultDigestSendHour is the local hour digests are scheduled for
+	DefaultDigestSendHour = 8
+	// DefaultDigestCheckInterval is how often the scheduler looks for due digests
+	DefaultDigestCheckInterval = 15 * time.Minute
+
+	// dailyDigestMinGap and weeklyDigestMinGap are the shortest time between two runs for a
+	// user; recipients who ran more recently are not considered.
+	dailyDigestMinGap  = 20 * time.Hour
+	weeklyDigestMinGap = 6 * 24 * time.Hour
+)
+
+// DigestEmailJob is a built digest queued for delivery by the mailer.
+type DigestEmailJob struct {
+	UserID       uuid.UUID                 `json:"user_id"`
+	Username     string                    `json:"username"`
+	Email        string                    `json:"email"`
+	Frequency    string                    `json:"frequency"`
+	ScheduledFor time.Time                 `json:"scheduled_for"`
+	Digest       models.NotificationDigest `json:"digest"`
+}
+
+// DigestScheduler periodically builds digests for opted-in users and queues them for the mailer.
+type DigestScheduler struct {
+	db            *sql.DB
+	redis         *redis.Client
+	notifications *NotificationService
+	sendHour      int
+	interval      time.Duration
+	stopCh        chan struct{}
+	wg            sync.WaitGroup
+}
+
+// NewDigestScheduler creates a digest scheduler.
+func NewDigestScheduler(db *sql.DB, rdb *redis.Client, sendHour int, interval time.Duration) *DigestScheduler {
+	if sendHour < 0 || sendHour > 23 {
+		sendHour = DefaultDigestSendHour
+	}
+	if interval <= 0 {
+		interval = DefaultDigestCheckInterval
+	}
+	return &DigestScheduler{
+		db:            db,
+		redis:         rdb,
+		notifications: NewNotificationService(db, nil, nil),
+		sendHour:      sendHour,
+		interval:      interval,
+		stopCh:        make(chan struct{}),
+	}
+}
+
+// Start runs the scheduler loop in the background.
+func (s *DigestScheduler) Start(ctx context.Context) {
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			if _, err := s.RunOnce(ctx, time.Now()); err != nil {
+				observability.LogError(ctx, observability.ErrorLog{
+					Message: "failed to schedule digests",
+					Code:    "DIGEST_SCHEDULE_FAILED",
+					Err:     err,
+				})
+			}
+
+			select {
+			case <-s.stopCh:
+				return
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop shuts down the scheduler loop.
+func (s *DigestScheduler) Stop(ctx context.Context) {
+	close(s.stopCh)
+	s.wg.Wait()
+	observability.LogInfo(ctx, "digest scheduler stopped")
+}
+
+type digestRecipient struct {
+	userID       uuid.UUID
+	username     string
+	email        string
+	frequency    string
+	scheduledFor time.Time
+}
+
+// RunOnce queues digests for every user whose digest is due at now and returns how many were queued.
+// Each user's send slot is computed in their own timezone, falling back to the admin display timezone.
+// Users with nothing new are marked as processed without a send.
+func (s *DigestScheduler) RunOnce(ctx context.Context, now time.Time) (int, error) {
+	ctx, span := otel.Tracer("clubhouse.notifications").Start(ctx, "DigestScheduler.RunOnce")
+	defer span.End()
+
+	if s.redis == nil {
+		err := fmt.Errorf("digest queue is not configured")
+		recordSpanError(span, err)
+		return 0, err
+	}
+
+	// Only one instance schedules at a time; the lock expires on its own if the holder dies.
+	release, locked, err := acquireJobLock(ctx, s.redis, DigestSchedulerLockKey, s.interval)
+	if err != nil {
+		recordSpanError(span, err)
+		return 0, fmt.Errorf("failed to acquire digest scheduler lock: %w", err)
+	}
+	span.SetAttributes(attribute.Bool("lock_acquired", locked))
+	if !locked {
+		return 0, nil
+	}
+	defer release()
+
+	recipients, err := s.listDueRecipients(ctx, now)
+	if err != nil {
+		recordSpanError(span, err)
+		return 0, err
+	}
+	span.SetAttributes(attribute.Int("due_count", len(recipients)))
+
+	queued := 0
+	for _, recipient := range recipients {
+		window := DefaultDigestWindow
+		if recipient.frequency == models.DigestFrequencyWeekly {
+			window = 7 * 24 * time.Hour
+		}
+
+		digest, err := s.notifications.BuildDigest(ctx, recipient.userID, window)
+		if err != nil {
+			recordSpanError(span, err)
+			return queued, err
+		}
+
+		if !digestHasActivity(digest) {
+			if err := s.markDigestRun(ctx, recipient.userID, now); err != nil {
+				recordSpanError(span, err)
+				return queued, err
+			}
+			continue
+		}
+
+		job := DigestEmailJob{
+			UserID:       recipient.userID,
+			Username:     recipient.username,
+			Email:        recipient.email,
+			Frequency:    recipient.frequency,
+			ScheduledFor: recipient.scheduledFor,
+			Digest:       *digest,
+		}
+		if err := EnqueueDigestEmail(ctx, s.redis, job); err != nil {
+			recordSpanError(span, err)
+			return queued, err
+		}
+		if err := s.markDigestRun(ctx, recipient.userID, now); err != nil {
+			recordSpanError(span, err)
+			return queued, err
+		}
+		queued++
+	}
+
+	span.SetAttributes(attribute.Int("queued_count", queued))
+	if queued > 0 {
+		observability.LogInfo(ctx, "digests queued", "count", fmt.Sprintf("%d", queued))
+	}
+
+	return queued, nil
+}
+
+// listDueRecipients returns opted-in users whose most recent send slot has not been processed yet.
+func (s *DigestScheduler) listDueRecipients(ctx context.Context, now time.Time) ([]digestRecipient, error) {
+	// Users who ran recently cannot be due yet, so skip them in SQL and keep the exact
+	// per-timezone slot check below. The margins leave room for DST shifts and check jitter.
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id, username, email, digest_frequency, COALESCE(timezone, ''), digest_last_run_at
+		FROM users
+		WHERE deleted_at IS NULL
+		  AND approved_at IS NOT NULL
+		  AND suspended_at IS NULL
+		  AND COALESCE(email, '') <> ''
+		  AND (
+			(digest_frequency = $1 AND (digest_last_run_at IS NULL OR digest_last_run_at < $3))
+			OR (digest_frequency = $2 AND (digest_last_run_at IS NULL OR digest_last_run_at < $4))
+		  )
+		ORDER BY id
+	`, models.DigestFrequencyDaily, models.DigestFrequencyWeekly,
+		now.Add(-dailyDigestMinGap).UTC(), now.Add(-weeklyDigestMinGap).UTC())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list digest recipients: %w", err)
+	}
+	defer rows.Close()
+
+	locations := make(map[string]*time.Location)
+	var recipients []digestRecipient
+	for rows.Next() {
+		var recipient digestRecipient
+		var timezone string
+		var lastRunAt sql.NullTime
+		if err := rows.Scan(&recipient.userID, &recipient.username, &recipient.email, &recipient.frequency, &timezone, &lastRunAt); err != nil {
+			return nil, fmt.Errorf("failed to scan digest recipient: %w", err)
+		}
+
+		loc, ok := locations[timezone]
+		if !ok {
+			loc = UserLocation(timezone)
+			locations[timezone] = loc
+		}
+		recipient.scheduledFor = digestSlot(now, loc, s.sendHour, recipient.frequency)
+		if lastRunAt.Valid && !lastRunAt.Time.Before(recipient.scheduledFor) {
+			continue
+		}
+		recipients = append(recipients, recipient)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating digest recipients: %w", err)
+	}
+
+	return recipients, nil
+}
+
+func (s *DigestScheduler) markDigestRun(ctx context.Context, userID uuid.UUID, runAt time.Time) error {
+	if _, err := s.db.ExecContext(ctx, "UPDATE users SET digest_last_run_at = $1 WHERE id = $2", runAt.UTC(), userID); err != nil {
+		return fmt.Errorf("failed to record digest run: %w", err)
+	}
+	return nil
+}
+
+func digestHasActivity(digest *models.NotificationDigest) bool {
+	return digest.UnreadNotificationCount > 0 || len(digest.Mentions) > 0 || digest.NewPostCount > 0
+}
+
+// digestSlot returns the most recent scheduled send time at or before now.
+// Weekly digests go out on Mondays.
+func digestSlot(now time.Time, loc *time.Location, sendHour int, frequency string) time.Time {
+	local := now.In(loc)
+	slot := time.Date(local.Year(), local.Month(), local.Day(), sendHour, 0, 0, 0, loc)
+	if local.Before(slot) {
+		slot = slot.AddDate(0, 0, -1)
+	}
+	if frequency == models.DigestFrequencyWeekly {
+		for slot.Weekday() != time.Monday {
+			slot = slot.AddDate(0, 0, -1)
+		}
+	}
+	return slot.UTC()
+}
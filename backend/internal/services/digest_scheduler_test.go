@@ -0,0 +1,187 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sanderginn/clubhouse/internal/models"
+	"github.com/sanderginn/clubhouse/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDigestSlot(t *testing.T) {
+	loc := time.UTC
+
+	// Wednesday 2026-01-14 10:30 UTC
+	now := time.Date(2026, time.January, 14, 10, 30, 0, 0, loc)
+	assert.Equal(t, time.Date(2026, time.January, 14, 8, 0, 0, 0, loc), digestSlot(now, loc, 8, models.DigestFrequencyDaily))
+	assert.Equal(t, time.Date(2026, time.January, 12, 8, 0, 0, 0, loc), digestSlot(now, loc, 8, models.DigestFrequencyWeekly))
+
+	// Before the send hour the previous day's slot applies
+	early := time.Date(2026, time.January, 14, 6, 0, 0, 0, loc)
+	assert.Equal(t, time.Date(2026, time.January, 13, 8, 0, 0, 0, loc), digestSlot(early, loc, 8, models.DigestFrequencyDaily))
+
+	// Monday before the send hour falls back to the previous Monday
+	mondayEarly := time.Date(2026, time.January, 12, 6, 0, 0, 0, loc)
+	assert.Equal(t, time.Date(2026, time.January, 5, 8, 0, 0, 0, loc), digestSlot(mondayEarly, loc, 8, models.DigestFrequencyWeekly))
+}
+
+func TestDigestSchedulerQueuesActiveUsersAndSkipsQuietOnes(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+	rdb := testutil.GetTestRedis(t)
+	t.Cleanup(func() { testutil.CleanupRedis(t) })
+	ctx := context.Background()
+
+	activeID := testutil.CreateTestUser(t, db, "digestactive", "digestactive@test.com", false, true)
+	quietID := testutil.CreateTestUser(t, db, "digestquiet", "digestquiet@test.com", false, true)
+	offID := testutil.CreateTestUser(t, db, "digestoff", "digestoff@test.com", false, true)
+	authorID := testutil.CreateTestUser(t, db, "digestposter", "digestposter@test.com", false, true)
+	sectionID := testutil.CreateTestSection(t, db, "Digest Section", "general")
+
+	userService := NewUserService(db)
+	_, err := userService.UpdateDigestPreferences(ctx, uuid.MustParse(activeID), models.DigestFrequencyDaily)
+	require.NoError(t, err)
+	_, err = userService.UpdateDigestPreferences(ctx, uuid.MustParse(quietID), models.DigestFrequencyDaily)
+	require.NoError(t, err)
+
+	// The quiet user opted out of the only section with new posts
+	_, err = db.Exec(`
+		INSERT INTO section_subscriptions (user_id, section_id, opted_out_at)
+		VALUES ($1, $2, now())
+	`, quietID, sectionID)
+	require.NoError(t, err)
+	testutil.CreateTestPost(t, db, authorID, sectionID, "Something new")
+
+	scheduler := NewDigestScheduler(db, rdb, DefaultDigestSendHour, time.Minute)
+	now := time.Now()
+	queued, err := scheduler.RunOnce(ctx, now)
+	require.NoError(t, err)
+	assert.Equal(t, 1, queued)
+
+	data, err := rdb.RPop(ctx, DigestQueueKey).Bytes()
+	require.NoError(t, err)
+	var job DigestEmailJob
+	require.NoError(t, json.Unmarshal(data, &job))
+	assert.Equal(t, activeID, job.UserID.String())
+	assert.Equal(t, models.DigestFrequencyDaily, job.Frequency)
+	assert.Equal(t, 1, job.Digest.NewPostCount)
+
+	length, err := rdb.LLen(ctx, DigestQueueKey).Result()
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), length)
+
+	var quietRunAt, offRunAt *time.Time
+	require.NoError(t, db.QueryRow(`SELECT digest_last_run_at FROM users WHERE id = $1`, quietID).Scan(&quietRunAt))
+	require.NoError(t, db.QueryRow(`SELECT digest_last_run_at FROM users WHERE id = $1`, offID).Scan(&offRunAt))
+	assert.NotNil(t, quietRunAt, "quiet user should be marked as processed")
+	assert.Nil(t, offRunAt, "users without digests should not be processed")
+
+	queued, err = scheduler.RunOnce(ctx, now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, 0, queued, "digests should not be queued twice for the same slot")
+}
+
+func TestUpdateDigestPreferencesRejectsUnknownFrequency(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	userID := testutil.CreateTestUser(t, db, "digestprefs", "digestprefs@test.com", false, true)
+
+	_, err := NewUserService(db).UpdateDigestPreferences(context.Background(), uuid.MustParse(userID), "hourly")
+	assert.ErrorIs(t, err, ErrInvalidDigestFrequency)
+}
+
+func TestDigestSlotUsesUserTimezoneOverGlobal(t *testing.T) {
+	config := GetConfigService()
+	previous := config.GetConfig().DisplayTimezone
+	global := "America/New_York"
+	_, err := config.UpdateConfig(context.Background(), ConfigUpdate{DisplayTimezone: &global})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, _ = config.UpdateConfig(context.Background(), ConfigUpdate{DisplayTimezone: &previous})
+	})
+
+	// Wednesday 2026-01-14 03:00 UTC is 12:00 in Tokyo and 22:00 the previous day in New York
+	now := time.Date(2026, time.January, 14, 3, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, "America/New_York", UserLocation("").String())
+	assert.Equal(t, "America/New_York", UserLocation("Not/AZone").String())
+	assert.Equal(t, "Asia/Tokyo", UserLocation(" Asia/Tokyo ").String())
+
+	// Global: 08:00 New York on 2026-01-13
+	assert.Equal(t, time.Date(2026, time.January, 13, 13, 0, 0, 0, time.UTC), digestSlot(now, UserLocation(""), 8, models.DigestFrequencyDaily))
+	// User: 08:00 Tokyo on 2026-01-14
+	assert.Equal(t, time.Date(2026, time.January, 13, 23, 0, 0, 0, time.UTC), digestSlot(now, UserLocation("Asia/Tokyo"), 8, models.DigestFrequencyDaily))
+}
+
+func TestNormalizeUserTimezone(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		err      error
+	}{
+		{input: "", expected: ""},
+		{input: "  Europe/Amsterdam ", expected: "Europe/Amsterdam"},
+		{input: "UTC", expected: "UTC"},
+		{input: "Mars/Olympus_Mons", err: ErrInvalidTimezone},
+		{input: "Local", err: ErrInvalidTimezone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			normalized, err := normalizeUserTimezone(tt.input)
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, normalized)
+		})
+	}
+}
+
+func TestDigestSchedulerUsesUserTimezoneForDueSlot(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+	ctx := context.Background()
+
+	tokyoID := testutil.CreateTestUser(t, db, "digesttokyo", "digesttokyo@test.com", false, true)
+	globalID := testutil.CreateTestUser(t, db, "digestglobal", "digestglobal@test.com", false, true)
+
+	userService := NewUserService(db)
+	for _, userID := range []string{tokyoID, globalID} {
+		_, err := userService.UpdateDigestPreferences(ctx, uuid.MustParse(userID), models.DigestFrequencyDaily)
+		require.NoError(t, err)
+	}
+	preference, err := userService.UpdateTimezone(ctx, uuid.MustParse(tokyoID), "Asia/Tokyo")
+	require.NoError(t, err)
+	assert.Equal(t, "Asia/Tokyo", preference.EffectiveTimezone)
+
+	// The Tokyo user last ran at 03:00 UTC on 2026-01-13 and the global user at 08:30 UTC,
+	// right after their 08:00 UTC slot. At 03:00 UTC the next day the Tokyo user's 08:00 local
+	// slot (23:00 UTC) has passed, but the global UTC slot has not.
+	_, err = db.Exec(`UPDATE users SET digest_last_run_at = $1 WHERE id = $2`, time.Date(2026, time.January, 13, 3, 0, 0, 0, time.UTC), tokyoID)
+	require.NoError(t, err)
+	_, err = db.Exec(`UPDATE users SET digest_last_run_at = $1 WHERE id = $2`, time.Date(2026, time.January, 13, 8, 30, 0, 0, time.UTC), globalID)
+	require.NoError(t, err)
+
+	scheduler := NewDigestScheduler(db, nil, DefaultDigestSendHour, time.Minute)
+	recipients, err := scheduler.listDueRecipients(ctx, time.Date(2026, time.January, 14, 3, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Len(t, recipients, 1)
+	assert.Equal(t, tokyoID, recipients[0].userID.String())
+	assert.Equal(t, time.Date(2026, time.January, 13, 23, 0, 0, 0, time.UTC), recipients[0].scheduledFor)
+
+	_, err = userService.UpdateTimezone(ctx, uuid.MustParse(globalID), "Not/AZone")
+	assert.ErrorIs(t, err, ErrInvalidTimezone)
+
+	cleared, err := userService.UpdateTimezone(ctx, uuid.MustParse(tokyoID), "")
+	require.NoError(t, err)
+	assert.Equal(t, "", cleared.Timezone)
+	assert.Equal(t, UserLocation("").String(), cleared.EffectiveTimezone)
+}
@@ -0,0 +1,11 @@
+package services
+
+import "time"
+
+// DefaultEditGracePeriod is how long after creation an edit is treated as a quick correction
+// and does not mark the post or comment as edited.
+const DefaultEditGracePeriod = 5 * time.Minute
+
+func editGracePeriodSeconds() float64 {
+	return GetConfigService().GetSettings().EditGracePeriod.Seconds()
+}
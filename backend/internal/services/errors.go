@@ -4,6 +4,9 @@ import "errors"
 
 // Sentinel errors for service layer
 var (
-	ErrPostNotFound    = errors.New("post not found")
-	ErrCommentNotFound = errors.New("comment not found")
+	ErrPostNotFound        = errors.New("post not found")
+	ErrCommentNotFound     = errors.New("comment not found")
+	ErrRatingsNotSupported = errors.New("post does not support ratings")
+	ErrLinkNotFound        = errors.New("link not found")
+	ErrMetadataQueueOff    = errors.New("metadata queue is not available")
 )
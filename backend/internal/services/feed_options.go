@@ -0,0 +1,10 @@
+package services
+
+// feedCommentCountSQL returns the comment count column and the comments join used by feed
+// queries, or a NULL count and no join when comment counts are omitted.
+func feedCommentCountSQL(includeCommentCounts bool) (string, string) {
+	if !includeCommentCounts {
+		return "NULL", ""
+	}
+	return "COALESCE(COUNT(DISTINCT c.id), 0)", "LEFT JOIN comments c ON p.id = c.post_id AND c.deleted_at IS NULL"
+}
@@ -0,0 +1,63 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sanderginn/clubhouse/internal/testutil"
+)
+
+func TestFeedCommentCountSQL(t *testing.T) {
+	column, join := feedCommentCountSQL(true)
+	if column != "COALESCE(COUNT(DISTINCT c.id), 0)" || join == "" {
+		t.Fatalf("expected comment aggregation when included, got %q / %q", column, join)
+	}
+
+	column, join = feedCommentCountSQL(false)
+	if column != "NULL" || join != "" {
+		t.Fatalf("expected comment aggregation to be skipped, got %q / %q", column, join)
+	}
+}
+
+func TestGetFeedOmitsCommentCounts(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	userID := testutil.CreateTestUser(t, db, "feedcountsuser", "feedcounts@test.com", false, true)
+	sectionID := testutil.CreateTestSection(t, db, "Feed Counts", "general")
+	postID := testutil.CreateTestPost(t, db, userID, sectionID, "Post with comments")
+	testutil.CreateTestComment(t, db, userID, postID, "First")
+	testutil.CreateTestComment(t, db, userID, postID, "Second")
+
+	service := NewPostService(db)
+
+	feed, err := service.GetFeed(context.Background(), uuid.MustParse(sectionID), nil, 10, uuid.MustParse(userID), true)
+	if err != nil {
+		t.Fatalf("GetFeed failed: %v", err)
+	}
+	if len(feed.Posts) != 1 || feed.Posts[0].CommentCount == nil || *feed.Posts[0].CommentCount != 2 {
+		t.Fatalf("expected comment count 2 when included, got %+v", feed.Posts)
+	}
+
+	feed, err = service.GetFeed(context.Background(), uuid.MustParse(sectionID), nil, 10, uuid.MustParse(userID), false)
+	if err != nil {
+		t.Fatalf("GetFeed without comment counts failed: %v", err)
+	}
+	if len(feed.Posts) != 1 {
+		t.Fatalf("expected 1 post, got %d", len(feed.Posts))
+	}
+	if feed.Posts[0].CommentCount != nil {
+		t.Fatalf("expected comment count omitted, got %d", *feed.Posts[0].CommentCount)
+	}
+
+	encoded, err := json.Marshal(feed.Posts[0])
+	if err != nil {
+		t.Fatalf("failed to marshal post: %v", err)
+	}
+	if strings.Contains(string(encoded), "comment_count") {
+		t.Fatalf("expected comment_count to be left out of the JSON, got %s", encoded)
+	}
+}
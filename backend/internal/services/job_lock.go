@@ -0,0 +1,34 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/sanderginn/clubhouse/internal/observability"
+)
+
+// releaseJobLock deletes a background job lock only if this instance still holds it.
+var releaseJobLock = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+  return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// acquireJobLock takes the Redis lock at key so only one instance runs a background job at a
+// time. The lock expires after ttl if the holder dies. When acquired, the caller must call release
+// once the run is done.
+func acquireJobLock(ctx context.Context, rdb *redis.Client, key string, ttl time.Duration) (release func(), acquired bool, err error) {
+	token := uuid.NewString()
+	acquired, err = rdb.SetNX(ctx, key, token, ttl).Result()
+	if err != nil || !acquired {
+		return nil, false, err
+	}
+	return func() {
+		if err := releaseJobLock.Run(ctx, rdb, []string{key}, token).Err(); err != nil && err != redis.Nil {
+			observability.LogWarn(ctx, "failed to release job lock", "key", key, "error", err.Error())
+		}
+	}, true, nil
+}
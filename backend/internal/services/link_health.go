@@ -0,0 +1,212 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/sanderginn/clubhouse/internal/observability"
+	"github.com/sanderginn/clubhouse/internal/services/links"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	// LinkHealthCheckerLockKey is the Redis key that keeps several instances from checking links at once
+	LinkHealthCheckerLockKey = "clubhouse:link_health_checker:lock"
+	// DefaultLinkHealthCheckInterval is how often the stale-link job wakes up
+	DefaultLinkHealthCheckInterval = 6 * time.Hour
+	// DefaultLinkHealthCheckBatchSize is how many links are rechecked per run
+	DefaultLinkHealthCheckBatchSize = 50
+	// DefaultLinkHealthRecheckAfter is how long a link's last check stays fresh
+	DefaultLinkHealthRecheckAfter = 7 * 24 * time.Hour
+)
+
+// LinkHealthChecker periodically rechecks a sample of stored links and flags the ones that
+// no longer resolve so clients can mark them as broken.
+type LinkHealthChecker struct {
+	db           *sql.DB
+	redis        *redis.Client
+	interval     time.Duration
+	batchSize    int
+	recheckAfter time.Duration
+	stopCh       chan struct{}
+	wg           sync.WaitGroup
+}
+
+// NewLinkHealthChecker creates a stale-link detection job. Non-positive settings fall back to defaults.
+func NewLinkHealthChecker(db *sql.DB, rdb *redis.Client, interval time.Duration, batchSize int, recheckAfter time.Duration) *LinkHealthChecker {
+	if interval <= 0 {
+		interval = DefaultLinkHealthCheckInterval
+	}
+	if batchSize <= 0 {
+		batchSize = DefaultLinkHealthCheckBatchSize
+	}
+	if recheckAfter <= 0 {
+		recheckAfter = DefaultLinkHealthRecheckAfter
+	}
+	return &LinkHealthChecker{
+		db:           db,
+		redis:        rdb,
+		interval:     interval,
+		batchSize:    batchSize,
+		recheckAfter: recheckAfter,
+		stopCh:       make(chan struct{}),
+	}
+}
+
+// Start runs the check loop in the background.
+func (c *LinkHealthChecker) Start(ctx context.Context) {
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		ticker := time.NewTicker(c.interval)
+		defer ticker.Stop()
+
+		for {
+			checked, err := c.RunOnce(ctx, time.Now())
+			if err != nil {
+				observability.LogError(ctx, observability.ErrorLog{
+					Message: "failed to check link health",
+					Code:    "LINK_HEALTH_CHECK_FAILED",
+					Err:     err,
+				})
+			} else if checked > 0 {
+				observability.LogInfo(ctx, "link health checked", "count", fmt.Sprintf("%d", checked))
+			}
+
+			select {
+			case <-c.stopCh:
+				return
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop shuts down the check loop.
+func (c *LinkHealthChecker) Stop(ctx context.Context) {
+	close(c.stopCh)
+	c.wg.Wait()
+	observability.LogInfo(ctx, "link health checker stopped")
+}
+
+type linkToCheck struct {
+	id  uuid.UUID
+	url string
+}
+
+// RunOnce rechecks the links whose last check is oldest (or missing) and returns how many were checked.
+// It does nothing when another instance holds the check lock.
+func (c *LinkHealthChecker) RunOnce(ctx context.Context, now time.Time) (int, error) {
+	ctx, span := otel.Tracer("clubhouse.links").Start(ctx, "LinkHealthChecker.RunOnce")
+	span.SetAttributes(attribute.Int("batch_size", c.batchSize))
+	defer span.End()
+
+	if c.redis == nil {
+		err := fmt.Errorf("link health check lock is not configured")
+		recordSpanError(span, err)
+		return 0, err
+	}
+
+	// Only one instance checks at a time; the lock expires on its own if the holder dies.
+	release, locked, err := acquireJobLock(ctx, c.redis, LinkHealthCheckerLockKey, c.interval)
+	if err != nil {
+		recordSpanError(span, err)
+		return 0, fmt.Errorf("failed to acquire link health check lock: %w", err)
+	}
+	span.SetAttributes(attribute.Bool("lock_acquired", locked))
+	if !locked {
+		return 0, nil
+	}
+	defer release()
+
+	rows, err := c.db.QueryContext(ctx, `
+		SELECT id, url
+		FROM links
+		WHERE last_checked_at IS NULL OR last_checked_at <= $1
+		ORDER BY last_checked_at ASC NULLS FIRST, created_at ASC
+		LIMIT $2
+	`, now.Add(-c.recheckAfter).UTC(), c.batchSize)
+	if err != nil {
+		recordSpanError(span, err)
+		return 0, fmt.Errorf("failed to list links to check: %w", err)
+	}
+
+	var batch []linkToCheck
+	for rows.Next() {
+		var link linkToCheck
+		if err := rows.Scan(&link.id, &link.url); err != nil {
+			rows.Close()
+			recordSpanError(span, err)
+			return 0, fmt.Errorf("failed to scan link: %w", err)
+		}
+		batch = append(batch, link)
+	}
+	if err := rows.Err(); err != nil {
+		rows.Close()
+		recordSpanError(span, err)
+		return 0, fmt.Errorf("error iterating links: %w", err)
+	}
+	rows.Close()
+
+	dead := 0
+	for _, link := range batch {
+		isDead, known := c.checkLink(ctx, link)
+		if isDead {
+			dead++
+		}
+		if _, err := c.db.ExecContext(ctx, `
+			UPDATE links
+			SET last_checked_at = $1,
+			    is_dead = CASE WHEN $2::boolean THEN $3::boolean ELSE is_dead END
+			WHERE id = $4
+		`, now.UTC(), known, isDead, link.id); err != nil {
+			recordSpanError(span, err)
+			return 0, fmt.Errorf("failed to update link health: %w", err)
+		}
+	}
+
+	span.SetAttributes(
+		attribute.Int("checked_count", len(batch)),
+		attribute.Int("dead_count", dead),
+	)
+	return len(batch), nil
+}
+
+// checkLink reports whether a link is dead and whether the check was conclusive. Uploads are
+// served by this app and always alive; blocked URLs, network errors and server errors are
+// inconclusive so a temporary outage does not flag a link.
+func (c *LinkHealthChecker) checkLink(ctx context.Context, link linkToCheck) (bool, bool) {
+	if links.IsInternalUploadURL(link.url) {
+		return false, true
+	}
+
+	status, err := links.CheckReachability(ctx, link.url)
+	if err != nil {
+		observability.LogWarn(ctx, "link health check failed",
+			"link_id", link.id.String(),
+			"error_type", links.ClassifyFetchError(err),
+		)
+		return false, false
+	}
+	return classifyLinkStatus(status)
+}
+
+func classifyLinkStatus(status int) (bool, bool) {
+	switch {
+	case status == http.StatusNotFound || status == http.StatusGone:
+		return true, true
+	case status >= http.StatusOK && status < http.StatusBadRequest:
+		return false, true
+	default:
+		return false, false
+	}
+}
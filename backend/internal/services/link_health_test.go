@@ -0,0 +1,128 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sanderginn/clubhouse/internal/services/links"
+	"github.com/sanderginn/clubhouse/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinkHealthCheckerMarksDeadAndRevivedLinks(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	statuses := map[string]int{}
+	links.SetCheckReachabilityFuncForTests(func(ctx context.Context, rawURL string) (int, error) {
+		return statuses[rawURL], nil
+	})
+	t.Cleanup(func() { links.SetCheckReachabilityFuncForTests(nil) })
+
+	userID := testutil.CreateTestUser(t, db, "linkhealthuser", "linkhealth@test.com", false, true)
+	sectionID := testutil.CreateTestSection(t, db, "Link Health Section", "general")
+	postID := testutil.CreateTestPost(t, db, userID, sectionID, "Post with a link")
+
+	const linkURL = "https://example.com/article"
+	var linkID uuid.UUID
+	if err := db.QueryRow(`
+		INSERT INTO links (post_id, url, created_at)
+		VALUES ($1, $2, now())
+		RETURNING id
+	`, postID, linkURL).Scan(&linkID); err != nil {
+		t.Fatalf("failed to create link: %v", err)
+	}
+
+	rdb := testutil.GetTestRedis(t)
+	t.Cleanup(func() { testutil.CleanupRedis(t) })
+	checker := NewLinkHealthChecker(db, rdb, time.Hour, 10, time.Hour)
+	postService := NewPostService(db)
+	ctx := context.Background()
+	now := time.Now()
+
+	statuses[linkURL] = http.StatusNotFound
+	checked, err := checker.RunOnce(ctx, now)
+	if err != nil {
+		t.Fatalf("RunOnce failed: %v", err)
+	}
+	if checked != 1 {
+		t.Fatalf("expected 1 link checked, got %d", checked)
+	}
+
+	post, err := postService.GetPostByID(ctx, uuid.MustParse(postID), uuid.MustParse(userID))
+	if err != nil {
+		t.Fatalf("GetPostByID failed: %v", err)
+	}
+	if len(post.Links) != 1 || !post.Links[0].IsDead {
+		t.Fatalf("expected link to be marked dead after 404")
+	}
+	if post.Links[0].LastCheckedAt == nil {
+		t.Fatalf("expected last_checked_at to be set")
+	}
+
+	// A fresh check is not repeated until the recheck window passes.
+	checked, err = checker.RunOnce(ctx, now.Add(time.Minute))
+	if err != nil {
+		t.Fatalf("RunOnce failed: %v", err)
+	}
+	if checked != 0 {
+		t.Fatalf("expected no links due for recheck, got %d", checked)
+	}
+
+	statuses[linkURL] = http.StatusOK
+	if _, err := checker.RunOnce(ctx, now.Add(2*time.Hour)); err != nil {
+		t.Fatalf("RunOnce failed: %v", err)
+	}
+
+	post, err = postService.GetPostByID(ctx, uuid.MustParse(postID), uuid.MustParse(userID))
+	if err != nil {
+		t.Fatalf("GetPostByID failed: %v", err)
+	}
+	if len(post.Links) != 1 || post.Links[0].IsDead {
+		t.Fatalf("expected link to be cleared after 200")
+	}
+}
+
+func TestClassifyLinkStatus(t *testing.T) {
+	tests := []struct {
+		status    int
+		wantDead  bool
+		wantKnown bool
+	}{
+		{http.StatusOK, false, true},
+		{http.StatusMovedPermanently, false, true},
+		{http.StatusNotFound, true, true},
+		{http.StatusGone, true, true},
+		{http.StatusForbidden, false, false},
+		{http.StatusServiceUnavailable, false, false},
+	}
+
+	for _, tt := range tests {
+		dead, known := classifyLinkStatus(tt.status)
+		if dead != tt.wantDead || known != tt.wantKnown {
+			t.Errorf("classifyLinkStatus(%d) = (%v, %v), want (%v, %v)", tt.status, dead, known, tt.wantDead, tt.wantKnown)
+		}
+	}
+}
+
+func TestLinkHealthCheckerSkipsRunWhenLockIsHeld(t *testing.T) {
+	rdb := testutil.GetTestRedis(t)
+	t.Cleanup(func() { testutil.CleanupRedis(t) })
+	ctx := context.Background()
+
+	require.NoError(t, rdb.Set(ctx, LinkHealthCheckerLockKey, "other-instance", time.Minute).Err())
+
+	// The lock is checked before the database is touched, so no DB is needed here.
+	checker := NewLinkHealthChecker(nil, rdb, time.Minute, 10, time.Hour)
+	checked, err := checker.RunOnce(ctx, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, 0, checked)
+
+	holder, err := rdb.Get(ctx, LinkHealthCheckerLockKey).Result()
+	require.NoError(t, err)
+	assert.Equal(t, "other-instance", holder, "another instance's lock must not be released")
+}
@@ -0,0 +1,72 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// LinkMetadataCacheKeyPrefix is the Redis key prefix for fetched link metadata keyed by URL
+	LinkMetadataCacheKeyPrefix = "clubhouse:link_metadata_cache:"
+	// DefaultLinkMetadataCacheTTL is how long fetched metadata is reused for the same URL
+	DefaultLinkMetadataCacheTTL = 24 * time.Hour
+)
+
+// linkMetadataCacheKey scopes entries by section type because extractors can
+// return different metadata for the same URL depending on the section.
+func linkMetadataCacheKey(sectionType, url string) string {
+	sum := sha256.Sum256([]byte(url))
+	return LinkMetadataCacheKeyPrefix + sectionType + ":" + hex.EncodeToString(sum[:])
+}
+
+// GetCachedLinkMetadata returns cached metadata for a URL.
+// Returns nil, nil when the cache is disabled or has no fresh entry.
+func GetCachedLinkMetadata(ctx context.Context, rdb *redis.Client, sectionType, url string) (map[string]interface{}, error) {
+	if rdb == nil || GetConfigService().GetSettings().LinkMetadataCacheTTL <= 0 {
+		return nil, nil
+	}
+
+	data, err := rdb.Get(ctx, linkMetadataCacheKey(sectionType, url)).Bytes()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var metadata map[string]interface{}
+	if err := json.Unmarshal(data, &metadata); err != nil {
+		return nil, err
+	}
+	if len(metadata) == 0 {
+		return nil, nil
+	}
+	return metadata, nil
+}
+
+// CacheLinkMetadata stores fetched metadata for a URL for the configured TTL.
+func CacheLinkMetadata(ctx context.Context, rdb *redis.Client, sectionType, url string, metadata map[string]interface{}) error {
+	ttl := GetConfigService().GetSettings().LinkMetadataCacheTTL
+	if rdb == nil || ttl <= 0 || len(metadata) == 0 {
+		return nil
+	}
+
+	data, err := json.Marshal(metadata)
+	if err != nil {
+		return err
+	}
+	return rdb.Set(ctx, linkMetadataCacheKey(sectionType, url), data, ttl).Err()
+}
+
+// InvalidateCachedLinkMetadata removes any cached metadata for a URL.
+func InvalidateCachedLinkMetadata(ctx context.Context, rdb *redis.Client, sectionType, url string) error {
+	if rdb == nil {
+		return nil
+	}
+	return rdb.Del(ctx, linkMetadataCacheKey(sectionType, url)).Err()
+}
@@ -0,0 +1,70 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sanderginn/clubhouse/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinkMetadataCacheRoundTrip(t *testing.T) {
+	rdb := testutil.GetTestRedis(t)
+	t.Cleanup(func() { testutil.CleanupRedis(t) })
+	ctx := context.Background()
+
+	url := "https://example.com/article"
+	cached, err := GetCachedLinkMetadata(ctx, rdb, "general", url)
+	require.NoError(t, err)
+	assert.Nil(t, cached)
+
+	err = CacheLinkMetadata(ctx, rdb, "general", url, map[string]interface{}{"title": "Article"})
+	require.NoError(t, err)
+
+	cached, err = GetCachedLinkMetadata(ctx, rdb, "general", url)
+	require.NoError(t, err)
+	assert.Equal(t, "Article", cached["title"])
+
+	otherSection, err := GetCachedLinkMetadata(ctx, rdb, "recipe", url)
+	require.NoError(t, err)
+	assert.Nil(t, otherSection)
+
+	require.NoError(t, InvalidateCachedLinkMetadata(ctx, rdb, "general", url))
+	cached, err = GetCachedLinkMetadata(ctx, rdb, "general", url)
+	require.NoError(t, err)
+	assert.Nil(t, cached)
+}
+
+func TestLinkMetadataCacheExpires(t *testing.T) {
+	rdb := testutil.GetTestRedis(t)
+	t.Cleanup(func() { testutil.CleanupRedis(t) })
+	ctx := context.Background()
+
+	setSettingsForTest(t, func(settings *Settings) { settings.LinkMetadataCacheTTL = time.Minute })
+
+	url := "https://example.com/expiring"
+	require.NoError(t, CacheLinkMetadata(ctx, rdb, "general", url, map[string]interface{}{"title": "Expiring"}))
+
+	testutil.GetMiniredisServer(t).FastForward(2 * time.Minute)
+
+	cached, err := GetCachedLinkMetadata(ctx, rdb, "general", url)
+	require.NoError(t, err)
+	assert.Nil(t, cached)
+}
+
+func TestLinkMetadataCacheDisabled(t *testing.T) {
+	rdb := testutil.GetTestRedis(t)
+	t.Cleanup(func() { testutil.CleanupRedis(t) })
+	ctx := context.Background()
+
+	setSettingsForTest(t, func(settings *Settings) { settings.LinkMetadataCacheTTL = 0 })
+
+	url := "https://example.com/disabled"
+	require.NoError(t, CacheLinkMetadata(ctx, rdb, "general", url, map[string]interface{}{"title": "Disabled"}))
+
+	cached, err := GetCachedLinkMetadata(ctx, rdb, "general", url)
+	require.NoError(t, err)
+	assert.Nil(t, cached)
+}
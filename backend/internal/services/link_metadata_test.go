@@ -12,11 +12,11 @@ func TestFetchLinkMetadataIncludesSpotifyEmbed(t *testing.T) {
 	config := GetConfigService()
 	current := config.GetConfig().LinkMetadataEnabled
 	enabled := true
-	if _, err := config.UpdateConfig(context.Background(), &enabled, nil, nil); err != nil {
+	if _, err := config.UpdateConfig(context.Background(), ConfigUpdate{LinkMetadataEnabled: &enabled}); err != nil {
 		t.Fatalf("failed to enable link metadata: %v", err)
 	}
 	t.Cleanup(func() {
-		if _, err := config.UpdateConfig(context.Background(), &current, nil, nil); err != nil {
+		if _, err := config.UpdateConfig(context.Background(), ConfigUpdate{LinkMetadataEnabled: &current}); err != nil {
 			t.Fatalf("failed to restore link metadata: %v", err)
 		}
 	})
@@ -197,6 +197,10 @@ func (f *Fetcher) Fetch(ctx context.Context, rawURL string) (map[string]interfac
 
 	metadata := make(map[string]interface{})
 
+	if normalized := normalizeContentType(contentTypeLower); normalized != "" {
+		metadata["content_type"] = normalized
+	}
+
 	// Treat SVGs as images here; frontend renders via <img> to avoid inline SVG execution.
 	if strings.HasPrefix(contentTypeLower, "image/") {
 		metadata["image"] = u.String()
@@ -727,6 +731,12 @@ func looksLikeImageURL(u *url.URL) bool {
 	return false
 }
 
+// normalizeContentType strips parameters such as charset from a Content-Type header value.
+func normalizeContentType(contentType string) string {
+	mediaType, _, _ := strings.Cut(contentType, ";")
+	return strings.TrimSpace(mediaType)
+}
+
 func extractHTMLMeta(body []byte) (map[string]string, string) {
 	metaTags := make(map[string]string)
 	if len(body) == 0 {
@@ -1084,6 +1084,71 @@ func TestFetchMetadataImageContent(t *testing.T) {
 	}
 }
 
+func TestFetchMetadataImageContentWithoutExtension(t *testing.T) {
+	fetcher := NewFetcher(&http.Client{
+		Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Status:     "200 OK",
+				Header:     http.Header{"Content-Type": []string{"Image/JPEG; charset=binary"}},
+				Body:       io.NopCloser(strings.NewReader("jpegbytes")),
+				Request:    r,
+			}, nil
+		}),
+	})
+	fetcher.resolver = fakeResolver{
+		addrs: map[string][]net.IPAddr{
+			"example.com": {{IP: net.ParseIP("93.184.216.34")}},
+		},
+	}
+
+	metadata, err := fetcher.Fetch(context.Background(), "https://example.com/media/12345")
+	if err != nil {
+		t.Fatalf("FetchMetadata error: %v", err)
+	}
+
+	if metadata["image"] != "https://example.com/media/12345" {
+		t.Errorf("image = %v, want %v", metadata["image"], "https://example.com/media/12345")
+	}
+	if metadata["type"] != "image" {
+		t.Errorf("type = %v, want image", metadata["type"])
+	}
+	if metadata["content_type"] != "image/jpeg" {
+		t.Errorf("content_type = %v, want image/jpeg", metadata["content_type"])
+	}
+}
+
+func TestFetchMetadataRecordsContentTypeForPages(t *testing.T) {
+	fetcher := NewFetcher(&http.Client{
+		Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Status:     "200 OK",
+				Header:     http.Header{"Content-Type": []string{"text/html; charset=utf-8"}},
+				Body:       io.NopCloser(strings.NewReader("<html><head><title>Gallery</title></head></html>")),
+				Request:    r,
+			}, nil
+		}),
+	})
+	fetcher.resolver = fakeResolver{
+		addrs: map[string][]net.IPAddr{
+			"example.com": {{IP: net.ParseIP("93.184.216.34")}},
+		},
+	}
+
+	metadata, err := fetcher.Fetch(context.Background(), "https://example.com/gallery/photo.jpg")
+	if err != nil {
+		t.Fatalf("FetchMetadata error: %v", err)
+	}
+
+	if metadata["content_type"] != "text/html" {
+		t.Errorf("content_type = %v, want text/html", metadata["content_type"])
+	}
+	if metadata["type"] == "image" {
+		t.Errorf("type = %v, want a non-image page", metadata["type"])
+	}
+}
+
 func TestFetchMetadataImageFallbackByExtension(t *testing.T) {
 	fetcher := NewFetcher(&http.Client{
 		Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
@@ -0,0 +1,83 @@
+package links
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// CheckReachability reports the HTTP status a URL answers with using the default fetcher.
+func CheckReachability(ctx context.Context, rawURL string) (int, error) {
+	return checkReachabilityFunc(ctx, rawURL)
+}
+
+var checkReachabilityFunc = func(ctx context.Context, rawURL string) (int, error) {
+	return defaultFetcher.CheckReachability(ctx, rawURL)
+}
+
+// SetCheckReachabilityFuncForTests overrides the reachability check. Use only in tests.
+func SetCheckReachabilityFuncForTests(fn func(context.Context, string) (int, error)) {
+	if fn == nil {
+		checkReachabilityFunc = func(ctx context.Context, rawURL string) (int, error) {
+			return defaultFetcher.CheckReachability(ctx, rawURL)
+		}
+		return
+	}
+	checkReachabilityFunc = fn
+}
+
+// CheckReachability sends a HEAD request to the URL and returns the final status code.
+// The same SSRF checks as metadata fetching apply to the URL and every redirect. Servers
+// that reject HEAD are retried with GET, discarding the body.
+func (f *Fetcher) CheckReachability(ctx context.Context, rawURL string) (int, error) {
+	if ctx == nil {
+		return 0, errors.New("context is required")
+	}
+
+	checkCtx, cancel := context.WithTimeout(ctx, fetchTimeout)
+	defer cancel()
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return 0, fmt.Errorf("parse url: %w", err)
+	}
+	if err := f.validateURL(checkCtx, u); err != nil {
+		return 0, err
+	}
+
+	client := f.client
+	if client == nil {
+		client = &http.Client{Timeout: fetchTimeout}
+	}
+	clientCopy := *client
+	clientCopy.CheckRedirect = f.redirectValidator(checkCtx, client.CheckRedirect)
+
+	status, err := doReachabilityRequest(checkCtx, &clientCopy, http.MethodHead, u)
+	if err != nil {
+		return 0, err
+	}
+	if status == http.StatusMethodNotAllowed || status == http.StatusNotImplemented {
+		return doReachabilityRequest(checkCtx, &clientCopy, http.MethodGet, u)
+	}
+	return status, nil
+}
+
+func doReachabilityRequest(ctx context.Context, client *http.Client, method string, u *url.URL) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), nil)
+	if err != nil {
+		return 0, fmt.Errorf("build request: %w", err)
+	}
+	applyRequestHeaders(req, u)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("fetch url: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxBodyBytes))
+
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,103 @@
+package links
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestCheckReachabilityUsesHead(t *testing.T) {
+	var methods []string
+	fetcher := NewFetcher(&http.Client{
+		Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			methods = append(methods, r.Method)
+			return &http.Response{
+				StatusCode: http.StatusNotFound,
+				Status:     "404 Not Found",
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader("")),
+				Request:    r,
+			}, nil
+		}),
+	})
+	fetcher.resolver = fakeResolver{
+		addrs: map[string][]net.IPAddr{
+			"example.com": {{IP: net.ParseIP("93.184.216.34")}},
+		},
+	}
+
+	status, err := fetcher.CheckReachability(context.Background(), "https://example.com/gone")
+	if err != nil {
+		t.Fatalf("CheckReachability error: %v", err)
+	}
+	if status != http.StatusNotFound {
+		t.Errorf("status = %d, want %d", status, http.StatusNotFound)
+	}
+	if len(methods) != 1 || methods[0] != http.MethodHead {
+		t.Errorf("methods = %v, want [HEAD]", methods)
+	}
+}
+
+func TestCheckReachabilityFallsBackToGet(t *testing.T) {
+	var methods []string
+	fetcher := NewFetcher(&http.Client{
+		Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			methods = append(methods, r.Method)
+			status := http.StatusOK
+			if r.Method == http.MethodHead {
+				status = http.StatusMethodNotAllowed
+			}
+			return &http.Response{
+				StatusCode: status,
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader("ok")),
+				Request:    r,
+			}, nil
+		}),
+	})
+	fetcher.resolver = fakeResolver{
+		addrs: map[string][]net.IPAddr{
+			"example.com": {{IP: net.ParseIP("93.184.216.34")}},
+		},
+	}
+
+	status, err := fetcher.CheckReachability(context.Background(), "https://example.com/page")
+	if err != nil {
+		t.Fatalf("CheckReachability error: %v", err)
+	}
+	if status != http.StatusOK {
+		t.Errorf("status = %d, want %d", status, http.StatusOK)
+	}
+	if len(methods) != 2 || methods[1] != http.MethodGet {
+		t.Errorf("methods = %v, want [HEAD GET]", methods)
+	}
+}
+
+func TestCheckReachabilityBlocksPrivateHosts(t *testing.T) {
+	called := false
+	fetcher := NewFetcher(&http.Client{
+		Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			called = true
+			return nil, nil
+		}),
+	})
+	fetcher.resolver = fakeResolver{
+		addrs: map[string][]net.IPAddr{
+			"internal.example": {{IP: net.ParseIP("10.0.0.5")}},
+		},
+	}
+
+	for _, rawURL := range []string{"http://127.0.0.1/admin", "http://localhost/", "https://internal.example/"} {
+		if _, err := fetcher.CheckReachability(context.Background(), rawURL); err == nil {
+			t.Errorf("expected %s to be blocked", rawURL)
+		} else if ClassifyFetchError(err) != "blocked" {
+			t.Errorf("error type for %s = %q, want blocked", rawURL, ClassifyFetchError(err))
+		}
+	}
+	if called {
+		t.Error("expected no request to be sent for blocked hosts")
+	}
+}
@@ -0,0 +1,23 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// DefaultMaxLogNoteLength is the default maximum cook/watch log note length in characters.
+const DefaultMaxLogNoteLength = 1000
+
+// ErrLogNoteTooLong is returned when a cook or watch log note exceeds the configured limit.
+var ErrLogNoteTooLong = errors.New("notes are too long")
+
+// validateLogNotes checks the note as it will be stored, i.e. after trimming.
+func validateLogNotes(notes string) error {
+	maxLength := GetConfigService().GetSettings().MaxLogNoteLength
+	if utf8.RuneCountInString(strings.TrimSpace(notes)) > maxLength {
+		return fmt.Errorf("%w: must be %d characters or less", ErrLogNoteTooLong, maxLength)
+	}
+	return nil
+}
@@ -3,10 +3,12 @@ package services
 import (
 	"context"
 	"encoding/json"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/redis/go-redis/v9"
+	"github.com/sanderginn/clubhouse/internal/models"
 )
 
 const (
@@ -14,6 +16,13 @@ const (
 	MetadataQueueKey = "clubhouse:metadata_queue"
 	// MetadataQueueProcessingKey is the Redis key for jobs being processed
 	MetadataQueueProcessingKey = "clubhouse:metadata_queue:processing"
+	// MetadataQueueDeadLetterKey is the Redis key for jobs that failed processing
+	MetadataQueueDeadLetterKey = "clubhouse:metadata_queue:dead_letter"
+	// MetadataLastSuccessKey is the Redis key holding the Unix time of the last processed job
+	MetadataLastSuccessKey = "clubhouse:metadata_queue:last_success"
+
+	// maxMetadataDeadLetterJobs bounds the dead-letter list so repeated failures cannot grow it forever
+	maxMetadataDeadLetterJobs = 1000
 )
 
 // MetadataJob represents a link metadata fetch job
@@ -22,6 +31,8 @@ type MetadataJob struct {
 	LinkID    uuid.UUID `json:"link_id"`
 	URL       string    `json:"url"`
 	CreatedAt time.Time `json:"created_at"`
+	// ForceRefresh bypasses the URL metadata cache and re-fetches the link
+	ForceRefresh bool `json:"force_refresh,omitempty"`
 }
 
 // EnqueueMetadataJob adds a link metadata fetch job to the Redis queue
@@ -92,3 +103,62 @@ func RequeueProcessingJobs(ctx context.Context, rdb *redis.Client) (int, error)
 		}
 	}
 }
+
+// DeadLetterMetadataJob records a failed job in the dead-letter list, keeping only the most recent failures
+func DeadLetterMetadataJob(ctx context.Context, rdb *redis.Client, job MetadataJob) error {
+	data, err := json.Marshal(job)
+	if err != nil {
+		return err
+	}
+	pipe := rdb.TxPipeline()
+	pipe.LPush(ctx, MetadataQueueDeadLetterKey, data)
+	pipe.LTrim(ctx, MetadataQueueDeadLetterKey, 0, maxMetadataDeadLetterJobs-1)
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+// GetDeadLetterLength returns the number of failed jobs in the dead-letter list
+func GetDeadLetterLength(ctx context.Context, rdb *redis.Client) (int64, error) {
+	return rdb.LLen(ctx, MetadataQueueDeadLetterKey).Result()
+}
+
+// RecordMetadataJobSuccess stores the time a job was last processed successfully
+func RecordMetadataJobSuccess(ctx context.Context, rdb *redis.Client, processedAt time.Time) error {
+	return rdb.Set(ctx, MetadataLastSuccessKey, processedAt.UTC().Unix(), 0).Err()
+}
+
+// GetMetadataQueueStatus reports queue depth, dead-letter size, and the last successful processing time
+func GetMetadataQueueStatus(ctx context.Context, rdb *redis.Client) (*models.MetadataQueueStatus, error) {
+	queueLength, err := GetQueueLength(ctx, rdb)
+	if err != nil {
+		return nil, err
+	}
+	processingLength, err := GetProcessingLength(ctx, rdb)
+	if err != nil {
+		return nil, err
+	}
+	deadLetterLength, err := GetDeadLetterLength(ctx, rdb)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &models.MetadataQueueStatus{
+		QueueLength:      queueLength,
+		ProcessingLength: processingLength,
+		DeadLetterLength: deadLetterLength,
+	}
+
+	raw, err := rdb.Get(ctx, MetadataLastSuccessKey).Result()
+	if err != nil && err != redis.Nil {
+		return nil, err
+	}
+	if err == nil {
+		seconds, parseErr := strconv.ParseInt(raw, 10, 64)
+		if parseErr == nil {
+			lastSuccess := time.Unix(seconds, 0).UTC()
+			status.LastSuccessAt = &lastSuccess
+		}
+	}
+
+	return status, nil
+}
@@ -138,21 +138,44 @@ func (w *MetadataWorker) processJob(ctx context.Context, job *MetadataJob, worke
 		fetchCtx = linkmeta.WithMetadataSectionType(fetchCtx, sectionType)
 	}
 
-	metadata, err := w.fetcher.Fetch(fetchCtx, job.URL)
-	if err != nil {
-		observability.LogError(ctx, observability.ErrorLog{
-			Message: "failed to fetch link metadata",
-			Code:    "METADATA_FETCH_FAILED",
-			Err:     err,
-		})
-		if ackErr := AckMetadataJob(ctx, w.redis, *job); ackErr != nil {
+	var metadata map[string]interface{}
+	if !job.ForceRefresh {
+		cached, cacheErr := GetCachedLinkMetadata(ctx, w.redis, sectionType, job.URL)
+		if cacheErr != nil {
+			observability.LogWarn(ctx, "failed to read cached link metadata",
+				"link_id", job.LinkID.String(),
+				"error", cacheErr.Error(),
+			)
+		}
+		metadata = cached
+	}
+
+	if metadata == nil {
+		fetched, err := w.fetcher.Fetch(fetchCtx, job.URL)
+		if err != nil {
 			observability.LogError(ctx, observability.ErrorLog{
-				Message: "failed to acknowledge metadata job after fetch failure",
-				Code:    "METADATA_ACK_FAILED",
-				Err:     ackErr,
+				Message: "failed to fetch link metadata",
+				Code:    "METADATA_FETCH_FAILED",
+				Err:     err,
 			})
+			w.deadLetterJob(ctx, job)
+			if ackErr := AckMetadataJob(ctx, w.redis, *job); ackErr != nil {
+				observability.LogError(ctx, observability.ErrorLog{
+					Message: "failed to acknowledge metadata job after fetch failure",
+					Code:    "METADATA_ACK_FAILED",
+					Err:     ackErr,
+				})
+			}
+			return
+		}
+		metadata = fetched
+
+		if err := CacheLinkMetadata(ctx, w.redis, sectionType, job.URL, metadata); err != nil {
+			observability.LogWarn(ctx, "failed to cache link metadata",
+				"link_id", job.LinkID.String(),
+				"error", err.Error(),
+			)
 		}
-		return
 	}
 
 	if err := w.updateLinkMetadata(ctx, job.LinkID, metadata); err != nil {
@@ -161,6 +184,7 @@ func (w *MetadataWorker) processJob(ctx context.Context, job *MetadataJob, worke
 			Code:    "METADATA_UPDATE_FAILED",
 			Err:     err,
 		})
+		w.deadLetterJob(ctx, job)
 		if ackErr := AckMetadataJob(ctx, w.redis, *job); ackErr != nil {
 			observability.LogError(ctx, observability.ErrorLog{
 				Message: "failed to acknowledge metadata job after update failure",
@@ -186,6 +210,13 @@ func (w *MetadataWorker) processJob(ctx context.Context, job *MetadataJob, worke
 		"post_id", job.PostID.String(),
 		"link_id", job.LinkID.String())
 
+	if err := RecordMetadataJobSuccess(ctx, w.redis, time.Now()); err != nil {
+		observability.LogWarn(ctx, "failed to record metadata job success",
+			"link_id", job.LinkID.String(),
+			"error", err.Error(),
+		)
+	}
+
 	if err := AckMetadataJob(ctx, w.redis, *job); err != nil {
 		observability.LogError(ctx, observability.ErrorLog{
 			Message: "failed to acknowledge completed metadata job",
@@ -195,6 +226,15 @@ func (w *MetadataWorker) processJob(ctx context.Context, job *MetadataJob, worke
 	}
 }
 
+func (w *MetadataWorker) deadLetterJob(ctx context.Context, job *MetadataJob) {
+	if err := DeadLetterMetadataJob(ctx, w.redis, *job); err != nil {
+		observability.LogWarn(ctx, "failed to dead-letter metadata job",
+			"link_id", job.LinkID.String(),
+			"error", err.Error(),
+		)
+	}
+}
+
 func (w *MetadataWorker) getPostSectionContext(ctx context.Context, postID uuid.UUID) (uuid.UUID, string, error) {
 	var sectionID uuid.UUID
 	var sectionType string
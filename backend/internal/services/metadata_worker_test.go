@@ -172,6 +172,55 @@ func TestMetadataWorker_ProcessJob(t *testing.T) {
 	assert.Equal(t, int64(0), processingLen)
 }
 
+func TestMetadataWorker_UsesCachedMetadataUnlessForced(t *testing.T) {
+	rdb := setupMetadataWorkerTestRedis(t)
+	db := setupMetadataWorkerTestDB(t)
+	ctx := context.Background()
+
+	userID := testutil.CreateTestUser(t, db, "cacheuser", "cache@example.com", false, true)
+	sectionID := testutil.CreateTestSection(t, db, "Cache Section", "general")
+	postID := testutil.CreateTestPost(t, db, userID, sectionID, "Cached post")
+	url := "https://example.com/cached"
+	cachedLinkID := createTestLink(t, db, postID, url)
+	forcedLinkID := createTestLink(t, db, postID, url)
+
+	require.NoError(t, CacheLinkMetadata(ctx, rdb, "general", url, map[string]interface{}{"title": "Cached Title"}))
+
+	fetcher := &mockMetadataFetcher{
+		metadata: map[string]interface{}{"title": "Fresh Title"},
+	}
+	worker := NewMetadataWorker(rdb, db, fetcher, 1)
+
+	worker.processJob(ctx, &MetadataJob{
+		PostID:    uuid.MustParse(postID),
+		LinkID:    uuid.MustParse(cachedLinkID),
+		URL:       url,
+		CreatedAt: time.Now(),
+	}, 0)
+	assert.Equal(t, 0, fetcher.called)
+
+	worker.processJob(ctx, &MetadataJob{
+		PostID:       uuid.MustParse(postID),
+		LinkID:       uuid.MustParse(forcedLinkID),
+		URL:          url,
+		CreatedAt:    time.Now(),
+		ForceRefresh: true,
+	}, 0)
+	assert.Equal(t, 1, fetcher.called)
+
+	var cachedMetadata string
+	require.NoError(t, db.QueryRow("SELECT metadata FROM links WHERE id = $1", cachedLinkID).Scan(&cachedMetadata))
+	assert.Contains(t, cachedMetadata, "Cached Title")
+
+	var forcedMetadata string
+	require.NoError(t, db.QueryRow("SELECT metadata FROM links WHERE id = $1", forcedLinkID).Scan(&forcedMetadata))
+	assert.Contains(t, forcedMetadata, "Fresh Title")
+
+	refreshed, err := GetCachedLinkMetadata(ctx, rdb, "general", url)
+	require.NoError(t, err)
+	assert.Equal(t, "Fresh Title", refreshed["title"])
+}
+
 func TestMetadataWorker_StoresMovieScoresInMetadataJSONB(t *testing.T) {
 	rdb := setupMetadataWorkerTestRedis(t)
 	db := setupMetadataWorkerTestDB(t)
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/redis/go-redis/v9"
 
 	"github.com/sanderginn/clubhouse/internal/models"
@@ -24,8 +25,14 @@ const (
 	notificationTypeNewComment              = "new_comment"
 	notificationTypeMention                 = "mention"
 	notificationTypeReaction                = "reaction"
+	notificationTypePostRemoved             = "post_removed"
 	notificationTypeUserRegistrationPending = "user_registration_pending"
 	notificationExcerptLimit                = 100
+	digestNotificationLimit                 = 20
+	digestPostsPerSection                   = 5
+	maxNotificationStateIDs                 = 100
+	// DefaultDigestWindow is the digest window used when none is provided.
+	DefaultDigestWindow = 24 * time.Hour
 )
 
 // NotificationService handles notification creation.
@@ -255,7 +262,9 @@ func (s *NotificationService) CreateNotificationForPostReaction(ctx context.Cont
 	return nil
 }
 
-// CreateNotificationForCommentReaction notifies a comment owner about a reaction.
+// CreateNotificationForCommentReaction notifies a comment owner about a reaction, unless they turned
+// comment reaction notifications off. Repeat reactions from the same user are coalesced into the
+// owner's existing unread notification for the comment.
 func (s *NotificationService) CreateNotificationForCommentReaction(ctx context.Context, commentID, reactorID uuid.UUID) error {
 	ctx, span := otel.Tracer("clubhouse.notifications").Start(ctx, "NotificationService.CreateNotificationForCommentReaction")
 	span.SetAttributes(
@@ -286,10 +295,37 @@ func (s *NotificationService) CreateNotificationForCommentReaction(ctx context.C
 		return nil
 	}
 
-	if err := s.insertNotification(ctx, commentOwnerID, notificationTypeReaction, &postID, &commentID, &reactorID); err != nil {
+	var enabled bool
+	if err := s.db.QueryRowContext(ctx, `
+		SELECT comment_reaction_notifications FROM users WHERE id = $1
+	`, commentOwnerID).Scan(&enabled); err != nil {
 		recordSpanError(span, err)
-		return err
+		return fmt.Errorf("failed to get comment reaction preference: %w", err)
+	}
+	span.SetAttributes(attribute.Bool("enabled", enabled))
+	if !enabled {
+		return nil
+	}
+
+	// A partial unique index allows one unread notification per comment and reactor, so
+	// concurrent repeats are dropped by the insert itself.
+	var notificationID uuid.UUID
+	err = s.db.QueryRowContext(ctx, `
+		INSERT INTO notifications (user_id, type, related_post_id, related_comment_id, related_user_id)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT DO NOTHING
+		RETURNING id
+	`, commentOwnerID, notificationTypeReaction, postID, commentID, reactorID).Scan(&notificationID)
+	span.SetAttributes(attribute.Bool("coalesced", errors.Is(err, sql.ErrNoRows)))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		recordSpanError(span, err)
+		return fmt.Errorf("failed to insert notification: %w", err)
 	}
+
+	s.deliverNotification(ctx, notificationID, commentOwnerID, notificationTypeReaction, &postID, &commentID, &reactorID)
 	return nil
 }
 
@@ -305,11 +341,15 @@ func (s *NotificationService) insertNotification(ctx context.Context, userID uui
 		return fmt.Errorf("failed to insert notification: %w", err)
 	}
 
+	s.deliverNotification(ctx, notificationID, userID, notificationType, postID, commentID, relatedUserID)
+	return nil
+}
+
+// deliverNotification records and pushes a notification that was just inserted.
+func (s *NotificationService) deliverNotification(ctx context.Context, notificationID uuid.UUID, userID uuid.UUID, notificationType string, postID *uuid.UUID, commentID *uuid.UUID, relatedUserID *uuid.UUID) {
 	observability.RecordNotificationsCreated(ctx, notificationType, 1)
 	s.sendPush(ctx, userID, notificationType, postID, commentID, relatedUserID)
 	s.publishRealtimeNotification(ctx, userID, notificationID)
-
-	return nil
 }
 
 func (s *NotificationService) sendPush(ctx context.Context, userID uuid.UUID, notificationType string, postID *uuid.UUID, commentID *uuid.UUID, relatedUserID *uuid.UUID) {
@@ -568,7 +608,7 @@ func (s *NotificationService) GetNotifications(ctx context.Context, userID uuid.
 	query := `
 		SELECT n.id, n.user_id, n.type, n.related_post_id, n.related_comment_id, n.related_user_id, n.read_at, n.created_at,
 		       ru.username, ru.profile_picture_url,
-		       COALESCE(c.content, p.content) AS content
+		       COALESCE(c.content, p.content, n.metadata->>'post_excerpt') AS content
 		FROM notifications n
 		LEFT JOIN users ru ON ru.id = n.related_user_id AND ru.deleted_at IS NULL
 		LEFT JOIN comments c ON c.id = n.related_comment_id AND c.deleted_at IS NULL
@@ -818,6 +858,233 @@ func (s *NotificationService) MarkAllNotificationsRead(ctx context.Context, user
 	return updatedCount, unreadCount, nil
 }
 
+// GetNotificationReadStates returns the read state of the given notifications that belong to the user.
+// Unknown IDs and notifications owned by other users are omitted.
+func (s *NotificationService) GetNotificationReadStates(ctx context.Context, userID uuid.UUID, notificationIDs []uuid.UUID) ([]models.NotificationReadState, error) {
+	ctx, span := otel.Tracer("clubhouse.notifications").Start(ctx, "NotificationService.GetNotificationReadStates")
+	span.SetAttributes(
+		attribute.String("user_id", userID.String()),
+		attribute.Int("requested_count", len(notificationIDs)),
+	)
+	defer span.End()
+
+	states := make([]models.NotificationReadState, 0, len(notificationIDs))
+	if len(notificationIDs) == 0 {
+		return states, nil
+	}
+	if len(notificationIDs) > maxNotificationStateIDs {
+		err := fmt.Errorf("too many notification ids")
+		recordSpanError(span, err)
+		return nil, err
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id, read_at
+		FROM notifications
+		WHERE user_id = $1 AND id = ANY($2)
+		ORDER BY created_at DESC, id DESC
+	`, userID, pq.Array(notificationIDs))
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, fmt.Errorf("failed to query notification states: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var state models.NotificationReadState
+		var readAt sql.NullTime
+		if err := rows.Scan(&state.ID, &readAt); err != nil {
+			recordSpanError(span, err)
+			return nil, fmt.Errorf("failed to scan notification state: %w", err)
+		}
+		if readAt.Valid {
+			state.Read = true
+			state.ReadAt = &readAt.Time
+		}
+		states = append(states, state)
+	}
+	if err := rows.Err(); err != nil {
+		recordSpanError(span, err)
+		return nil, fmt.Errorf("failed to iterate notification states: %w", err)
+	}
+
+	span.SetAttributes(attribute.Int("found_count", len(states)))
+	return states, nil
+}
+
+// BuildDigest summarizes a user's unread notifications, unread mentions, and new posts in
+// subscribed sections created within the window ending now. Mentions are listed and counted
+// separately from the other unread notifications.
+func (s *NotificationService) BuildDigest(ctx context.Context, userID uuid.UUID, window time.Duration) (*models.NotificationDigest, error) {
+	ctx, span := otel.Tracer("clubhouse.notifications").Start(ctx, "NotificationService.BuildDigest")
+	if window <= 0 {
+		window = DefaultDigestWindow
+	}
+	span.SetAttributes(
+		attribute.String("user_id", userID.String()),
+		attribute.Int64("window_seconds", int64(window/time.Second)),
+	)
+	defer span.End()
+
+	windowEnd := time.Now().UTC()
+	windowStart := windowEnd.Add(-window)
+
+	digest := &models.NotificationDigest{
+		UserID:              userID,
+		WindowStart:         windowStart,
+		WindowEnd:           windowEnd,
+		UnreadNotifications: []models.Notification{},
+		Mentions:            []models.Notification{},
+		Sections:            []models.DigestSectionUpdate{},
+	}
+
+	if err := s.db.QueryRowContext(ctx, `
+		SELECT COUNT(*)
+		FROM notifications
+		WHERE user_id = $1 AND read_at IS NULL AND created_at >= $2 AND created_at < $3 AND type <> $4
+	`, userID, windowStart, windowEnd, notificationTypeMention).Scan(&digest.UnreadNotificationCount); err != nil {
+		recordSpanError(span, err)
+		return nil, fmt.Errorf("failed to count digest notifications: %w", err)
+	}
+
+	unread, err := s.listDigestNotifications(ctx, userID, windowStart, windowEnd, false)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+	digest.UnreadNotifications = unread
+
+	mentions, err := s.listDigestNotifications(ctx, userID, windowStart, windowEnd, true)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+	digest.Mentions = mentions
+
+	sections, newPostCount, err := s.listDigestSectionPosts(ctx, userID, windowStart, windowEnd)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+	digest.Sections = sections
+	digest.NewPostCount = newPostCount
+
+	span.SetAttributes(
+		attribute.Int("unread_count", digest.UnreadNotificationCount),
+		attribute.Int("mention_count", len(digest.Mentions)),
+		attribute.Int("new_post_count", digest.NewPostCount),
+	)
+
+	return digest, nil
+}
+
+func (s *NotificationService) listDigestNotifications(ctx context.Context, userID uuid.UUID, windowStart, windowEnd time.Time, mentions bool) ([]models.Notification, error) {
+	typeFilter := "n.type <> $4"
+	if mentions {
+		typeFilter = "n.type = $4"
+	}
+
+	query := `
+		SELECT n.id, n.user_id, n.type, n.related_post_id, n.related_comment_id, n.related_user_id, n.read_at, n.created_at,
+		       ru.username, ru.profile_picture_url,
+		       COALESCE(c.content, p.content, n.metadata->>'post_excerpt') AS content
+		FROM notifications n
+		LEFT JOIN users ru ON ru.id = n.related_user_id AND ru.deleted_at IS NULL
+		LEFT JOIN comments c ON c.id = n.related_comment_id AND c.deleted_at IS NULL
+		LEFT JOIN posts p ON p.id = n.related_post_id AND p.deleted_at IS NULL
+		WHERE n.user_id = $1
+		  AND n.read_at IS NULL
+		  AND n.created_at >= $2 AND n.created_at < $3
+		  AND ` + typeFilter + `
+		ORDER BY n.created_at DESC, n.id DESC
+		LIMIT $5
+	`
+
+	rows, err := s.db.QueryContext(ctx, query, userID, windowStart, windowEnd, notificationTypeMention, digestNotificationLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query digest notifications: %w", err)
+	}
+	defer rows.Close()
+
+	notifications := make([]models.Notification, 0)
+	for rows.Next() {
+		notification, err := scanNotificationRow(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan digest notification: %w", err)
+		}
+		notifications = append(notifications, *notification)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating digest notifications: %w", err)
+	}
+
+	return notifications, nil
+}
+
+func (s *NotificationService) listDigestSectionPosts(ctx context.Context, userID uuid.UUID, windowStart, windowEnd time.Time) ([]models.DigestSectionUpdate, int, error) {
+	query := `
+		SELECT id, content, created_at, section_id, section_name, section_type,
+		       author_id, author_username, author_profile_picture_url, total_count
+		FROM (
+			SELECT p.id, p.content, p.created_at,
+			       s.id AS section_id, s.name AS section_name, s.type AS section_type,
+			       u.id AS author_id, u.username AS author_username, u.profile_picture_url AS author_profile_picture_url,
+			       ROW_NUMBER() OVER (PARTITION BY p.section_id ORDER BY p.created_at DESC, p.id DESC) AS section_rank,
+			       COUNT(*) OVER () AS total_count
+			FROM posts p
+			JOIN sections s ON s.id = p.section_id
+			JOIN users u ON u.id = p.user_id
+			WHERE p.deleted_at IS NULL
+			  AND p.user_id <> $1
+			  AND p.created_at >= $2 AND p.created_at < $3
+			  AND NOT EXISTS (
+					SELECT 1 FROM section_subscriptions ss
+					WHERE ss.user_id = $1 AND ss.section_id = p.section_id
+			  )
+		) ranked
+		WHERE section_rank <= $4
+		ORDER BY section_name ASC, section_id, created_at DESC, id DESC
+	`
+
+	rows, err := s.db.QueryContext(ctx, query, userID, windowStart, windowEnd, digestPostsPerSection)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query digest posts: %w", err)
+	}
+	defer rows.Close()
+
+	sections := make([]models.DigestSectionUpdate, 0)
+	totalCount := 0
+	for rows.Next() {
+		var post models.DigestPost
+		var content string
+		var section models.DigestSectionUpdate
+		var profilePicture sql.NullString
+		if err := rows.Scan(
+			&post.ID, &content, &post.CreatedAt,
+			&section.SectionID, &section.SectionName, &section.SectionType,
+			&post.Author.ID, &post.Author.Username, &profilePicture, &totalCount,
+		); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan digest post: %w", err)
+		}
+		if profilePicture.Valid {
+			post.Author.ProfilePictureURL = &profilePicture.String
+		}
+		post.ContentExcerpt = truncateNotificationExcerpt(content)
+
+		if len(sections) == 0 || sections[len(sections)-1].SectionID != section.SectionID {
+			section.Posts = []models.DigestPost{}
+			sections = append(sections, section)
+		}
+		last := &sections[len(sections)-1]
+		last.Posts = append(last.Posts, post)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating digest posts: %w", err)
+	}
+
+	return sections, totalCount, nil
+}
+
 type notificationScanner interface {
 	Scan(dest ...any) error
 }
@@ -896,7 +1163,7 @@ func (s *NotificationService) getNotificationDetails(ctx context.Context, userID
 	query := `
 		SELECT n.id, n.user_id, n.type, n.related_post_id, n.related_comment_id, n.related_user_id, n.read_at, n.created_at,
 		       ru.username, ru.profile_picture_url,
-		       COALESCE(c.content, p.content) AS content
+		       COALESCE(c.content, p.content, n.metadata->>'post_excerpt') AS content
 		FROM notifications n
 		LEFT JOIN users ru ON ru.id = n.related_user_id AND ru.deleted_at IS NULL
 		LEFT JOIN comments c ON c.id = n.related_comment_id AND c.deleted_at IS NULL
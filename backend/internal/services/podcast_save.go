@@ -189,9 +189,10 @@ func (s *PodcastSaveService) GetPostPodcastSaveInfo(ctx context.Context, postID
 		FROM podcast_saves ps
 		JOIN users u ON ps.user_id = u.id
 		WHERE ps.post_id = $1 AND ps.deleted_at IS NULL
+			AND (u.activity_private = false OR u.id = $2)
 		GROUP BY u.id, u.username, u.profile_picture_url
 		ORDER BY first_saved ASC
-	`, postID)
+	`, postID, uuidPointerValue(viewerID))
 	if err != nil {
 		recordSpanError(span, err)
 		return nil, fmt.Errorf("failed to query podcast save users: %w", err)
@@ -125,6 +125,46 @@ func TestGetPostPodcastSaveInfoIncludesViewerAndAggregate(t *testing.T) {
 	}
 }
 
+func TestGetPostPodcastSaveInfoHidesPrivateUsers(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	viewerID := uuid.MustParse(testutil.CreateTestUser(t, db, "podcastpublicviewer", "podcastpublicviewer@test.com", false, true))
+	privateUserID := uuid.MustParse(testutil.CreateTestUser(t, db, "podcastprivateuser", "podcastprivateuser@test.com", false, true))
+	sectionID := testutil.CreateTestSection(t, db, "Podcasts", "podcast")
+	postID := uuid.MustParse(testutil.CreateTestPost(t, db, viewerID.String(), sectionID, "Podcast post"))
+
+	if _, err := db.Exec(`UPDATE users SET activity_private = true WHERE id = $1`, privateUserID); err != nil {
+		t.Fatalf("failed to mark user private: %v", err)
+	}
+
+	service := NewPodcastSaveService(db)
+	for _, userID := range []uuid.UUID{viewerID, privateUserID} {
+		if _, err := service.SavePodcast(context.Background(), userID, postID); err != nil {
+			t.Fatalf("SavePodcast failed: %v", err)
+		}
+	}
+
+	info, err := service.GetPostPodcastSaveInfo(context.Background(), postID, &viewerID)
+	if err != nil {
+		t.Fatalf("GetPostPodcastSaveInfo failed: %v", err)
+	}
+	if info.SaveCount != 2 {
+		t.Fatalf("expected save count 2, got %d", info.SaveCount)
+	}
+	if len(info.Users) != 1 || info.Users[0].ID != viewerID {
+		t.Fatalf("expected only the public viewer in users, got %+v", info.Users)
+	}
+
+	info, err = service.GetPostPodcastSaveInfo(context.Background(), postID, nil)
+	if err != nil {
+		t.Fatalf("GetPostPodcastSaveInfo failed: %v", err)
+	}
+	if len(info.Users) != 1 {
+		t.Fatalf("expected anonymous viewers to see only public users, got %d users", len(info.Users))
+	}
+}
+
 func TestListSectionSavedPodcastPostsPaginatesAndGuardsSectionType(t *testing.T) {
 	db := testutil.RequireTestDB(t)
 	t.Cleanup(func() { testutil.CleanupTables(t, db) })
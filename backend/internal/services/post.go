@@ -20,6 +20,7 @@ import (
 	linkmeta "github.com/sanderginn/clubhouse/internal/services/links"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // PostService handles post-related operations
@@ -28,7 +29,12 @@ type PostService struct {
 	redis *redis.Client
 }
 
-const maxPostImages = 10
+const (
+	maxPostImages = 10
+
+	defaultMoreFromAuthorLimit = 5
+	maxMoreFromAuthorLimit     = 20
+)
 
 var imageLinkPattern = regexp.MustCompile(`(?i)\.(jpg|jpeg|png|gif|webp|bmp|svg|avif|tif|tiff)(?:$|[?#&])`)
 
@@ -74,8 +80,8 @@ func (s *PostService) CreatePost(ctx context.Context, req *models.CreatePostRequ
 	)
 	defer span.End()
 
-	// Validate input
-	if err := validateCreatePostInput(req); err != nil {
+	if strings.TrimSpace(req.SectionID) == "" {
+		err := fmt.Errorf("section_id is required")
 		recordSpanError(span, err)
 		return nil, err
 	}
@@ -101,6 +107,12 @@ func (s *PostService) CreatePost(ctx context.Context, req *models.CreatePostRequ
 		return nil, fmt.Errorf("section not found")
 	}
 
+	// Validate input
+	if err := validateCreatePostInput(req, sectionType); err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+
 	resolvedLinks := req.Links
 	if shouldDetectPodcastKinds(resolvedLinks) {
 		detectionHints := fetchLinkMetadata(ctx, resolvedLinks, sectionType)
@@ -166,7 +178,23 @@ func (s *PostService) CreatePost(ctx context.Context, req *models.CreatePostRequ
 		for _, linkReq := range resolvedLinks {
 			linkID := uuid.New()
 
-			mergedMetadata, sortedHighlights, podcast := mergeHighlightsIntoMetadata(linkReq, nil)
+			// Reuse fresh metadata fetched for another post with the same URL instead of queueing a fetch
+			var cachedMetadata models.JSONMap
+			needsMetadataJob := shouldEnqueueMetadataJobs && !linkmeta.IsInternalUploadURL(linkReq.URL)
+			if needsMetadataJob {
+				cached, cacheErr := GetCachedLinkMetadata(ctx, s.redis, sectionType, linkReq.URL)
+				if cacheErr != nil {
+					observability.LogWarn(ctx, "failed to read cached link metadata",
+						"link_url", linkReq.URL,
+						"error", cacheErr.Error(),
+					)
+				} else if cached != nil {
+					cachedMetadata = models.JSONMap(cached)
+					needsMetadataJob = false
+				}
+			}
+
+			mergedMetadata, sortedHighlights, podcast := mergeHighlightsIntoMetadata(linkReq, cachedMetadata)
 			metadataValue := interface{}(nil)
 			if len(mergedMetadata) > 0 {
 				metadataValue = mergedMetadata
@@ -192,7 +220,7 @@ func (s *PostService) CreatePost(ctx context.Context, req *models.CreatePostRequ
 				link.Metadata = stripHighlightsFromMetadata(meta)
 			}
 			if len(sortedHighlights) > 0 {
-				link.Highlights = sortedHighlights
+				link.Highlights = formatHighlightTimestamps(sortedHighlights)
 			}
 			if podcast != nil {
 				link.Podcast = podcast
@@ -200,7 +228,7 @@ func (s *PostService) CreatePost(ctx context.Context, req *models.CreatePostRequ
 
 			post.Links = append(post.Links, link)
 
-			if shouldEnqueueMetadataJobs && !linkmeta.IsInternalUploadURL(linkReq.URL) {
+			if needsMetadataJob {
 				jobs = append(jobs, MetadataJob{
 					PostID:    post.ID,
 					LinkID:    linkID,
@@ -266,19 +294,9 @@ func (s *PostService) CreatePost(ctx context.Context, req *models.CreatePostRequ
 		post.MovieStats = &models.MovieStats{}
 	}
 
-	for _, job := range jobs {
-		enqueueCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-		if err := EnqueueMetadataJob(enqueueCtx, s.redis, job); err != nil {
-			observability.LogWarn(ctx, "failed to enqueue metadata job",
-				"post_id", job.PostID.String(),
-				"link_id", job.LinkID.String(),
-				"link_url", job.URL,
-				"error", err.Error(),
-			)
-		}
-		cancel()
-	}
+	s.enqueueMetadataJobs(ctx, jobs)
 
+	applyPostDisplayFields(&post, sectionType)
 	observability.RecordPostCreated(ctx, sectionName)
 	return &post, nil
 }
@@ -313,6 +331,8 @@ func (s *PostService) UpdatePost(ctx context.Context, postID uuid.UUID, userID u
 	var resolvedLinks []models.LinkRequest
 	var existingLinks []models.Link
 	var removedLink *models.Link
+	shouldEnqueueMetadataJobs := s.redis != nil && GetConfigService().IsLinkMetadataEnabled()
+	var jobs []MetadataJob
 
 	var ownerID uuid.UUID
 	var previousContent string
@@ -340,6 +360,11 @@ func (s *PostService) UpdatePost(ctx context.Context, postID uuid.UUID, userID u
 		return nil, unauthorizedErr
 	}
 
+	if err := validateUpdatePostForSection(req, sectionType); err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+
 	if req.Links != nil || req.RemoveLinkMetadata {
 		var err error
 		existingLinks, err = s.getPostLinks(ctx, postID, uuid.Nil)
@@ -405,7 +430,7 @@ func (s *PostService) UpdatePost(ctx context.Context, postID uuid.UUID, userID u
 		if linksChanged && len(resolvedLinks) > 0 {
 			if len(detectionMetadata) > 0 {
 				linkMetadata = detectionMetadata
-			} else {
+			} else if !shouldEnqueueMetadataJobs {
 				linkMetadata = fetchLinkMetadata(ctx, resolvedLinks, sectionType)
 			}
 		}
@@ -422,6 +447,19 @@ func (s *PostService) UpdatePost(ctx context.Context, postID uuid.UUID, userID u
 		imagesChanged = !postImageRequestsMatchEntries(existingImages, normalizedImages)
 	}
 
+	if IsMediaRequiredForSectionType(sectionType) {
+		keepsMedia, err := s.updateKeepsMedia(ctx, postID, req, resolvedLinks, existingLinks, removedLink, normalizedImages)
+		if err != nil {
+			recordSpanError(span, err)
+			return nil, err
+		}
+		if !keepsMedia {
+			mediaErr := errors.New("media is required for this section")
+			recordSpanError(span, mediaErr)
+			return nil, mediaErr
+		}
+	}
+
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		recordSpanError(span, err)
@@ -433,9 +471,10 @@ func (s *PostService) UpdatePost(ctx context.Context, postID uuid.UUID, userID u
 
 	_, err = tx.ExecContext(ctx, `
 		UPDATE posts
-		SET content = $1, updated_at = now()
+		SET content = $1,
+			updated_at = CASE WHEN created_at > now() - make_interval(secs => $3) THEN updated_at ELSE now() END
 		WHERE id = $2
-	`, trimmedContent, postID)
+	`, trimmedContent, postID, editGracePeriodSeconds())
 	if err != nil {
 		recordSpanError(span, err)
 		return nil, fmt.Errorf("failed to update post: %w", err)
@@ -448,12 +487,34 @@ func (s *PostService) UpdatePost(ctx context.Context, postID uuid.UUID, userID u
 		}
 
 		if len(resolvedLinks) > 0 {
+			existingMetadataByURL := make(map[string]models.JSONMap, len(existingLinks))
+			for _, link := range existingLinks {
+				existingMetadataByURL[link.URL] = models.JSONMap(link.Metadata)
+			}
+
 			for i, linkReq := range resolvedLinks {
 				linkID := uuid.New()
 
 				var fetchedMetadata models.JSONMap
+				needsMetadataJob := false
 				if len(linkMetadata) > i && len(linkMetadata[i]) > 0 {
 					fetchedMetadata = linkMetadata[i]
+				} else if existingMetadata, ok := existingMetadataByURL[linkReq.URL]; ok {
+					// Unchanged URLs keep their metadata; only new URLs need a fetch
+					fetchedMetadata = existingMetadata
+				} else if shouldEnqueueMetadataJobs && !linkmeta.IsInternalUploadURL(linkReq.URL) {
+					cached, cacheErr := GetCachedLinkMetadata(ctx, s.redis, sectionType, linkReq.URL)
+					if cacheErr != nil {
+						observability.LogWarn(ctx, "failed to read cached link metadata",
+							"link_url", linkReq.URL,
+							"error", cacheErr.Error(),
+						)
+					}
+					if cached != nil {
+						fetchedMetadata = models.JSONMap(cached)
+					} else {
+						needsMetadataJob = true
+					}
 				}
 
 				mergedMetadata, _, _ := mergeHighlightsIntoMetadata(linkReq, fetchedMetadata)
@@ -470,6 +531,15 @@ func (s *PostService) UpdatePost(ctx context.Context, postID uuid.UUID, userID u
 					recordSpanError(span, err)
 					return nil, fmt.Errorf("failed to create link: %w", err)
 				}
+
+				if needsMetadataJob {
+					jobs = append(jobs, MetadataJob{
+						PostID:    postID,
+						LinkID:    linkID,
+						URL:       linkReq.URL,
+						CreatedAt: time.Now(),
+					})
+				}
 			}
 		}
 	}
@@ -551,9 +621,28 @@ func (s *PostService) UpdatePost(ctx context.Context, postID uuid.UUID, userID u
 		return nil, fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
+	s.enqueueMetadataJobs(ctx, jobs)
+
 	return s.GetPostByID(ctx, postID, userID)
 }
 
+// enqueueMetadataJobs queues link metadata fetches after a post write has committed.
+// Failures are logged rather than returned so the write itself still succeeds.
+func (s *PostService) enqueueMetadataJobs(ctx context.Context, jobs []MetadataJob) {
+	for _, job := range jobs {
+		enqueueCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		if err := EnqueueMetadataJob(enqueueCtx, s.redis, job); err != nil {
+			observability.LogWarn(ctx, "failed to enqueue metadata job",
+				"post_id", job.PostID.String(),
+				"link_id", job.LinkID.String(),
+				"link_url", job.URL,
+				"error", err.Error(),
+			)
+		}
+		cancel()
+	}
+}
+
 // GetPostByID retrieves a post by ID with all related data
 func (s *PostService) GetPostByID(ctx context.Context, postID uuid.UUID, userID uuid.UUID) (*models.Post, error) {
 	ctx, span := otel.Tracer("clubhouse.posts").Start(ctx, "PostService.GetPostByID")
@@ -608,6 +697,7 @@ func (s *PostService) GetPostByID(ctx context.Context, postID uuid.UUID, userID
 		return nil, err
 	}
 	post.Links = links
+	applyPostDisplayFields(&post, sectionType)
 
 	// Fetch images for this post
 	images, err := s.getPostImages(ctx, postID)
@@ -649,16 +739,365 @@ func (s *PostService) GetPostByID(ctx context.Context, postID uuid.UUID, userID
 		post.BookStats = bookStats
 	}
 
-	if isMovieOrSeriesSectionType(sectionType) {
-		movieStats, err := s.getMovieStats(ctx, postID, viewerID)
-		if err != nil {
-			recordSpanError(span, err)
-			return nil, err
+	if isMovieOrSeriesSectionType(sectionType) {
+		movieStats, err := s.getMovieStats(ctx, postID, viewerID)
+		if err != nil {
+			recordSpanError(span, err)
+			return nil, err
+		}
+		post.MovieStats = movieStats
+	}
+
+	return &post, nil
+}
+
+// GetPostsByIDs loads several posts with the same details as GetPostByID, keyed by post ID.
+// Deleted or missing posts are left out of the result.
+func (s *PostService) GetPostsByIDs(ctx context.Context, postIDs []uuid.UUID, viewerID uuid.UUID) (map[uuid.UUID]*models.Post, error) {
+	ctx, span := otel.Tracer("clubhouse.posts").Start(ctx, "PostService.GetPostsByIDs")
+	span.SetAttributes(
+		attribute.Int("post_count", len(postIDs)),
+		attribute.String("viewer_id", viewerID.String()),
+	)
+	defer span.End()
+
+	postsByID := make(map[uuid.UUID]*models.Post, len(postIDs))
+	if len(postIDs) == 0 {
+		return postsByID, nil
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT
+			p.id, p.user_id, p.section_id, p.content,
+			p.created_at, p.updated_at, p.deleted_at, p.deleted_by_user_id,
+			u.id, u.username, COALESCE(u.email, '') as email, u.profile_picture_url, u.bio, u.is_admin, u.created_at,
+			COALESCE(COUNT(DISTINCT c.id), 0) as comment_count,
+			s.type
+		FROM posts p
+		JOIN users u ON p.user_id = u.id
+		JOIN sections s ON p.section_id = s.id
+		LEFT JOIN comments c ON p.id = c.post_id AND c.deleted_at IS NULL
+		WHERE p.id = ANY($1) AND p.deleted_at IS NULL
+		GROUP BY p.id, u.id, s.type
+	`, pq.Array(postIDs))
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var recipePostIDs []uuid.UUID
+	var bookPostIDs []uuid.UUID
+	var moviePostIDs []uuid.UUID
+	for rows.Next() {
+		var post models.Post
+		var user models.User
+		var sectionType string
+
+		if err := rows.Scan(
+			&post.ID, &post.UserID, &post.SectionID, &post.Content,
+			&post.CreatedAt, &post.UpdatedAt, &post.DeletedAt, &post.DeletedByUserID,
+			&user.ID, &user.Username, &user.Email, &user.ProfilePictureURL, &user.Bio, &user.IsAdmin, &user.CreatedAt,
+			&post.CommentCount, &sectionType,
+		); err != nil {
+			recordSpanError(span, err)
+			return nil, err
+		}
+
+		post.User = &user
+		post.SectionType = sectionType
+
+		switch {
+		case sectionType == "recipe":
+			recipePostIDs = append(recipePostIDs, post.ID)
+		case sectionType == "book":
+			bookPostIDs = append(bookPostIDs, post.ID)
+		case isMovieOrSeriesSectionType(sectionType):
+			moviePostIDs = append(moviePostIDs, post.ID)
+		}
+
+		postsByID[post.ID] = &post
+	}
+	if err := rows.Err(); err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+
+	foundIDs := make([]uuid.UUID, 0, len(postsByID))
+	for postID := range postsByID {
+		foundIDs = append(foundIDs, postID)
+	}
+	linksByPost, err := s.getPostLinksForPosts(ctx, foundIDs, viewerID)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+	imagesByPost, err := s.getPostImagesForPosts(ctx, foundIDs)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+	reactionsByPost, err := s.getPostReactionsForPosts(ctx, foundIDs, viewerID)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+	for postID, post := range postsByID {
+		post.Links = linksByPost[postID]
+		applyPostDisplayFields(post, post.SectionType)
+		post.Images = imagesByPost[postID]
+		post.ReactionCounts = reactionsByPost[postID].counts
+		post.ViewerReactions = reactionsByPost[postID].viewerReactions
+	}
+
+	viewerIDPtr := &viewerID
+	if viewerID == uuid.Nil {
+		viewerIDPtr = nil
+	}
+
+	if len(recipePostIDs) > 0 {
+		statsByPost, err := s.getRecipeStatsForPosts(ctx, recipePostIDs, viewerIDPtr)
+		if err != nil {
+			recordSpanError(span, err)
+			return nil, err
+		}
+		for postID, stat := range statsByPost {
+			if post, ok := postsByID[postID]; ok {
+				post.RecipeStats = stat
+			}
+		}
+	}
+
+	if len(bookPostIDs) > 0 {
+		statsByPost, err := s.getBookStatsForPosts(ctx, bookPostIDs, viewerIDPtr)
+		if err != nil {
+			recordSpanError(span, err)
+			return nil, err
+		}
+		for postID, stat := range statsByPost {
+			if post, ok := postsByID[postID]; ok {
+				post.BookStats = stat
+			}
+		}
+	}
+
+	if len(moviePostIDs) > 0 {
+		statsByPost, err := s.getMovieStatsForPosts(ctx, moviePostIDs, viewerIDPtr)
+		if err != nil {
+			recordSpanError(span, err)
+			return nil, err
+		}
+		for postID, stat := range statsByPost {
+			if post, ok := postsByID[postID]; ok {
+				post.MovieStats = stat
+			}
+		}
+	}
+
+	return postsByID, nil
+}
+
+// GetPostCounts retrieves comment and reaction counts for a post without loading the full post
+func (s *PostService) GetPostCounts(ctx context.Context, postID uuid.UUID) (*models.PostCounts, error) {
+	ctx, span := otel.Tracer("clubhouse.posts").Start(ctx, "PostService.GetPostCounts")
+	span.SetAttributes(attribute.String("post_id", postID.String()))
+	defer span.End()
+
+	query := `
+		SELECT COUNT(c.id)
+		FROM posts p
+		LEFT JOIN comments c ON p.id = c.post_id AND c.deleted_at IS NULL
+		WHERE p.id = $1 AND p.deleted_at IS NULL
+		GROUP BY p.id
+	`
+
+	counts := models.PostCounts{PostID: postID}
+	if err := s.db.QueryRowContext(ctx, query, postID).Scan(&counts.CommentCount); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			recordSpanError(span, ErrPostNotFound)
+			return nil, ErrPostNotFound
+		}
+		recordSpanError(span, err)
+		return nil, err
+	}
+
+	reactionCounts, _, err := s.getPostReactions(ctx, postID, uuid.Nil)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+	counts.ReactionCounts = reactionCounts
+	for _, count := range reactionCounts {
+		counts.ReactionCount += count
+	}
+
+	span.SetAttributes(
+		attribute.Int("comment_count", counts.CommentCount),
+		attribute.Int("reaction_count", counts.ReactionCount),
+	)
+	return &counts, nil
+}
+
+// GetPostRatingDistribution returns a histogram of the 1-5 ratings logged for a movie, series, book, or recipe post
+func (s *PostService) GetPostRatingDistribution(ctx context.Context, postID uuid.UUID) (*models.RatingDistribution, error) {
+	ctx, span := otel.Tracer("clubhouse.posts").Start(ctx, "PostService.GetPostRatingDistribution")
+	span.SetAttributes(attribute.String("post_id", postID.String()))
+	defer span.End()
+
+	var sectionType string
+	err := s.db.QueryRowContext(ctx, `
+		SELECT s.type
+		FROM posts p
+		JOIN sections s ON p.section_id = s.id
+		WHERE p.id = $1 AND p.deleted_at IS NULL
+	`, postID).Scan(&sectionType)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			recordSpanError(span, ErrPostNotFound)
+			return nil, ErrPostNotFound
+		}
+		recordSpanError(span, err)
+		return nil, fmt.Errorf("failed to fetch post section: %w", err)
+	}
+	span.SetAttributes(attribute.String("section_type", sectionType))
+
+	var query string
+	switch sectionType {
+	case "movie", "series":
+		query = `
+			SELECT rating, COUNT(*)
+			FROM watch_logs
+			WHERE post_id = $1 AND deleted_at IS NULL
+			GROUP BY rating
+		`
+	case "book":
+		query = `
+			SELECT rating, COUNT(*)
+			FROM read_logs
+			WHERE post_id = $1 AND deleted_at IS NULL AND rating IS NOT NULL
+			GROUP BY rating
+		`
+	case "recipe":
+		query = `
+			SELECT rating, COUNT(*)
+			FROM cook_logs
+			WHERE post_id = $1 AND deleted_at IS NULL
+			GROUP BY rating
+		`
+	default:
+		recordSpanError(span, ErrRatingsNotSupported)
+		return nil, ErrRatingsNotSupported
+	}
+
+	distribution := models.RatingDistribution{
+		PostID:      postID,
+		SectionType: sectionType,
+		Counts:      map[int]int{1: 0, 2: 0, 3: 0, 4: 0, 5: 0},
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, postID)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, fmt.Errorf("failed to query rating distribution: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var rating int
+		var count int
+		if err := rows.Scan(&rating, &count); err != nil {
+			recordSpanError(span, err)
+			return nil, fmt.Errorf("failed to scan rating distribution: %w", err)
+		}
+		distribution.Counts[rating] = count
+		distribution.TotalRatings += count
+	}
+	if err := rows.Err(); err != nil {
+		recordSpanError(span, err)
+		return nil, fmt.Errorf("failed to iterate rating distribution: %w", err)
+	}
+
+	span.SetAttributes(attribute.Int("total_ratings", distribution.TotalRatings))
+	return &distribution, nil
+}
+
+// GetMoreFromAuthor returns the author's other recent posts in the same section as postID,
+// newest first. The source post and deleted posts are excluded.
+func (s *PostService) GetMoreFromAuthor(ctx context.Context, postID uuid.UUID, viewerID uuid.UUID, limit int) ([]*models.Post, error) {
+	ctx, span := otel.Tracer("clubhouse.posts").Start(ctx, "PostService.GetMoreFromAuthor")
+	span.SetAttributes(
+		attribute.String("post_id", postID.String()),
+		attribute.String("viewer_id", viewerID.String()),
+		attribute.Int("limit", limit),
+	)
+	defer span.End()
+
+	if limit <= 0 {
+		limit = defaultMoreFromAuthorLimit
+	}
+	if limit > maxMoreFromAuthorLimit {
+		limit = maxMoreFromAuthorLimit
+	}
+
+	var authorID uuid.UUID
+	var sectionID uuid.UUID
+	err := s.db.QueryRowContext(ctx, `
+		SELECT user_id, section_id
+		FROM posts
+		WHERE id = $1 AND deleted_at IS NULL
+	`, postID).Scan(&authorID, &sectionID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			recordSpanError(span, ErrPostNotFound)
+			return nil, ErrPostNotFound
+		}
+		recordSpanError(span, err)
+		return nil, fmt.Errorf("failed to fetch post author: %w", err)
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id
+		FROM posts
+		WHERE user_id = $1 AND section_id = $2 AND id <> $3 AND deleted_at IS NULL
+		ORDER BY created_at DESC, id DESC
+		LIMIT $4
+	`, authorID, sectionID, postID, limit)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, fmt.Errorf("failed to fetch author posts: %w", err)
+	}
+	defer rows.Close()
+
+	var relatedIDs []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			recordSpanError(span, err)
+			return nil, fmt.Errorf("failed to scan author post: %w", err)
+		}
+		relatedIDs = append(relatedIDs, id)
+	}
+	if err := rows.Err(); err != nil {
+		recordSpanError(span, err)
+		return nil, fmt.Errorf("failed to iterate author posts: %w", err)
+	}
+
+	postsByID, err := s.GetPostsByIDs(ctx, relatedIDs, viewerID)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+
+	posts := make([]*models.Post, 0, len(relatedIDs))
+	for _, id := range relatedIDs {
+		// The post may have been deleted since the ID lookup
+		if post, ok := postsByID[id]; ok {
+			posts = append(posts, post)
 		}
-		post.MovieStats = movieStats
 	}
 
-	return &post, nil
+	span.SetAttributes(attribute.Int("result_count", len(posts)))
+	return posts, nil
 }
 
 // getPostLinks retrieves all links for a post
@@ -668,7 +1107,7 @@ func (s *PostService) getPostLinks(ctx context.Context, postID uuid.UUID, viewer
 	defer span.End()
 
 	query := `
-		SELECT id, url, metadata, created_at
+		SELECT id, url, metadata, created_at, is_dead, last_checked_at
 		FROM links
 		WHERE post_id = $1
 		ORDER BY created_at ASC
@@ -685,39 +1124,17 @@ func (s *PostService) getPostLinks(ctx context.Context, postID uuid.UUID, viewer
 	for rows.Next() {
 		var link models.Link
 		var metadataJSON sql.NullString
+		var lastCheckedAt sql.NullTime
 
-		err := rows.Scan(&link.ID, &link.URL, &metadataJSON, &link.CreatedAt)
+		err := rows.Scan(&link.ID, &link.URL, &metadataJSON, &link.CreatedAt, &link.IsDead, &lastCheckedAt)
 		if err != nil {
 			recordSpanError(span, err)
 			return nil, err
 		}
-
-		// Parse metadata if present
-		if metadataJSON.Valid {
-			var metadata map[string]interface{}
-			if err := json.Unmarshal([]byte(metadataJSON.String), &metadata); err != nil {
-				observability.LogWarn(ctx, "failed to parse link metadata", "post_id", postID.String(), "link_id", link.ID.String())
-			} else {
-				highlights, err := extractHighlightsFromMetadata(metadata)
-				if err != nil {
-					observability.LogWarn(ctx, "failed to parse link highlights", "post_id", postID.String(), "link_id", link.ID.String())
-				} else if len(highlights) > 0 {
-					link.Highlights = highlights
-					highlightCount += len(highlights)
-					delete(metadata, "highlights")
-				}
-				podcast, err := extractPodcastFromMetadata(metadata)
-				if err != nil {
-					observability.LogWarn(ctx, "failed to parse podcast metadata", "post_id", postID.String(), "link_id", link.ID.String())
-				} else if podcast != nil {
-					link.Podcast = podcast
-					delete(metadata, "podcast")
-				}
-				if len(metadata) > 0 {
-					link.Metadata = metadata
-				}
-			}
+		if lastCheckedAt.Valid {
+			link.LastCheckedAt = &lastCheckedAt.Time
 		}
+		highlightCount += applyPostLinkMetadata(ctx, postID, &link, metadataJSON)
 
 		links = append(links, link)
 	}
@@ -741,6 +1158,109 @@ func (s *PostService) getPostLinks(ctx context.Context, postID uuid.UUID, viewer
 	return links, nil
 }
 
+// getPostLinksForPosts retrieves the links for several posts in one query, keyed by post ID.
+// Posts without links have no entry.
+func (s *PostService) getPostLinksForPosts(ctx context.Context, postIDs []uuid.UUID, viewerID uuid.UUID) (map[uuid.UUID][]models.Link, error) {
+	ctx, span := otel.Tracer("clubhouse.posts").Start(ctx, "PostService.getPostLinksForPosts")
+	span.SetAttributes(attribute.Int("post_count", len(postIDs)))
+	defer span.End()
+
+	linksByPost := make(map[uuid.UUID][]models.Link, len(postIDs))
+	if len(postIDs) == 0 {
+		return linksByPost, nil
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT post_id, id, url, metadata, created_at, is_dead, last_checked_at
+		FROM links
+		WHERE post_id = ANY($1)
+		ORDER BY created_at ASC
+	`, pq.Array(postIDs))
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var links []models.Link
+	var linkPostIDs []uuid.UUID
+	highlightCount := 0
+	for rows.Next() {
+		var postID uuid.UUID
+		var link models.Link
+		var metadataJSON sql.NullString
+		var lastCheckedAt sql.NullTime
+
+		if err := rows.Scan(&postID, &link.ID, &link.URL, &metadataJSON, &link.CreatedAt, &link.IsDead, &lastCheckedAt); err != nil {
+			recordSpanError(span, err)
+			return nil, err
+		}
+		if lastCheckedAt.Valid {
+			link.LastCheckedAt = &lastCheckedAt.Time
+		}
+		highlightCount += applyPostLinkMetadata(ctx, postID, &link, metadataJSON)
+
+		links = append(links, link)
+		linkPostIDs = append(linkPostIDs, postID)
+	}
+	if err := rows.Err(); err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+
+	if highlightCount > 0 {
+		if err := s.populateHighlightReactions(ctx, links, viewerID); err != nil {
+			recordSpanError(span, err)
+			return nil, err
+		}
+	}
+
+	for i, link := range links {
+		linksByPost[linkPostIDs[i]] = append(linksByPost[linkPostIDs[i]], link)
+	}
+
+	span.SetAttributes(
+		attribute.Int("link_count", len(links)),
+		attribute.Int("highlight_count", highlightCount),
+	)
+	return linksByPost, nil
+}
+
+// applyPostLinkMetadata parses a link's stored metadata, moving highlights and podcast details
+// into their own fields, and returns how many highlights were found.
+func applyPostLinkMetadata(ctx context.Context, postID uuid.UUID, link *models.Link, metadataJSON sql.NullString) int {
+	if !metadataJSON.Valid {
+		return 0
+	}
+
+	var metadata map[string]interface{}
+	if err := json.Unmarshal([]byte(metadataJSON.String), &metadata); err != nil {
+		observability.LogWarn(ctx, "failed to parse link metadata", "post_id", postID.String(), "link_id", link.ID.String())
+		return 0
+	}
+
+	highlightCount := 0
+	highlights, err := extractHighlightsFromMetadata(metadata)
+	if err != nil {
+		observability.LogWarn(ctx, "failed to parse link highlights", "post_id", postID.String(), "link_id", link.ID.String())
+	} else if len(highlights) > 0 {
+		link.Highlights = formatHighlightTimestamps(highlights)
+		highlightCount = len(highlights)
+		delete(metadata, "highlights")
+	}
+	podcast, err := extractPodcastFromMetadata(metadata)
+	if err != nil {
+		observability.LogWarn(ctx, "failed to parse podcast metadata", "post_id", postID.String(), "link_id", link.ID.String())
+	} else if podcast != nil {
+		link.Podcast = podcast
+		delete(metadata, "podcast")
+	}
+	if len(metadata) > 0 {
+		link.Metadata = metadata
+	}
+	return highlightCount
+}
+
 func (s *PostService) populateHighlightReactions(ctx context.Context, links []models.Link, viewerID uuid.UUID) error {
 	if len(links) == 0 {
 		return nil
@@ -887,6 +1407,52 @@ func (s *PostService) getPostImages(ctx context.Context, postID uuid.UUID) ([]mo
 	return images, nil
 }
 
+// getPostImagesForPosts retrieves the images for several posts in one query, keyed by post ID
+// and in order. Posts without images have no entry.
+func (s *PostService) getPostImagesForPosts(ctx context.Context, postIDs []uuid.UUID) (map[uuid.UUID][]models.PostImage, error) {
+	imagesByPost := make(map[uuid.UUID][]models.PostImage, len(postIDs))
+	if len(postIDs) == 0 {
+		return imagesByPost, nil
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT post_id, id, image_url, position, caption, alt_text, created_at
+		FROM post_images
+		WHERE post_id = ANY($1)
+		ORDER BY post_id, position ASC
+	`, pq.Array(postIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var postID uuid.UUID
+		var image models.PostImage
+		var caption sql.NullString
+		var altText sql.NullString
+
+		if err := rows.Scan(&postID, &image.ID, &image.URL, &image.Position, &caption, &altText, &image.CreatedAt); err != nil {
+			return nil, err
+		}
+
+		if caption.Valid {
+			image.Caption = &caption.String
+		}
+		if altText.Valid {
+			image.AltText = &altText.String
+		}
+
+		imagesByPost[postID] = append(imagesByPost[postID], image)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return imagesByPost, nil
+}
+
 type postImageEntry struct {
 	url     string
 	caption sql.NullString
@@ -1058,6 +1624,19 @@ func sanitizePodcastMetadata(podcast *models.PodcastMetadata) *models.PodcastMet
 	return sanitized
 }
 
+// formatHighlightTimestamps returns a copy of highlights with display timestamps filled in,
+// leaving the input untouched since it may still be persisted.
+func formatHighlightTimestamps(highlights []models.Highlight) []models.Highlight {
+	if len(highlights) == 0 {
+		return nil
+	}
+	formatted := append([]models.Highlight(nil), highlights...)
+	for i := range formatted {
+		formatted[i].FormattedTimestamp = models.FormatHighlightTimestamp(formatted[i].Timestamp)
+	}
+	return formatted
+}
+
 func sortHighlights(highlights []models.Highlight) []models.Highlight {
 	if len(highlights) == 0 {
 		return nil
@@ -1152,6 +1731,10 @@ func isImageLink(link models.Link) bool {
 				return true
 			}
 		}
+		// The fetched content type decides when it is known, whatever the URL looks like.
+		if contentType, ok := link.Metadata["content_type"].(string); ok && strings.TrimSpace(contentType) != "" {
+			return strings.HasPrefix(strings.ToLower(strings.TrimSpace(contentType)), "image/")
+		}
 	}
 	return imageLinkPattern.MatchString(link.URL)
 }
@@ -1204,6 +1787,58 @@ func (s *PostService) getPostReactions(ctx context.Context, postID uuid.UUID, vi
 	return counts, viewerReactions, nil
 }
 
+// postReactions holds the reaction counts on a post and the emojis the viewer reacted with.
+type postReactions struct {
+	counts          map[string]int
+	viewerReactions []string
+}
+
+// getPostReactionsForPosts retrieves reaction counts and viewer reactions for several posts in
+// one query. Every requested post has an entry, even without reactions.
+func (s *PostService) getPostReactionsForPosts(ctx context.Context, postIDs []uuid.UUID, viewerID uuid.UUID) (map[uuid.UUID]*postReactions, error) {
+	reactionsByPost := make(map[uuid.UUID]*postReactions, len(postIDs))
+	for _, postID := range postIDs {
+		reactionsByPost[postID] = &postReactions{counts: make(map[string]int)}
+	}
+	if len(postIDs) == 0 {
+		return reactionsByPost, nil
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT post_id, emoji, COUNT(*), bool_or(user_id = $2)
+		FROM reactions
+		WHERE post_id = ANY($1) AND deleted_at IS NULL
+		GROUP BY post_id, emoji
+	`, pq.Array(postIDs), viewerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var postID uuid.UUID
+		var emoji string
+		var count int
+		var viewerReacted bool
+		if err := rows.Scan(&postID, &emoji, &count, &viewerReacted); err != nil {
+			return nil, err
+		}
+		reactions, ok := reactionsByPost[postID]
+		if !ok {
+			continue
+		}
+		reactions.counts[emoji] = count
+		if viewerReacted && viewerID != uuid.Nil {
+			reactions.viewerReactions = append(reactions.viewerReactions, emoji)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return reactionsByPost, nil
+}
+
 func (s *PostService) getRecipeStats(ctx context.Context, postID uuid.UUID, viewerID *uuid.UUID) (*models.RecipeStats, error) {
 	statsByPost, err := s.getRecipeStatsForPosts(ctx, []uuid.UUID{postID}, viewerID)
 	if err != nil {
@@ -1309,11 +1944,12 @@ func (s *PostService) getRecipeStatsForPosts(ctx context.Context, postIDs []uuid
 
 	if viewerID != nil {
 		categoryRows, err := s.db.QueryContext(ctx, `
-			SELECT post_id, category
-			FROM saved_recipes
-			WHERE post_id = ANY($1) AND user_id = $2 AND deleted_at IS NULL
-			ORDER BY category ASC
-		`, pq.Array(postIDs), *viewerID)
+			SELECT sr.post_id, sr.category
+			FROM saved_recipes sr
+			LEFT JOIN recipe_categories rc ON rc.user_id = sr.user_id AND rc.name = sr.category
+			WHERE sr.post_id = ANY($1) AND sr.user_id = $2 AND sr.deleted_at IS NULL
+			ORDER BY `+viewerCategoryOrderBy(ViewerCategoryStatRecipe, "sr.category", "sr.created_at", "rc.position"),
+			pq.Array(postIDs), *viewerID)
 		if err != nil {
 			recordSpanError(span, err)
 			return nil, err
@@ -1523,11 +2159,12 @@ func (s *PostService) getMovieStatsForPosts(ctx context.Context, postIDs []uuid.
 
 	if viewerID != nil {
 		categoryRows, err := s.db.QueryContext(ctx, `
-			SELECT post_id, category
-			FROM watchlist_items
-			WHERE post_id = ANY($1) AND user_id = $2 AND deleted_at IS NULL
-			ORDER BY category ASC
-		`, pq.Array(postIDs), *viewerID)
+			SELECT wi.post_id, wi.category
+			FROM watchlist_items wi
+			LEFT JOIN watchlist_categories wc ON wc.user_id = wi.user_id AND wc.name = wi.category
+			WHERE wi.post_id = ANY($1) AND wi.user_id = $2 AND wi.deleted_at IS NULL
+			ORDER BY `+viewerCategoryOrderBy(ViewerCategoryStatWatchlist, "wi.category", "wi.created_at", "wc.position"),
+			pq.Array(postIDs), *viewerID)
 		if err != nil {
 			recordSpanError(span, err)
 			return nil, err
@@ -1560,12 +2197,14 @@ func isMovieOrSeriesSectionType(sectionType string) bool {
 }
 
 // GetMovieFeed retrieves a paginated feed of posts across movie and series sections.
+// Without includeCommentCounts the comment count aggregation is skipped and posts carry no comment_count.
 func (s *PostService) GetMovieFeed(
 	ctx context.Context,
 	cursor *string,
 	limit int,
 	userID uuid.UUID,
 	sectionType *string,
+	includeCommentCounts bool,
 ) (*models.FeedResponse, error) {
 	ctx, span := otel.Tracer("clubhouse.posts").Start(ctx, "PostService.GetMovieFeed")
 	span.SetAttributes(
@@ -1583,16 +2222,20 @@ func (s *PostService) GetMovieFeed(
 		limit = 20
 	}
 
+	commentCountColumn, commentJoin := feedCommentCountSQL(includeCommentCounts)
+	span.SetAttributes(attribute.Bool("include_comment_counts", includeCommentCounts))
+
 	query := `
 		SELECT
 			p.id, p.user_id, p.section_id, p.content,
 			p.created_at, p.updated_at, p.deleted_at, p.deleted_by_user_id,
 			u.id, u.username, COALESCE(u.email, '') as email, u.profile_picture_url, u.bio, u.is_admin, u.created_at,
-			COALESCE(COUNT(DISTINCT c.id), 0) as comment_count
+			` + commentCountColumn + ` as comment_count,
+			s.type
 		FROM posts p
 		JOIN sections s ON p.section_id = s.id
 		JOIN users u ON p.user_id = u.id
-		LEFT JOIN comments c ON p.id = c.post_id AND c.deleted_at IS NULL
+		` + commentJoin + `
 		WHERE p.deleted_at IS NULL
 	`
 
@@ -1613,7 +2256,7 @@ func (s *PostService) GetMovieFeed(
 		argIndex++
 	}
 
-	query += fmt.Sprintf(" GROUP BY p.id, u.id ORDER BY p.created_at DESC LIMIT $%d", argIndex)
+	query += fmt.Sprintf(" GROUP BY p.id, u.id, s.type ORDER BY p.created_at DESC LIMIT $%d", argIndex)
 	args = append(args, limit+1)
 
 	rows, err := s.db.QueryContext(ctx, query, args...)
@@ -1627,12 +2270,13 @@ func (s *PostService) GetMovieFeed(
 	for rows.Next() {
 		var post models.Post
 		var user models.User
+		var postSectionType string
 
 		err := rows.Scan(
 			&post.ID, &post.UserID, &post.SectionID, &post.Content,
 			&post.CreatedAt, &post.UpdatedAt, &post.DeletedAt, &post.DeletedByUserID,
 			&user.ID, &user.Username, &user.Email, &user.ProfilePictureURL, &user.Bio, &user.IsAdmin, &user.CreatedAt,
-			&post.CommentCount,
+			&post.CommentCount, &postSectionType,
 		)
 		if err != nil {
 			recordSpanError(span, err)
@@ -1647,6 +2291,7 @@ func (s *PostService) GetMovieFeed(
 			return nil, err
 		}
 		post.Links = links
+		applyPostDisplayFields(&post, postSectionType)
 
 		images, err := s.getPostImages(ctx, post.ID)
 		if err != nil {
@@ -1713,16 +2358,32 @@ func (s *PostService) GetMovieFeed(
 	}, nil
 }
 
-// GetFeed retrieves a paginated feed of posts for a section using cursor-based pagination
-func (s *PostService) GetFeed(ctx context.Context, sectionID uuid.UUID, cursor *string, limit int, userID uuid.UUID) (*models.FeedResponse, error) {
+// GetFeed retrieves a paginated feed of posts for a section using cursor-based pagination.
+// Without includeCommentCounts the comment count aggregation is skipped and posts carry no comment_count.
+func (s *PostService) GetFeed(ctx context.Context, sectionID uuid.UUID, cursor *string, limit int, userID uuid.UUID, includeCommentCounts bool) (*models.FeedResponse, error) {
 	ctx, span := otel.Tracer("clubhouse.posts").Start(ctx, "PostService.GetFeed")
+	defer span.End()
+
+	return s.getFeed(ctx, span, sectionID, cursor, limit, userID, false, includeCommentCounts)
+}
+
+// GetUnreadFeed retrieves only the posts created after the viewer's read position for the section.
+// Viewers without a read position see the full feed.
+func (s *PostService) GetUnreadFeed(ctx context.Context, sectionID uuid.UUID, cursor *string, limit int, userID uuid.UUID, includeCommentCounts bool) (*models.FeedResponse, error) {
+	ctx, span := otel.Tracer("clubhouse.posts").Start(ctx, "PostService.GetUnreadFeed")
+	defer span.End()
+
+	return s.getFeed(ctx, span, sectionID, cursor, limit, userID, true, includeCommentCounts)
+}
+
+func (s *PostService) getFeed(ctx context.Context, span trace.Span, sectionID uuid.UUID, cursor *string, limit int, userID uuid.UUID, unreadOnly bool, includeCommentCounts bool) (*models.FeedResponse, error) {
 	span.SetAttributes(
 		attribute.String("section_id", sectionID.String()),
 		attribute.String("user_id", userID.String()),
 		attribute.Int("limit", limit),
 		attribute.Bool("has_cursor", cursor != nil && *cursor != ""),
+		attribute.Bool("unread_only", unreadOnly),
 	)
-	defer span.End()
 
 	if limit <= 0 || limit > 100 {
 		limit = 20
@@ -1735,16 +2396,19 @@ func (s *PostService) GetFeed(ctx context.Context, sectionID uuid.UUID, cursor *
 	}
 	span.SetAttributes(attribute.String("section_type", sectionType))
 
+	commentCountColumn, commentJoin := feedCommentCountSQL(includeCommentCounts)
+	span.SetAttributes(attribute.Bool("include_comment_counts", includeCommentCounts))
+
 	// Build base query
 	query := `
 		SELECT
 			p.id, p.user_id, p.section_id, p.content,
 			p.created_at, p.updated_at, p.deleted_at, p.deleted_by_user_id,
 			u.id, u.username, COALESCE(u.email, '') as email, u.profile_picture_url, u.bio, u.is_admin, u.created_at,
-			COALESCE(COUNT(DISTINCT c.id), 0) as comment_count
+			` + commentCountColumn + ` as comment_count
 		FROM posts p
 		JOIN users u ON p.user_id = u.id
-		LEFT JOIN comments c ON p.id = c.post_id AND c.deleted_at IS NULL
+		` + commentJoin + `
 		WHERE p.section_id = $1 AND p.deleted_at IS NULL
 	`
 
@@ -1758,6 +2422,15 @@ func (s *PostService) GetFeed(ctx context.Context, sectionID uuid.UUID, cursor *
 		argIndex++
 	}
 
+	if unreadOnly {
+		query += fmt.Sprintf(` AND p.created_at > COALESCE(
+			(SELECT last_read_at FROM section_read_positions WHERE user_id = $%d AND section_id = $1),
+			'-infinity'::timestamp
+		)`, argIndex)
+		args = append(args, userID)
+		argIndex++
+	}
+
 	query += fmt.Sprintf(" GROUP BY p.id, u.id ORDER BY p.created_at DESC LIMIT $%d", argIndex)
 	args = append(args, limit+1) // Fetch one extra to determine if hasMore
 
@@ -1793,6 +2466,7 @@ func (s *PostService) GetFeed(ctx context.Context, sectionID uuid.UUID, cursor *
 			return nil, err
 		}
 		post.Links = links
+		applyPostDisplayFields(&post, sectionType)
 
 		// Fetch images for this post
 		images, err := s.getPostImages(ctx, post.ID)
@@ -1982,6 +2656,9 @@ func (s *PostService) DeletePost(ctx context.Context, postID uuid.UUID, userID u
 	// Copy over the user and links from the original post
 	updatedPost.User = post.User
 	updatedPost.Links = post.Links
+	updatedPost.Slug = post.Slug
+	updatedPost.DisplayTitle = post.DisplayTitle
+	updatedPost.SectionType = post.SectionType
 	updatedPost.Images = post.Images
 	updatedPost.ReactionCounts = post.ReactionCounts
 	updatedPost.ViewerReactions = post.ViewerReactions
@@ -1991,7 +2668,7 @@ func (s *PostService) DeletePost(ctx context.Context, postID uuid.UUID, userID u
 }
 
 // RestorePost restores a soft-deleted post
-// Only the post owner (within 7 days) or an admin can restore
+// Only the post owner (within the restore window) or an admin can restore
 func (s *PostService) RestorePost(ctx context.Context, postID uuid.UUID, userID uuid.UUID, isAdmin bool) (*models.Post, error) {
 	ctx, span := otel.Tracer("clubhouse.posts").Start(ctx, "PostService.RestorePost")
 	span.SetAttributes(
@@ -2007,22 +2684,25 @@ func (s *PostService) RestorePost(ctx context.Context, postID uuid.UUID, userID
 			p.id, p.user_id, p.section_id, p.content,
 			p.created_at, p.updated_at, p.deleted_at, p.deleted_by_user_id,
 			u.id, u.username, COALESCE(u.email, '') as email, u.profile_picture_url, u.bio, u.is_admin, u.created_at,
-			COALESCE(COUNT(DISTINCT c.id), 0) as comment_count
+			COALESCE(COUNT(DISTINCT c.id), 0) as comment_count,
+			s.type
 		FROM posts p
 		JOIN users u ON p.user_id = u.id
+		JOIN sections s ON p.section_id = s.id
 		LEFT JOIN comments c ON p.id = c.post_id AND c.deleted_at IS NULL
 		WHERE p.id = $1 AND p.deleted_at IS NOT NULL
-		GROUP BY p.id, u.id
+		GROUP BY p.id, u.id, s.type
 	`
 
 	var post models.Post
 	var user models.User
+	var sectionType string
 
 	err := s.db.QueryRowContext(ctx, query, postID).Scan(
 		&post.ID, &post.UserID, &post.SectionID, &post.Content,
 		&post.CreatedAt, &post.UpdatedAt, &post.DeletedAt, &post.DeletedByUserID,
 		&user.ID, &user.Username, &user.Email, &user.ProfilePictureURL, &user.Bio, &user.IsAdmin, &user.CreatedAt,
-		&post.CommentCount,
+		&post.CommentCount, &sectionType,
 	)
 
 	if err != nil {
@@ -2036,7 +2716,7 @@ func (s *PostService) RestorePost(ctx context.Context, postID uuid.UUID, userID
 	}
 
 	// Check permissions
-	// Only owner (within 7 days) or admin can restore
+	// Only owner (within the restore window) or admin can restore
 	if !isAdmin && post.UserID != userID {
 		unauthorizedErr := errors.New("unauthorized")
 		recordSpanError(span, unauthorizedErr)
@@ -2044,9 +2724,7 @@ func (s *PostService) RestorePost(ctx context.Context, postID uuid.UUID, userID
 	}
 
 	if !isAdmin && post.DeletedAt != nil {
-		// Check if within 7 days
-		sevenDaysAgo := time.Now().AddDate(0, 0, -7)
-		if post.DeletedAt.Before(sevenDaysAgo) {
+		if restoreWindowExpired(*post.DeletedAt) {
 			permanentErr := errors.New("post permanently deleted")
 			recordSpanError(span, permanentErr)
 			return nil, permanentErr
@@ -2080,6 +2758,7 @@ func (s *PostService) RestorePost(ctx context.Context, postID uuid.UUID, userID
 		return nil, err
 	}
 	post.Links = links
+	applyPostDisplayFields(&post, sectionType)
 
 	// Fetch images for this post
 	images, err := s.getPostImages(ctx, postID)
@@ -2102,8 +2781,9 @@ func (s *PostService) RestorePost(ctx context.Context, postID uuid.UUID, userID
 	return &post, nil
 }
 
-// GetPostsByUserID retrieves a paginated list of posts by a specific user using cursor-based pagination
-func (s *PostService) GetPostsByUserID(ctx context.Context, targetUserID uuid.UUID, cursor *string, limit int, viewerID uuid.UUID) (*models.FeedResponse, error) {
+// GetPostsByUserID retrieves a paginated list of posts by a specific user using cursor-based pagination.
+// Without includeCommentCounts the comment count aggregation is skipped and posts carry no comment_count.
+func (s *PostService) GetPostsByUserID(ctx context.Context, targetUserID uuid.UUID, cursor *string, limit int, viewerID uuid.UUID, includeCommentCounts bool) (*models.FeedResponse, error) {
 	ctx, span := otel.Tracer("clubhouse.posts").Start(ctx, "PostService.GetPostsByUserID")
 	span.SetAttributes(
 		attribute.String("target_user_id", targetUserID.String()),
@@ -2117,18 +2797,21 @@ func (s *PostService) GetPostsByUserID(ctx context.Context, targetUserID uuid.UU
 		limit = 20
 	}
 
+	commentCountColumn, commentJoin := feedCommentCountSQL(includeCommentCounts)
+	span.SetAttributes(attribute.Bool("include_comment_counts", includeCommentCounts))
+
 	// Build base query
 	query := `
 		SELECT
 			p.id, p.user_id, p.section_id, p.content,
 			p.created_at, p.updated_at, p.deleted_at, p.deleted_by_user_id,
 			u.id, u.username, COALESCE(u.email, '') as email, u.profile_picture_url, u.bio, u.is_admin, u.created_at,
-			COALESCE(COUNT(DISTINCT c.id), 0) as comment_count,
+			` + commentCountColumn + ` as comment_count,
 			s.type
 		FROM posts p
 		JOIN users u ON p.user_id = u.id
 		JOIN sections s ON p.section_id = s.id
-		LEFT JOIN comments c ON p.id = c.post_id AND c.deleted_at IS NULL
+		` + commentJoin + `
 		WHERE p.user_id = $1 AND p.deleted_at IS NULL
 	`
 
@@ -2181,6 +2864,7 @@ func (s *PostService) GetPostsByUserID(ctx context.Context, targetUserID uuid.UU
 			return nil, err
 		}
 		post.Links = links
+		applyPostDisplayFields(&post, sectionType)
 
 		// Fetch images for this post
 		images, err := s.getPostImages(ctx, post.ID)
@@ -2359,6 +3043,17 @@ func (s *PostService) HardDeletePost(ctx context.Context, postID uuid.UUID, admi
 		return fmt.Errorf("failed to delete notifications: %w", err)
 	}
 
+	// Let commenters know the thread is gone while their comments can still be resolved
+	var removalNotifications []postRemovalNotification
+	if GetConfigService().GetSettings().PostRemovalNotificationsEnabled {
+		removalNotifications, err = insertPostRemovalNotifications(ctx, tx, postID, adminUserID)
+		if err != nil {
+			recordSpanError(span, err)
+			return err
+		}
+	}
+	span.SetAttributes(attribute.Int("removal_notification_count", len(removalNotifications)))
+
 	// Delete comments on this post
 	_, err = tx.ExecContext(ctx, "DELETE FROM comments WHERE post_id = $1", postID)
 	if err != nil {
@@ -2411,6 +3106,111 @@ func (s *PostService) HardDeletePost(ctx context.Context, postID uuid.UUID, admi
 
 	observability.RecordPostDeleted(ctx)
 
+	if len(removalNotifications) > 0 {
+		observability.RecordNotificationsCreated(ctx, notificationTypePostRemoved, int64(len(removalNotifications)))
+		notificationService := NewNotificationService(s.db, s.redis, nil)
+		for _, notification := range removalNotifications {
+			notificationService.publishRealtimeNotification(ctx, notification.userID, notification.notificationID)
+		}
+	}
+
+	return nil
+}
+
+type postRemovalNotification struct {
+	userID         uuid.UUID
+	notificationID uuid.UUID
+}
+
+// insertPostRemovalNotifications notifies each active commenter on a post once that the post was removed.
+// Commenters who unsubscribed from the section and the acting admin are skipped. The post is about to be
+// deleted, so an excerpt of it is kept in the notification metadata to tell readers which post it was.
+func insertPostRemovalNotifications(ctx context.Context, tx *sql.Tx, postID uuid.UUID, adminUserID uuid.UUID) ([]postRemovalNotification, error) {
+	rows, err := tx.QueryContext(ctx, `
+		INSERT INTO notifications (user_id, type, related_user_id, metadata)
+		SELECT DISTINCT c.user_id, $2, p.user_id, jsonb_build_object('post_excerpt', LEFT(btrim(p.content), $4))
+		FROM comments c
+		JOIN posts p ON p.id = c.post_id
+		JOIN users u ON u.id = c.user_id
+		WHERE c.post_id = $1
+		  AND c.deleted_at IS NULL
+		  AND c.user_id <> $3
+		  AND u.deleted_at IS NULL
+		  AND u.approved_at IS NOT NULL
+		  AND NOT EXISTS (
+				SELECT 1 FROM section_subscriptions ss
+				WHERE ss.user_id = c.user_id AND ss.section_id = p.section_id
+		  )
+		RETURNING user_id, id
+	`, postID, notificationTypePostRemoved, adminUserID, notificationExcerptLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create post removal notifications: %w", err)
+	}
+	defer rows.Close()
+
+	var notifications []postRemovalNotification
+	for rows.Next() {
+		var notification postRemovalNotification
+		if err := rows.Scan(&notification.userID, &notification.notificationID); err != nil {
+			return nil, fmt.Errorf("failed to scan post removal notification: %w", err)
+		}
+		notifications = append(notifications, notification)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate post removal notifications: %w", err)
+	}
+
+	return notifications, nil
+}
+
+// RefreshLinkMetadata drops any cached metadata for a post link's URL and queues a forced re-fetch.
+func (s *PostService) RefreshLinkMetadata(ctx context.Context, linkID uuid.UUID) error {
+	ctx, span := otel.Tracer("clubhouse.posts").Start(ctx, "PostService.RefreshLinkMetadata")
+	span.SetAttributes(attribute.String("link_id", linkID.String()))
+	defer span.End()
+
+	if s.redis == nil {
+		recordSpanError(span, ErrMetadataQueueOff)
+		return ErrMetadataQueueOff
+	}
+
+	var postID uuid.UUID
+	var url string
+	var sectionType string
+	err := s.db.QueryRowContext(ctx, `
+		SELECT l.post_id, l.url, s.type
+		FROM links l
+		JOIN posts p ON p.id = l.post_id
+		JOIN sections s ON s.id = p.section_id
+		WHERE l.id = $1
+	`, linkID).Scan(&postID, &url, &sectionType)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			recordSpanError(span, ErrLinkNotFound)
+			return ErrLinkNotFound
+		}
+		recordSpanError(span, err)
+		return fmt.Errorf("failed to get link: %w", err)
+	}
+	span.SetAttributes(attribute.String("post_id", postID.String()))
+
+	if err := InvalidateCachedLinkMetadata(ctx, s.redis, sectionType, url); err != nil {
+		recordSpanError(span, err)
+		return fmt.Errorf("failed to invalidate cached link metadata: %w", err)
+	}
+
+	job := MetadataJob{
+		PostID:       postID,
+		LinkID:       linkID,
+		URL:          url,
+		CreatedAt:    time.Now(),
+		ForceRefresh: true,
+	}
+	if err := EnqueueMetadataJob(ctx, s.redis, job); err != nil {
+		recordSpanError(span, err)
+		return fmt.Errorf("failed to enqueue metadata job: %w", err)
+	}
+
 	return nil
 }
 
@@ -2507,17 +3307,29 @@ func (s *PostService) AdminRestorePost(ctx context.Context, postID uuid.UUID, ad
 	return fullPost, nil
 }
 
-// validateCreatePostInput validates post creation input
-func validateCreatePostInput(req *models.CreatePostRequest) error {
-	if strings.TrimSpace(req.SectionID) == "" {
-		return fmt.Errorf("section_id is required")
-	}
+// applyPostDisplayFields fills the computed fields clients use to render a post. It must run
+// after the post's links are loaded.
+func applyPostDisplayFields(post *models.Post, sectionType string) {
+	post.Slug = models.PostSlug(post.Content, post.Links)
+	post.DisplayTitle = models.PostDisplayTitle(post.Content, post.Links)
+	post.SectionType = sectionType
+}
 
+// validateCreatePostInput validates post creation input for the target section type
+func validateCreatePostInput(req *models.CreatePostRequest, sectionType string) error {
 	trimmedContent := strings.TrimSpace(req.Content)
 	if trimmedContent == "" && len(req.Links) == 0 && len(req.Images) == 0 {
 		return fmt.Errorf("content is required")
 	}
 
+	if trimmedContent == "" && IsContentRequiredForSectionType(sectionType) {
+		return fmt.Errorf("content is required for this section")
+	}
+
+	if len(req.Links) == 0 && len(req.Images) == 0 && IsMediaRequiredForSectionType(sectionType) {
+		return fmt.Errorf("media is required for this section")
+	}
+
 	if len(trimmedContent) > 5000 {
 		return fmt.Errorf("content must be less than 5000 characters")
 	}
@@ -2543,6 +3355,9 @@ func validateCreatePostInput(req *models.CreatePostRequest) error {
 		if len(image.URL) > 2048 {
 			return fmt.Errorf("image url must be less than 2048 characters")
 		}
+		if !GetConfigService().IsImageHostAllowed(image.URL) {
+			return fmt.Errorf("image host is not allowed")
+		}
 	}
 
 	return nil
@@ -2585,12 +3400,66 @@ func validateUpdatePostInput(req *models.UpdatePostRequest) error {
 			if len(image.URL) > 2048 {
 				return fmt.Errorf("image url must be less than 2048 characters")
 			}
+			if !GetConfigService().IsImageHostAllowed(image.URL) {
+				return fmt.Errorf("image host is not allowed")
+			}
 		}
 	}
 
 	return nil
 }
 
+// validateUpdatePostForSection applies the same section-type rules as post creation once the
+// post's section is known.
+func validateUpdatePostForSection(req *models.UpdatePostRequest, sectionType string) error {
+	if strings.TrimSpace(req.Content) == "" && IsContentRequiredForSectionType(sectionType) {
+		return fmt.Errorf("content is required for this section")
+	}
+	return nil
+}
+
+// updateKeepsMedia reports whether a post still has at least one link or image after the update.
+// Links and images the request leaves untouched are loaded from the database.
+func (s *PostService) updateKeepsMedia(
+	ctx context.Context,
+	postID uuid.UUID,
+	req *models.UpdatePostRequest,
+	resolvedLinks []models.LinkRequest,
+	existingLinks []models.Link,
+	removedLink *models.Link,
+	normalizedImages []models.PostImageRequest,
+) (bool, error) {
+	if req.Images != nil {
+		if len(normalizedImages) > 0 {
+			return true, nil
+		}
+	} else {
+		existingImages, err := getPostImageEntries(ctx, s.db, postID)
+		if err != nil {
+			return false, fmt.Errorf("failed to fetch post images: %w", err)
+		}
+		if len(existingImages) > 0 {
+			return true, nil
+		}
+	}
+
+	if req.Links != nil {
+		return len(resolvedLinks) > 0, nil
+	}
+	if existingLinks == nil {
+		var err error
+		existingLinks, err = s.getPostLinks(ctx, postID, uuid.Nil)
+		if err != nil {
+			return false, fmt.Errorf("failed to fetch post links: %w", err)
+		}
+	}
+	remaining := len(existingLinks)
+	if removedLink != nil {
+		remaining--
+	}
+	return remaining > 0, nil
+}
+
 func imageCount(images *[]models.PostImageRequest) int {
 	if images == nil {
 		return 0
@@ -3,6 +3,8 @@ package services
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"strings"
 	"testing"
 	"time"
@@ -44,6 +46,40 @@ func TestCreatePostWithoutLinks(t *testing.T) {
 	}
 }
 
+func TestCreatePostResponseIncludesDisplayFields(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	disableLinkMetadata(t)
+
+	userID := uuid.MustParse(testutil.CreateTestUser(t, db, "displayfields", "displayfields@test.com", false, true))
+	sectionID := testutil.CreateTestSection(t, db, "Book Club", "book")
+
+	service := NewPostService(db)
+	created, err := service.CreatePost(context.Background(), &models.CreatePostRequest{
+		SectionID: sectionID,
+		Content:   "Finished Piranesi\nWhat did everyone think?",
+	}, userID)
+	if err != nil {
+		t.Fatalf("CreatePost failed: %v", err)
+	}
+
+	if created.SectionType != "book" {
+		t.Errorf("expected section_type book, got %q", created.SectionType)
+	}
+	if created.DisplayTitle != "Finished Piranesi" {
+		t.Errorf("expected display title %q, got %q", "Finished Piranesi", created.DisplayTitle)
+	}
+
+	fetched, err := service.GetPostByID(context.Background(), created.ID, userID)
+	if err != nil {
+		t.Fatalf("GetPostByID failed: %v", err)
+	}
+	if created.SectionType != fetched.SectionType || created.DisplayTitle != fetched.DisplayTitle || created.Slug != fetched.Slug {
+		t.Errorf("expected create response display fields to match read shape, got %+v vs %+v", created, fetched)
+	}
+}
+
 func TestCreatePostMovieSectionInitializesMovieStats(t *testing.T) {
 	db := testutil.RequireTestDB(t)
 	t.Cleanup(func() { testutil.CleanupTables(t, db) })
@@ -137,11 +173,11 @@ func TestCreatePost_EnqueuesMetadataJob(t *testing.T) {
 	config := GetConfigService()
 	current := config.GetConfig().LinkMetadataEnabled
 	enabled := true
-	if _, err := config.UpdateConfig(context.Background(), &enabled, nil, nil); err != nil {
+	if _, err := config.UpdateConfig(context.Background(), ConfigUpdate{LinkMetadataEnabled: &enabled}); err != nil {
 		t.Fatalf("failed to enable link metadata: %v", err)
 	}
 	t.Cleanup(func() {
-		if _, err := config.UpdateConfig(context.Background(), &current, nil, nil); err != nil {
+		if _, err := config.UpdateConfig(context.Background(), ConfigUpdate{LinkMetadataEnabled: &current}); err != nil {
 			t.Fatalf("failed to restore link metadata: %v", err)
 		}
 	})
@@ -209,11 +245,11 @@ func TestCreatePost_MultipleLinks_EnqueuesAllJobs(t *testing.T) {
 	config := GetConfigService()
 	current := config.GetConfig().LinkMetadataEnabled
 	enabled := true
-	if _, err := config.UpdateConfig(context.Background(), &enabled, nil, nil); err != nil {
+	if _, err := config.UpdateConfig(context.Background(), ConfigUpdate{LinkMetadataEnabled: &enabled}); err != nil {
 		t.Fatalf("failed to enable link metadata: %v", err)
 	}
 	t.Cleanup(func() {
-		if _, err := config.UpdateConfig(context.Background(), &current, nil, nil); err != nil {
+		if _, err := config.UpdateConfig(context.Background(), ConfigUpdate{LinkMetadataEnabled: &current}); err != nil {
 			t.Fatalf("failed to restore link metadata: %v", err)
 		}
 	})
@@ -251,6 +287,127 @@ func TestCreatePost_MultipleLinks_EnqueuesAllJobs(t *testing.T) {
 	}
 }
 
+func TestCreatePost_ReusesCachedMetadataForSameURL(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	config := GetConfigService()
+	current := config.GetConfig().LinkMetadataEnabled
+	enabled := true
+	if _, err := config.UpdateConfig(context.Background(), ConfigUpdate{LinkMetadataEnabled: &enabled}); err != nil {
+		t.Fatalf("failed to enable link metadata: %v", err)
+	}
+	t.Cleanup(func() {
+		if _, err := config.UpdateConfig(context.Background(), ConfigUpdate{LinkMetadataEnabled: &current}); err != nil {
+			t.Fatalf("failed to restore link metadata: %v", err)
+		}
+	})
+
+	rdb := setupMetadataQueueTestRedis(t)
+
+	userID := testutil.CreateTestUser(t, db, "cachedlinkpost", "cachedlinkpost@test.com", false, true)
+	sectionID := testutil.CreateTestSection(t, db, "Cached Link Section", "general")
+	url := "https://example.com/popular-article"
+
+	service := NewPostServiceWithRedis(db, rdb)
+	firstReq := &models.CreatePostRequest{
+		SectionID: sectionID,
+		Content:   "First share",
+		Links:     []models.LinkRequest{{URL: url}},
+	}
+	if _, err := service.CreatePost(context.Background(), firstReq, uuid.MustParse(userID)); err != nil {
+		t.Fatalf("CreatePost failed: %v", err)
+	}
+
+	length, err := GetQueueLength(context.Background(), rdb)
+	if err != nil {
+		t.Fatalf("failed to get queue length: %v", err)
+	}
+	if length != 1 {
+		t.Fatalf("expected 1 metadata job for first post, got %d", length)
+	}
+
+	// Simulate the worker having fetched the URL
+	if err := CacheLinkMetadata(context.Background(), rdb, "general", url, map[string]interface{}{"title": "Popular Article"}); err != nil {
+		t.Fatalf("failed to cache metadata: %v", err)
+	}
+
+	secondReq := &models.CreatePostRequest{
+		SectionID: sectionID,
+		Content:   "Second share",
+		Links:     []models.LinkRequest{{URL: url}},
+	}
+	post, err := service.CreatePost(context.Background(), secondReq, uuid.MustParse(userID))
+	if err != nil {
+		t.Fatalf("CreatePost failed: %v", err)
+	}
+
+	length, err = GetQueueLength(context.Background(), rdb)
+	if err != nil {
+		t.Fatalf("failed to get queue length: %v", err)
+	}
+	if length != 1 {
+		t.Fatalf("expected no new metadata job for cached URL, got %d queued", length)
+	}
+
+	if len(post.Links) != 1 || post.Links[0].Metadata["title"] != "Popular Article" {
+		t.Fatalf("expected cached metadata on returned link, got %+v", post.Links)
+	}
+
+	var metadataJSON string
+	if err := db.QueryRow(`SELECT metadata FROM links WHERE post_id = $1`, post.ID).Scan(&metadataJSON); err != nil {
+		t.Fatalf("failed to query link metadata: %v", err)
+	}
+	if !strings.Contains(metadataJSON, "Popular Article") {
+		t.Fatalf("expected stored metadata to come from cache, got %s", metadataJSON)
+	}
+}
+
+func TestRefreshLinkMetadataQueuesForcedJob(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	rdb := setupMetadataQueueTestRedis(t)
+
+	userID := testutil.CreateTestUser(t, db, "refreshlinkpost", "refreshlinkpost@test.com", false, true)
+	sectionID := testutil.CreateTestSection(t, db, "Refresh Link Section", "general")
+	postID := testutil.CreateTestPost(t, db, userID, sectionID, "Refresh me")
+	url := "https://example.com/stale"
+
+	var linkID uuid.UUID
+	if err := db.QueryRow(`INSERT INTO links (id, post_id, url, created_at) VALUES (gen_random_uuid(), $1, $2, now()) RETURNING id`, postID, url).Scan(&linkID); err != nil {
+		t.Fatalf("failed to create link: %v", err)
+	}
+	if err := CacheLinkMetadata(context.Background(), rdb, "general", url, map[string]interface{}{"title": "Stale"}); err != nil {
+		t.Fatalf("failed to cache metadata: %v", err)
+	}
+
+	service := NewPostServiceWithRedis(db, rdb)
+	if err := service.RefreshLinkMetadata(context.Background(), linkID); err != nil {
+		t.Fatalf("RefreshLinkMetadata failed: %v", err)
+	}
+
+	cached, err := GetCachedLinkMetadata(context.Background(), rdb, "general", url)
+	if err != nil {
+		t.Fatalf("failed to read cache: %v", err)
+	}
+	if cached != nil {
+		t.Fatalf("expected cache entry to be invalidated, got %v", cached)
+	}
+
+	job, err := DequeueMetadataJob(context.Background(), rdb, 1*time.Second)
+	if err != nil {
+		t.Fatalf("failed to dequeue metadata job: %v", err)
+	}
+	if job == nil || job.LinkID != linkID || !job.ForceRefresh {
+		t.Fatalf("expected forced refresh job for link %s, got %+v", linkID, job)
+	}
+
+	if err := service.RefreshLinkMetadata(context.Background(), uuid.New()); !errors.Is(err, ErrLinkNotFound) {
+		t.Fatalf("expected ErrLinkNotFound, got %v", err)
+	}
+}
+
 func TestCreatePostWithHighlightsStoresSortedMetadata(t *testing.T) {
 	db := testutil.RequireTestDB(t)
 	t.Cleanup(func() { testutil.CleanupTables(t, db) })
@@ -315,6 +472,51 @@ func TestCreatePostWithHighlightsStoresSortedMetadata(t *testing.T) {
 	}
 }
 
+func TestHighlightsIncludeFormattedTimestamp(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	disableLinkMetadata(t)
+
+	userID := testutil.CreateTestUser(t, db, "formatteduser", "formatteduser@test.com", false, true)
+	sectionID := testutil.CreateTestSection(t, db, "Music Section", "music")
+
+	service := NewPostService(db)
+	post, err := service.CreatePost(context.Background(), &models.CreatePostRequest{
+		SectionID: sectionID,
+		Content:   "Long mix",
+		Links: []models.LinkRequest{
+			{
+				URL:        "https://example.com/mix",
+				Highlights: []models.Highlight{{Timestamp: 3725, Label: "Drop"}},
+			},
+		},
+	}, uuid.MustParse(userID))
+	if err != nil {
+		t.Fatalf("CreatePost failed: %v", err)
+	}
+	if got := post.Links[0].Highlights[0].FormattedTimestamp; got != "01:02:05" {
+		t.Fatalf("expected created highlight formatted as 01:02:05, got %q", got)
+	}
+
+	fetched, err := service.GetPostByID(context.Background(), post.ID, uuid.MustParse(userID))
+	if err != nil {
+		t.Fatalf("GetPostByID failed: %v", err)
+	}
+	highlight := fetched.Links[0].Highlights[0]
+	if highlight.Timestamp != 3725 || highlight.FormattedTimestamp != "01:02:05" {
+		t.Fatalf("expected 3725 seconds formatted as 01:02:05, got %+v", highlight)
+	}
+
+	var metadataBytes []byte
+	if err := db.QueryRow(`SELECT metadata FROM links WHERE post_id = $1`, post.ID).Scan(&metadataBytes); err != nil {
+		t.Fatalf("failed to query link metadata: %v", err)
+	}
+	if strings.Contains(string(metadataBytes), "formatted_timestamp") {
+		t.Fatalf("expected formatted timestamp not to be stored, got %s", metadataBytes)
+	}
+}
+
 func TestCreatePostRejectsHighlightsForNonMusicSection(t *testing.T) {
 	db := testutil.RequireTestDB(t)
 	t.Cleanup(func() { testutil.CleanupTables(t, db) })
@@ -909,6 +1111,58 @@ func TestGetPostByIDNonRecipeOmitsRecipeStats(t *testing.T) {
 	}
 }
 
+func TestGetUnreadFeedReturnsPostsAfterReadPosition(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	viewerID := testutil.CreateTestUser(t, db, "unreadfeedviewer", "unreadfeedviewer@test.com", false, true)
+	sectionID := testutil.CreateTestSection(t, db, "Unread", "general")
+	olderPostID := testutil.CreateTestPost(t, db, viewerID, sectionID, "Older post")
+	newerPostID := testutil.CreateTestPost(t, db, viewerID, sectionID, "Newer post")
+
+	now := time.Now().UTC()
+	if _, err := db.ExecContext(context.Background(), "UPDATE posts SET created_at = $1 WHERE id = $2", now.Add(-2*time.Hour), olderPostID); err != nil {
+		t.Fatalf("failed to backdate older post: %v", err)
+	}
+	if _, err := db.ExecContext(context.Background(), "UPDATE posts SET created_at = $1 WHERE id = $2", now.Add(-10*time.Minute), newerPostID); err != nil {
+		t.Fatalf("failed to backdate newer post: %v", err)
+	}
+
+	service := NewPostService(db)
+	feed, err := service.GetUnreadFeed(context.Background(), uuid.MustParse(sectionID), nil, 10, uuid.MustParse(viewerID), true)
+	if err != nil {
+		t.Fatalf("GetUnreadFeed failed: %v", err)
+	}
+	if len(feed.Posts) != 2 {
+		t.Fatalf("expected 2 posts without a read position, got %d", len(feed.Posts))
+	}
+
+	readAt := now.Add(-time.Hour)
+	sectionService := NewSectionService(db)
+	if _, err := sectionService.UpdateReadPosition(context.Background(), uuid.MustParse(viewerID), uuid.MustParse(sectionID), &readAt); err != nil {
+		t.Fatalf("UpdateReadPosition failed: %v", err)
+	}
+
+	feed, err = service.GetUnreadFeed(context.Background(), uuid.MustParse(sectionID), nil, 10, uuid.MustParse(viewerID), true)
+	if err != nil {
+		t.Fatalf("GetUnreadFeed failed: %v", err)
+	}
+	if len(feed.Posts) != 1 {
+		t.Fatalf("expected 1 unread post, got %d", len(feed.Posts))
+	}
+	if feed.Posts[0].ID.String() != newerPostID {
+		t.Fatalf("expected unread post %s, got %s", newerPostID, feed.Posts[0].ID)
+	}
+
+	fullFeed, err := service.GetFeed(context.Background(), uuid.MustParse(sectionID), nil, 10, uuid.MustParse(viewerID), true)
+	if err != nil {
+		t.Fatalf("GetFeed failed: %v", err)
+	}
+	if len(fullFeed.Posts) != 2 {
+		t.Fatalf("expected full feed to keep 2 posts, got %d", len(fullFeed.Posts))
+	}
+}
+
 func TestGetFeedIncludesRecipeStatsForRecipeSection(t *testing.T) {
 	db := testutil.RequireTestDB(t)
 	t.Cleanup(func() { testutil.CleanupTables(t, db) })
@@ -934,7 +1188,7 @@ func TestGetFeedIncludesRecipeStatsForRecipeSection(t *testing.T) {
 	}
 
 	service := NewPostService(db)
-	feed, err := service.GetFeed(context.Background(), uuid.MustParse(sectionID), nil, 10, uuid.MustParse(viewerID))
+	feed, err := service.GetFeed(context.Background(), uuid.MustParse(sectionID), nil, 10, uuid.MustParse(viewerID), true)
 	if err != nil {
 		t.Fatalf("GetFeed failed: %v", err)
 	}
@@ -1097,7 +1351,7 @@ func TestGetFeedIncludesMovieStatsForMovieSection(t *testing.T) {
 	}
 
 	service := NewPostService(db)
-	feed, err := service.GetFeed(context.Background(), uuid.MustParse(sectionID), nil, 10, uuid.MustParse(viewerID))
+	feed, err := service.GetFeed(context.Background(), uuid.MustParse(sectionID), nil, 10, uuid.MustParse(viewerID), true)
 	if err != nil {
 		t.Fatalf("GetFeed failed: %v", err)
 	}
@@ -1291,7 +1545,7 @@ func TestGetFeedIncludesBookStatsForBookSection(t *testing.T) {
 	}
 
 	service := NewPostService(db)
-	feed, err := service.GetFeed(context.Background(), uuid.MustParse(sectionID), nil, 10, uuid.MustParse(viewerID))
+	feed, err := service.GetFeed(context.Background(), uuid.MustParse(sectionID), nil, 10, uuid.MustParse(viewerID), true)
 	if err != nil {
 		t.Fatalf("GetFeed failed: %v", err)
 	}
@@ -1407,7 +1661,7 @@ func TestGetPostsByUserIDIncludesBookStatsForBookPosts(t *testing.T) {
 	}
 
 	service := NewPostService(db)
-	feed, err := service.GetPostsByUserID(context.Background(), uuid.MustParse(authorID), nil, 10, uuid.MustParse(viewerID))
+	feed, err := service.GetPostsByUserID(context.Background(), uuid.MustParse(authorID), nil, 10, uuid.MustParse(viewerID), true)
 	if err != nil {
 		t.Fatalf("GetPostsByUserID failed: %v", err)
 	}
@@ -1521,7 +1775,7 @@ func TestGetMovieFeedIncludesMovieAndSeriesWithPagination(t *testing.T) {
 
 	service := NewPostService(db)
 
-	firstPage, err := service.GetMovieFeed(context.Background(), nil, 1, uuid.MustParse(viewerID), nil)
+	firstPage, err := service.GetMovieFeed(context.Background(), nil, 1, uuid.MustParse(viewerID), nil, true)
 	if err != nil {
 		t.Fatalf("GetMovieFeed first page failed: %v", err)
 	}
@@ -1547,7 +1801,7 @@ func TestGetMovieFeedIncludesMovieAndSeriesWithPagination(t *testing.T) {
 		t.Fatalf("expected series watch count 1, got %d", firstPost.MovieStats.WatchCount)
 	}
 
-	secondPage, err := service.GetMovieFeed(context.Background(), firstPage.NextCursor, 10, uuid.MustParse(viewerID), nil)
+	secondPage, err := service.GetMovieFeed(context.Background(), firstPage.NextCursor, 10, uuid.MustParse(viewerID), nil, true)
 	if err != nil {
 		t.Fatalf("GetMovieFeed second page failed: %v", err)
 	}
@@ -1600,6 +1854,7 @@ func TestGetMovieFeedFiltersBySectionType(t *testing.T) {
 		10,
 		uuid.MustParse(viewerID),
 		&seriesType,
+		true,
 	)
 	if err != nil {
 		t.Fatalf("GetMovieFeed series filter failed: %v", err)
@@ -1619,6 +1874,7 @@ func TestGetMovieFeedFiltersBySectionType(t *testing.T) {
 		10,
 		uuid.MustParse(viewerID),
 		&movieType,
+		true,
 	)
 	if err != nil {
 		t.Fatalf("GetMovieFeed movie filter failed: %v", err)
@@ -1690,7 +1946,7 @@ func TestGetPostsByUserIDIncludesMovieStatsForMovieAndSeries(t *testing.T) {
 	}
 
 	service := NewPostService(db)
-	feed, err := service.GetPostsByUserID(context.Background(), uuid.MustParse(authorID), nil, 10, uuid.MustParse(viewerID))
+	feed, err := service.GetPostsByUserID(context.Background(), uuid.MustParse(authorID), nil, 10, uuid.MustParse(viewerID), true)
 	if err != nil {
 		t.Fatalf("GetPostsByUserID failed: %v", err)
 	}
@@ -1859,6 +2115,42 @@ func TestUpdatePostCreatesAuditLogWithMetadata(t *testing.T) {
 	}
 }
 
+func TestUpdatePostEditGracePeriod(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	setSettingsForTest(t, func(settings *Settings) { settings.EditGracePeriod = 5 * time.Minute })
+
+	userID := testutil.CreateTestUser(t, db, "gracepostuser", "gracepost@test.com", false, true)
+	sectionID := testutil.CreateTestSection(t, db, "Grace Post Section", "general")
+	postID := testutil.CreateTestPost(t, db, userID, sectionID, "Original post content")
+
+	service := NewPostService(db)
+	post, err := service.UpdatePost(context.Background(), uuid.MustParse(postID), uuid.MustParse(userID), &models.UpdatePostRequest{
+		Content: "Fixed typo content",
+	})
+	if err != nil {
+		t.Fatalf("UpdatePost failed: %v", err)
+	}
+	if post.UpdatedAt != nil {
+		t.Fatalf("expected updated_at to stay null within grace period, got %v", post.UpdatedAt)
+	}
+
+	if _, err := db.Exec(`UPDATE posts SET created_at = now() - interval '10 minutes' WHERE id = $1`, postID); err != nil {
+		t.Fatalf("failed to backdate post: %v", err)
+	}
+
+	post, err = service.UpdatePost(context.Background(), uuid.MustParse(postID), uuid.MustParse(userID), &models.UpdatePostRequest{
+		Content: "Later edit content",
+	})
+	if err != nil {
+		t.Fatalf("UpdatePost failed: %v", err)
+	}
+	if post.UpdatedAt == nil {
+		t.Fatal("expected updated_at to be set after grace period")
+	}
+}
+
 func TestUpdatePostRemovesLinkMetadata(t *testing.T) {
 	db := testutil.RequireTestDB(t)
 	t.Cleanup(func() { testutil.CleanupTables(t, db) })
@@ -2323,7 +2615,7 @@ func TestUpdatePostWithPodcastMetadataStoresPodcastPayloadAndReturnsFeedShape(t
 		}
 	}
 
-	feed, err := service.GetFeed(context.Background(), uuid.MustParse(sectionID), nil, 10, uuid.MustParse(userID))
+	feed, err := service.GetFeed(context.Background(), uuid.MustParse(sectionID), nil, 10, uuid.MustParse(userID), true)
 	if err != nil {
 		t.Fatalf("GetFeed failed: %v", err)
 	}
@@ -2346,6 +2638,144 @@ func TestUpdatePostWithPodcastMetadataStoresPodcastPayloadAndReturnsFeedShape(t
 	}
 }
 
+func TestGetPostCountsMatchesGetPostByID(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	authorID := testutil.CreateTestUser(t, db, "countsauthor", "countsauthor@test.com", false, true)
+	viewerID := testutil.CreateTestUser(t, db, "countsviewer", "countsviewer@test.com", false, true)
+	sectionID := testutil.CreateTestSection(t, db, "Counts Section", "general")
+	postID := testutil.CreateTestPost(t, db, authorID, sectionID, "Counts post")
+
+	testutil.CreateTestComment(t, db, viewerID, postID, "First comment")
+	testutil.CreateTestComment(t, db, authorID, postID, "Second comment")
+	deletedCommentID := testutil.CreateTestComment(t, db, viewerID, postID, "Deleted comment")
+	if _, err := db.Exec(`UPDATE comments SET deleted_at = now() WHERE id = $1`, deletedCommentID); err != nil {
+		t.Fatalf("failed to delete comment: %v", err)
+	}
+
+	for _, reaction := range []struct {
+		userID string
+		emoji  string
+	}{
+		{userID: authorID, emoji: "👍"},
+		{userID: viewerID, emoji: "👍"},
+		{userID: viewerID, emoji: "🔥"},
+	} {
+		if _, err := db.Exec(`
+			INSERT INTO reactions (id, user_id, post_id, emoji, created_at)
+			VALUES (gen_random_uuid(), $1, $2, $3, now())
+		`, reaction.userID, postID, reaction.emoji); err != nil {
+			t.Fatalf("failed to create reaction: %v", err)
+		}
+	}
+
+	service := NewPostService(db)
+	counts, err := service.GetPostCounts(context.Background(), uuid.MustParse(postID))
+	if err != nil {
+		t.Fatalf("GetPostCounts failed: %v", err)
+	}
+
+	post, err := service.GetPostByID(context.Background(), uuid.MustParse(postID), uuid.MustParse(viewerID))
+	if err != nil {
+		t.Fatalf("GetPostByID failed: %v", err)
+	}
+
+	if post.CommentCount == nil || counts.CommentCount != *post.CommentCount {
+		t.Errorf("expected comment count %v, got %d", post.CommentCount, counts.CommentCount)
+	}
+	if counts.CommentCount != 2 {
+		t.Errorf("expected comment count 2, got %d", counts.CommentCount)
+	}
+	if len(counts.ReactionCounts) != len(post.ReactionCounts) {
+		t.Fatalf("expected reaction counts %v, got %v", post.ReactionCounts, counts.ReactionCounts)
+	}
+	for emoji, count := range post.ReactionCounts {
+		if counts.ReactionCounts[emoji] != count {
+			t.Errorf("expected %d %s reactions, got %d", count, emoji, counts.ReactionCounts[emoji])
+		}
+	}
+	if counts.ReactionCount != 3 {
+		t.Errorf("expected reaction count 3, got %d", counts.ReactionCount)
+	}
+}
+
+func TestGetPostCountsDeletedPostNotFound(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	userID := testutil.CreateTestUser(t, db, "countsdeleted", "countsdeleted@test.com", false, true)
+	sectionID := testutil.CreateTestSection(t, db, "Counts Deleted Section", "general")
+	postID := testutil.CreateTestPost(t, db, userID, sectionID, "Deleted counts post")
+	if _, err := db.Exec(`UPDATE posts SET deleted_at = now() WHERE id = $1`, postID); err != nil {
+		t.Fatalf("failed to delete post: %v", err)
+	}
+
+	service := NewPostService(db)
+	if _, err := service.GetPostCounts(context.Background(), uuid.MustParse(postID)); !errors.Is(err, ErrPostNotFound) {
+		t.Fatalf("expected ErrPostNotFound, got %v", err)
+	}
+}
+
+func TestGetPostRatingDistributionCountsWatchLogs(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	authorID := testutil.CreateTestUser(t, db, "distauthor", "distauthor@test.com", false, true)
+	sectionID := testutil.CreateTestSection(t, db, "Distribution Movies", "movie")
+	postID := testutil.CreateTestPost(t, db, authorID, sectionID, "Distribution movie")
+
+	for i, rating := range []int{5, 5, 3, 1} {
+		userID := testutil.CreateTestUser(t, db, fmt.Sprintf("distwatcher%d", i), fmt.Sprintf("distwatcher%d@test.com", i), false, true)
+		if _, err := db.Exec(`
+			INSERT INTO watch_logs (id, user_id, post_id, rating, watched_at, created_at)
+			VALUES (gen_random_uuid(), $1, $2, $3, now(), now())
+		`, userID, postID, rating); err != nil {
+			t.Fatalf("failed to create watch log: %v", err)
+		}
+	}
+	deletedUserID := testutil.CreateTestUser(t, db, "distdeleted", "distdeleted@test.com", false, true)
+	if _, err := db.Exec(`
+		INSERT INTO watch_logs (id, user_id, post_id, rating, watched_at, created_at, deleted_at)
+		VALUES (gen_random_uuid(), $1, $2, 2, now(), now(), now())
+	`, deletedUserID, postID); err != nil {
+		t.Fatalf("failed to create deleted watch log: %v", err)
+	}
+
+	service := NewPostService(db)
+	distribution, err := service.GetPostRatingDistribution(context.Background(), uuid.MustParse(postID))
+	if err != nil {
+		t.Fatalf("GetPostRatingDistribution failed: %v", err)
+	}
+
+	expected := map[int]int{1: 1, 2: 0, 3: 1, 4: 0, 5: 2}
+	for rating, count := range expected {
+		if distribution.Counts[rating] != count {
+			t.Errorf("expected %d ratings of %d, got %d", count, rating, distribution.Counts[rating])
+		}
+	}
+	if distribution.TotalRatings != 4 {
+		t.Errorf("expected total ratings 4, got %d", distribution.TotalRatings)
+	}
+	if distribution.SectionType != "movie" {
+		t.Errorf("expected section type movie, got %s", distribution.SectionType)
+	}
+}
+
+func TestGetPostRatingDistributionRejectsUnsupportedSection(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	userID := testutil.CreateTestUser(t, db, "distgeneral", "distgeneral@test.com", false, true)
+	sectionID := testutil.CreateTestSection(t, db, "Distribution General", "general")
+	postID := testutil.CreateTestPost(t, db, userID, sectionID, "General post")
+
+	service := NewPostService(db)
+	if _, err := service.GetPostRatingDistribution(context.Background(), uuid.MustParse(postID)); !errors.Is(err, ErrRatingsNotSupported) {
+		t.Fatalf("expected ErrRatingsNotSupported, got %v", err)
+	}
+}
+
 func TestDeletePostOwner(t *testing.T) {
 	db := testutil.RequireTestDB(t)
 	t.Cleanup(func() { testutil.CleanupTables(t, db) })
@@ -2531,29 +2961,116 @@ func TestHardDeletePostCreatesAuditLog(t *testing.T) {
 	}
 }
 
-func TestAdminDeletePostCreatesAuditLogWithMetadata(t *testing.T) {
+func TestHardDeletePostNotifiesCommentersOnce(t *testing.T) {
 	db := testutil.RequireTestDB(t)
 	t.Cleanup(func() { testutil.CleanupTables(t, db) })
 
-	userID := testutil.CreateTestUser(t, db, "moderateduser", "moderateduser@test.com", false, true)
-	adminID := testutil.CreateTestUser(t, db, "moderatoradmin", "moderatoradmin@test.com", true, true)
-	sectionID := testutil.CreateTestSection(t, db, "Moderation Section", "general")
-	content := strings.Repeat("a", 150)
-	postID := testutil.CreateTestPost(t, db, userID, sectionID, content)
+	authorID := testutil.CreateTestUser(t, db, "removedpostauthor", "removedpostauthor@test.com", false, true)
+	commenterID := testutil.CreateTestUser(t, db, "removedpostcommenter", "removedpostcommenter@test.com", false, true)
+	mutedID := testutil.CreateTestUser(t, db, "removedpostmuted", "removedpostmuted@test.com", false, true)
+	adminID := testutil.CreateTestUser(t, db, "removedpostadmin", "removedpostadmin@test.com", true, true)
+	sectionID := testutil.CreateTestSection(t, db, "Removal Section", "general")
+	postID := testutil.CreateTestPost(t, db, authorID, sectionID, "Post to remove")
+
+	testutil.CreateTestComment(t, db, commenterID, postID, "First comment")
+	testutil.CreateTestComment(t, db, commenterID, postID, "Second comment")
+	testutil.CreateTestComment(t, db, mutedID, postID, "Muted comment")
+	testutil.CreateTestComment(t, db, adminID, postID, "Admin comment")
+
+	if _, err := db.Exec(`INSERT INTO section_subscriptions (user_id, section_id, opted_out_at) VALUES ($1, $2, now())`, mutedID, sectionID); err != nil {
+		t.Fatalf("failed to opt out of section: %v", err)
+	}
 
 	service := NewPostService(db)
-	_, err := service.DeletePost(context.Background(), uuid.MustParse(postID), uuid.MustParse(adminID), true)
-	if err != nil {
-		t.Fatalf("DeletePost failed: %v", err)
+	if err := service.HardDeletePost(context.Background(), uuid.MustParse(postID), uuid.MustParse(adminID)); err != nil {
+		t.Fatalf("HardDeletePost failed: %v", err)
 	}
 
-	var relatedPostID uuid.UUID
-	var metadataBytes []byte
-	err = db.QueryRow(`
-		SELECT related_post_id, metadata
-		FROM audit_logs
-		WHERE admin_user_id = $1 AND action = 'delete_post'
-	`, adminID).Scan(&relatedPostID, &metadataBytes)
+	rows, err := db.Query(`SELECT user_id, related_user_id FROM notifications WHERE type = $1`, notificationTypePostRemoved)
+	if err != nil {
+		t.Fatalf("failed to query notifications: %v", err)
+	}
+	defer rows.Close()
+
+	notified := make(map[string]int)
+	for rows.Next() {
+		var userID string
+		var relatedUserID string
+		if err := rows.Scan(&userID, &relatedUserID); err != nil {
+			t.Fatalf("failed to scan notification: %v", err)
+		}
+		if relatedUserID != authorID {
+			t.Errorf("expected related user %s, got %s", authorID, relatedUserID)
+		}
+		notified[userID]++
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatalf("failed to iterate notifications: %v", err)
+	}
+
+	if len(notified) != 1 || notified[commenterID] != 1 {
+		t.Fatalf("expected exactly one notification for commenter, got %v", notified)
+	}
+
+	notifications, _, _, _, err := NewNotificationService(db, nil, nil).GetNotifications(context.Background(), uuid.MustParse(commenterID), 10, nil)
+	if err != nil {
+		t.Fatalf("GetNotifications failed: %v", err)
+	}
+	if len(notifications) != 1 || notifications[0].ContentExcerpt == nil || *notifications[0].ContentExcerpt != "Post to remove" {
+		t.Fatalf("expected removal notification to carry the post excerpt, got %+v", notifications)
+	}
+}
+
+func TestHardDeletePostSkipsCommenterNotificationsWhenDisabled(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	setSettingsForTest(t, func(settings *Settings) { settings.PostRemovalNotificationsEnabled = false })
+
+	authorID := testutil.CreateTestUser(t, db, "quietremovalauthor", "quietremovalauthor@test.com", false, true)
+	commenterID := testutil.CreateTestUser(t, db, "quietremovalcommenter", "quietremovalcommenter@test.com", false, true)
+	adminID := testutil.CreateTestUser(t, db, "quietremovaladmin", "quietremovaladmin@test.com", true, true)
+	sectionID := testutil.CreateTestSection(t, db, "Quiet Removal Section", "general")
+	postID := testutil.CreateTestPost(t, db, authorID, sectionID, "Quietly removed post")
+	testutil.CreateTestComment(t, db, commenterID, postID, "Comment")
+
+	service := NewPostService(db)
+	if err := service.HardDeletePost(context.Background(), uuid.MustParse(postID), uuid.MustParse(adminID)); err != nil {
+		t.Fatalf("HardDeletePost failed: %v", err)
+	}
+
+	var count int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM notifications WHERE type = $1`, notificationTypePostRemoved).Scan(&count); err != nil {
+		t.Fatalf("failed to count notifications: %v", err)
+	}
+	if count != 0 {
+		t.Fatalf("expected no post removal notifications, got %d", count)
+	}
+}
+
+func TestAdminDeletePostCreatesAuditLogWithMetadata(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	userID := testutil.CreateTestUser(t, db, "moderateduser", "moderateduser@test.com", false, true)
+	adminID := testutil.CreateTestUser(t, db, "moderatoradmin", "moderatoradmin@test.com", true, true)
+	sectionID := testutil.CreateTestSection(t, db, "Moderation Section", "general")
+	content := strings.Repeat("a", 150)
+	postID := testutil.CreateTestPost(t, db, userID, sectionID, content)
+
+	service := NewPostService(db)
+	_, err := service.DeletePost(context.Background(), uuid.MustParse(postID), uuid.MustParse(adminID), true)
+	if err != nil {
+		t.Fatalf("DeletePost failed: %v", err)
+	}
+
+	var relatedPostID uuid.UUID
+	var metadataBytes []byte
+	err = db.QueryRow(`
+		SELECT related_post_id, metadata
+		FROM audit_logs
+		WHERE admin_user_id = $1 AND action = 'delete_post'
+	`, adminID).Scan(&relatedPostID, &metadataBytes)
 	if err != nil {
 		t.Fatalf("failed to query audit log: %v", err)
 	}
@@ -2597,6 +3114,75 @@ func TestAdminDeletePostCreatesAuditLogWithMetadata(t *testing.T) {
 	}
 }
 
+func TestIsImageLinkUsesFetchedContentType(t *testing.T) {
+	tests := []struct {
+		name string
+		link models.Link
+		want bool
+	}{
+		{
+			name: "extension",
+			link: models.Link{URL: "https://example.com/photo.png"},
+			want: true,
+		},
+		{
+			name: "no extension with image content type",
+			link: models.Link{
+				URL:      "https://example.com/media/12345",
+				Metadata: map[string]interface{}{"content_type": "image/webp"},
+			},
+			want: true,
+		},
+		{
+			name: "no extension with html content type",
+			link: models.Link{
+				URL:      "https://example.com/media/12345",
+				Metadata: map[string]interface{}{"content_type": "text/html"},
+			},
+			want: false,
+		},
+		{
+			name: "image extension with html content type",
+			link: models.Link{
+				URL:      "https://example.com/gallery/photo.jpg",
+				Metadata: map[string]interface{}{"content_type": "text/html"},
+			},
+			want: false,
+		},
+		{
+			name: "no extension without metadata",
+			link: models.Link{URL: "https://example.com/media/12345"},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isImageLink(tt.link); got != tt.want {
+				t.Errorf("isImageLink() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFindPrimaryNonImageLinkSkipsImageContentType(t *testing.T) {
+	links := []models.Link{
+		{
+			URL:      "https://cdn.example.com/abc123",
+			Metadata: map[string]interface{}{"content_type": "image/jpeg"},
+		},
+		{URL: "https://example.com/article"},
+	}
+
+	primary := findPrimaryNonImageLink(links)
+	if primary == nil {
+		t.Fatal("expected a primary non-image link")
+	}
+	if primary.URL != "https://example.com/article" {
+		t.Errorf("expected primary link https://example.com/article, got %s", primary.URL)
+	}
+}
+
 func TestLinkRequestsMatchExistingLinks_PodcastNotesUseValueComparison(t *testing.T) {
 	existingNote := "Same note value"
 	requestedNote := "Same note value"
@@ -2643,11 +3229,11 @@ func disableLinkMetadata(t *testing.T) {
 	config := GetConfigService()
 	current := config.GetConfig().LinkMetadataEnabled
 	disabled := false
-	if _, err := config.UpdateConfig(context.Background(), &disabled, nil, nil); err != nil {
+	if _, err := config.UpdateConfig(context.Background(), ConfigUpdate{LinkMetadataEnabled: &disabled}); err != nil {
 		t.Fatalf("failed to disable link metadata: %v", err)
 	}
 	t.Cleanup(func() {
-		if _, err := config.UpdateConfig(context.Background(), &current, nil, nil); err != nil {
+		if _, err := config.UpdateConfig(context.Background(), ConfigUpdate{LinkMetadataEnabled: &current}); err != nil {
 			t.Fatalf("failed to restore link metadata: %v", err)
 		}
 	})
@@ -2670,11 +3256,11 @@ func TestCreatePost_QueueFailure_DoesNotFailPost(t *testing.T) {
 	config := GetConfigService()
 	current := config.GetConfig().LinkMetadataEnabled
 	enabled := true
-	if _, err := config.UpdateConfig(context.Background(), &enabled, nil, nil); err != nil {
+	if _, err := config.UpdateConfig(context.Background(), ConfigUpdate{LinkMetadataEnabled: &enabled}); err != nil {
 		t.Fatalf("failed to enable link metadata: %v", err)
 	}
 	t.Cleanup(func() {
-		if _, err := config.UpdateConfig(context.Background(), &current, nil, nil); err != nil {
+		if _, err := config.UpdateConfig(context.Background(), ConfigUpdate{LinkMetadataEnabled: &current}); err != nil {
 			t.Fatalf("failed to restore link metadata: %v", err)
 		}
 	})
@@ -2702,3 +3288,402 @@ func TestCreatePost_QueueFailure_DoesNotFailPost(t *testing.T) {
 		t.Fatalf("expected post")
 	}
 }
+
+func TestCreatePostEnforcesAllowedImageHosts(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	disableLinkMetadata(t)
+	config := GetConfigService()
+	previousHosts := config.GetConfig().AllowedImageHosts
+	allowed := []string{"images.example.com"}
+	if _, err := config.UpdateConfig(context.Background(), ConfigUpdate{AllowedImageHosts: &allowed}); err != nil {
+		t.Fatalf("failed to set allowed image hosts: %v", err)
+	}
+	t.Cleanup(func() {
+		if _, err := config.UpdateConfig(context.Background(), ConfigUpdate{AllowedImageHosts: &previousHosts}); err != nil {
+			t.Fatalf("failed to restore allowed image hosts: %v", err)
+		}
+	})
+
+	userID := uuid.MustParse(testutil.CreateTestUser(t, db, "imagehostuser", "imagehostuser@test.com", false, true))
+	sectionID := testutil.CreateTestSection(t, db, "Image Section", "general")
+	service := NewPostService(db)
+
+	_, err := service.CreatePost(context.Background(), &models.CreatePostRequest{
+		SectionID: sectionID,
+		Images:    []models.PostImageRequest{{URL: "https://elsewhere.example.net/photo.jpg"}},
+	}, userID)
+	if err == nil || err.Error() != "image host is not allowed" {
+		t.Fatalf("expected image host error, got %v", err)
+	}
+
+	post, err := service.CreatePost(context.Background(), &models.CreatePostRequest{
+		SectionID: sectionID,
+		Images: []models.PostImageRequest{
+			{URL: "https://images.example.com/photo.jpg"},
+			{URL: "/api/v1/uploads/" + userID.String() + "/upload.png"},
+		},
+	}, userID)
+	if err != nil {
+		t.Fatalf("CreatePost with allowlisted image failed: %v", err)
+	}
+	if len(post.Images) != 2 {
+		t.Fatalf("expected 2 images, got %d", len(post.Images))
+	}
+
+	disallowed := []models.PostImageRequest{{URL: "https://elsewhere.example.net/photo.jpg"}}
+	_, err = service.UpdatePost(context.Background(), post.ID, userID, &models.UpdatePostRequest{
+		Content: "Updated",
+		Images:  &disallowed,
+	})
+	if err == nil || err.Error() != "image host is not allowed" {
+		t.Fatalf("expected image host error on update, got %v", err)
+	}
+}
+
+func TestCreatePostRequiresContentForConfiguredSectionTypes(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	disableLinkMetadata(t)
+	setSettingsForTest(t, func(settings *Settings) { settings.ContentRequiredSectionTypes = []string{"recipe"} })
+
+	userID := uuid.MustParse(testutil.CreateTestUser(t, db, "contentrequser", "contentrequser@test.com", false, true))
+	recipeSectionID := testutil.CreateTestSection(t, db, "Recipes", "recipe")
+	generalSectionID := testutil.CreateTestSection(t, db, "General", "general")
+	service := NewPostService(db)
+
+	_, err := service.CreatePost(context.Background(), &models.CreatePostRequest{
+		SectionID: recipeSectionID,
+		Links:     []models.LinkRequest{{URL: "https://example.com/recipe"}},
+	}, userID)
+	if err == nil || err.Error() != "content is required for this section" {
+		t.Fatalf("expected content required error for recipe section, got %v", err)
+	}
+
+	post, err := service.CreatePost(context.Background(), &models.CreatePostRequest{
+		SectionID: generalSectionID,
+		Links:     []models.LinkRequest{{URL: "https://example.com/article"}},
+	}, userID)
+	if err != nil {
+		t.Fatalf("expected link-only general post to succeed, got %v", err)
+	}
+	if len(post.Links) != 1 {
+		t.Fatalf("expected 1 link, got %d", len(post.Links))
+	}
+}
+
+func TestIsContentRequiredForSectionType(t *testing.T) {
+	setSettingsForTest(t, func(settings *Settings) { settings.ContentRequiredSectionTypes = []string{" Recipe ", "", "book"} })
+
+	for sectionType, expected := range map[string]bool{
+		"recipe":  true,
+		"book":    true,
+		"general": false,
+		"":        false,
+	} {
+		if got := IsContentRequiredForSectionType(sectionType); got != expected {
+			t.Errorf("IsContentRequiredForSectionType(%q) = %v, want %v", sectionType, got, expected)
+		}
+	}
+}
+
+func TestValidateUpdatePostForSectionRequiresContent(t *testing.T) {
+	setSettingsForTest(t, func(settings *Settings) { settings.ContentRequiredSectionTypes = []string{"recipe"} })
+
+	empty := &models.UpdatePostRequest{Content: "  "}
+	if err := validateUpdatePostForSection(empty, "recipe"); err == nil || err.Error() != "content is required for this section" {
+		t.Fatalf("expected content required error for recipe section, got %v", err)
+	}
+	if err := validateUpdatePostForSection(&models.UpdatePostRequest{Content: "Updated recipe"}, "recipe"); err != nil {
+		t.Fatalf("expected recipe update with content to be valid, got %v", err)
+	}
+}
+
+func TestCreatePostRequiresMediaForConfiguredSectionTypes(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	disableLinkMetadata(t)
+	setSettingsForTest(t, func(settings *Settings) { settings.MediaRequiredSectionTypes = []string{"photos"} })
+
+	userID := uuid.MustParse(testutil.CreateTestUser(t, db, "mediarequser", "mediarequser@test.com", false, true))
+	photosSectionID := testutil.CreateTestSection(t, db, "Photos", "photos")
+	service := NewPostService(db)
+
+	_, err := service.CreatePost(context.Background(), &models.CreatePostRequest{
+		SectionID: photosSectionID,
+		Content:   "Just some text",
+	}, userID)
+	if err == nil || err.Error() != "media is required for this section" {
+		t.Fatalf("expected media required error for photos section, got %v", err)
+	}
+
+	post, err := service.CreatePost(context.Background(), &models.CreatePostRequest{
+		SectionID: photosSectionID,
+		Content:   "Sunset",
+		Images:    []models.PostImageRequest{{URL: "/api/v1/uploads/" + userID.String() + "/sunset.png"}},
+	}, userID)
+	if err != nil {
+		t.Fatalf("expected image post to succeed, got %v", err)
+	}
+	if len(post.Images) != 1 {
+		t.Fatalf("expected 1 image, got %d", len(post.Images))
+	}
+}
+
+func TestValidateCreatePostInputRequiresMedia(t *testing.T) {
+	setSettingsForTest(t, func(settings *Settings) { settings.MediaRequiredSectionTypes = []string{" Photos "} })
+
+	textOnly := &models.CreatePostRequest{SectionID: uuid.New().String(), Content: "No pictures"}
+	if err := validateCreatePostInput(textOnly, "photos"); err == nil || err.Error() != "media is required for this section" {
+		t.Fatalf("expected media required error, got %v", err)
+	}
+	if err := validateCreatePostInput(textOnly, "general"); err != nil {
+		t.Fatalf("expected text-only general post to be valid, got %v", err)
+	}
+
+	withImage := &models.CreatePostRequest{
+		SectionID: uuid.New().String(),
+		Images:    []models.PostImageRequest{{URL: "/api/v1/uploads/" + uuid.New().String() + "/photo.png"}},
+	}
+	if err := validateCreatePostInput(withImage, "photos"); err != nil {
+		t.Fatalf("expected image post to be valid, got %v", err)
+	}
+}
+
+func enableLinkMetadata(t *testing.T) {
+	t.Helper()
+	config := GetConfigService()
+	current := config.GetConfig().LinkMetadataEnabled
+	enabled := true
+	if _, err := config.UpdateConfig(context.Background(), ConfigUpdate{LinkMetadataEnabled: &enabled}); err != nil {
+		t.Fatalf("failed to enable link metadata: %v", err)
+	}
+	t.Cleanup(func() {
+		if _, err := config.UpdateConfig(context.Background(), ConfigUpdate{LinkMetadataEnabled: &current}); err != nil {
+			t.Fatalf("failed to restore link metadata: %v", err)
+		}
+	})
+}
+
+func TestUpdatePost_ChangedLinkURLEnqueuesOneJob(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	enableLinkMetadata(t)
+	rdb := setupMetadataQueueTestRedis(t)
+
+	userID := uuid.MustParse(testutil.CreateTestUser(t, db, "editlinkjob", "editlinkjob@test.com", false, true))
+	sectionID := testutil.CreateTestSection(t, db, "Edit Link Section", "general")
+	service := NewPostServiceWithRedis(db, rdb)
+
+	post, err := service.CreatePost(context.Background(), &models.CreatePostRequest{
+		SectionID: sectionID,
+		Content:   "Original",
+		Links:     []models.LinkRequest{{URL: "https://example.com/original"}},
+	}, userID)
+	if err != nil {
+		t.Fatalf("CreatePost failed: %v", err)
+	}
+	if err := rdb.Del(context.Background(), MetadataQueueKey).Err(); err != nil {
+		t.Fatalf("failed to clear metadata queue: %v", err)
+	}
+
+	newURL := "https://example.com/replacement"
+	updated, err := service.UpdatePost(context.Background(), post.ID, userID, &models.UpdatePostRequest{
+		Content: "Original",
+		Links:   &[]models.LinkRequest{{URL: newURL}},
+	})
+	if err != nil {
+		t.Fatalf("UpdatePost failed: %v", err)
+	}
+
+	length, err := GetQueueLength(context.Background(), rdb)
+	if err != nil {
+		t.Fatalf("failed to get queue length: %v", err)
+	}
+	if length != 1 {
+		t.Fatalf("expected 1 metadata job, got %d", length)
+	}
+
+	job, err := DequeueMetadataJob(context.Background(), rdb, time.Second)
+	if err != nil {
+		t.Fatalf("failed to dequeue metadata job: %v", err)
+	}
+	if job == nil || job.URL != newURL {
+		t.Fatalf("expected metadata job for %s, got %+v", newURL, job)
+	}
+	if len(updated.Links) != 1 || job.LinkID != updated.Links[0].ID {
+		t.Fatalf("expected job for new link, got job %+v and links %+v", job, updated.Links)
+	}
+}
+
+func TestUpdatePost_UnchangedLinkURLEnqueuesNoJob(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	enableLinkMetadata(t)
+	rdb := setupMetadataQueueTestRedis(t)
+
+	userID := uuid.MustParse(testutil.CreateTestUser(t, db, "keeplinkjob", "keeplinkjob@test.com", false, true))
+	sectionID := testutil.CreateTestSection(t, db, "Keep Link Section", "general")
+	service := NewPostServiceWithRedis(db, rdb)
+
+	url := "https://example.com/kept"
+	post, err := service.CreatePost(context.Background(), &models.CreatePostRequest{
+		SectionID: sectionID,
+		Content:   "Original",
+		Links:     []models.LinkRequest{{URL: url}},
+	}, userID)
+	if err != nil {
+		t.Fatalf("CreatePost failed: %v", err)
+	}
+	if err := rdb.Del(context.Background(), MetadataQueueKey).Err(); err != nil {
+		t.Fatalf("failed to clear metadata queue: %v", err)
+	}
+
+	if _, err := service.UpdatePost(context.Background(), post.ID, userID, &models.UpdatePostRequest{
+		Content: "Edited",
+		Links:   &[]models.LinkRequest{{URL: url}},
+	}); err != nil {
+		t.Fatalf("UpdatePost failed: %v", err)
+	}
+
+	length, err := GetQueueLength(context.Background(), rdb)
+	if err != nil {
+		t.Fatalf("failed to get queue length: %v", err)
+	}
+	if length != 0 {
+		t.Fatalf("expected no metadata jobs, got %d", length)
+	}
+}
+
+func TestGetMoreFromAuthorReturnsOtherSectionPosts(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	authorID := testutil.CreateTestUser(t, db, "moreauthor", "moreauthor@test.com", false, true)
+	otherUserID := testutil.CreateTestUser(t, db, "moreother", "moreother@test.com", false, true)
+	sectionID := testutil.CreateTestSection(t, db, "General", "general")
+	otherSectionID := testutil.CreateTestSection(t, db, "Music", "music")
+
+	currentPostID := testutil.CreateTestPost(t, db, authorID, sectionID, "Current post")
+	olderPostID := testutil.CreateTestPost(t, db, authorID, sectionID, "Older post")
+	newerPostID := testutil.CreateTestPost(t, db, authorID, sectionID, "Newer post")
+	deletedPostID := testutil.CreateTestPost(t, db, authorID, sectionID, "Deleted post")
+	testutil.CreateTestPost(t, db, authorID, otherSectionID, "Other section post")
+	testutil.CreateTestPost(t, db, otherUserID, sectionID, "Other author post")
+
+	if _, err := db.Exec(`UPDATE posts SET created_at = now() - interval '2 hours' WHERE id = $1`, olderPostID); err != nil {
+		t.Fatalf("failed to backdate post: %v", err)
+	}
+	if _, err := db.Exec(`UPDATE posts SET deleted_at = now() WHERE id = $1`, deletedPostID); err != nil {
+		t.Fatalf("failed to delete post: %v", err)
+	}
+
+	service := NewPostService(db)
+	posts, err := service.GetMoreFromAuthor(context.Background(), uuid.MustParse(currentPostID), uuid.MustParse(otherUserID), 10)
+	if err != nil {
+		t.Fatalf("GetMoreFromAuthor failed: %v", err)
+	}
+
+	if len(posts) != 2 {
+		t.Fatalf("expected 2 posts, got %d", len(posts))
+	}
+	if posts[0].ID.String() != newerPostID || posts[1].ID.String() != olderPostID {
+		t.Fatalf("expected newer then older post, got %s and %s", posts[0].ID, posts[1].ID)
+	}
+	for _, post := range posts {
+		if post.ID.String() == currentPostID {
+			t.Fatal("expected current post to be excluded")
+		}
+		if post.UserID.String() != authorID || post.SectionID.String() != sectionID {
+			t.Fatalf("expected posts from the same author and section, got %+v", post)
+		}
+	}
+}
+
+func TestGetMoreFromAuthorClampsLimitToMaximum(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	authorID := testutil.CreateTestUser(t, db, "moreclamp", "moreclamp@test.com", false, true)
+	sectionID := testutil.CreateTestSection(t, db, "General", "general")
+	currentPostID := testutil.CreateTestPost(t, db, authorID, sectionID, "Current post")
+	for i := 0; i < maxMoreFromAuthorLimit+2; i++ {
+		testutil.CreateTestPost(t, db, authorID, sectionID, fmt.Sprintf("Post %d", i))
+	}
+
+	service := NewPostService(db)
+	posts, err := service.GetMoreFromAuthor(context.Background(), uuid.MustParse(currentPostID), uuid.MustParse(authorID), 100)
+	if err != nil {
+		t.Fatalf("GetMoreFromAuthor failed: %v", err)
+	}
+	if len(posts) != maxMoreFromAuthorLimit {
+		t.Fatalf("expected %d posts, got %d", maxMoreFromAuthorLimit, len(posts))
+	}
+}
+
+func TestGetMoreFromAuthorReturnsNotFoundForDeletedPost(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	authorID := testutil.CreateTestUser(t, db, "moredeleted", "moredeleted@test.com", false, true)
+	sectionID := testutil.CreateTestSection(t, db, "General", "general")
+	postID := testutil.CreateTestPost(t, db, authorID, sectionID, "Gone")
+	if _, err := db.Exec(`UPDATE posts SET deleted_at = now() WHERE id = $1`, postID); err != nil {
+		t.Fatalf("failed to delete post: %v", err)
+	}
+
+	service := NewPostService(db)
+	if _, err := service.GetMoreFromAuthor(context.Background(), uuid.MustParse(postID), uuid.MustParse(authorID), 5); !errors.Is(err, ErrPostNotFound) {
+		t.Fatalf("expected ErrPostNotFound, got %v", err)
+	}
+}
+
+func TestGetPostsByIDsLoadsLinksImagesAndReactionsPerPost(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	authorID := testutil.CreateTestUser(t, db, "batchauthor", "batchauthor@test.com", false, true)
+	viewerID := testutil.CreateTestUser(t, db, "batchviewer", "batchviewer@test.com", false, true)
+	sectionID := testutil.CreateTestSection(t, db, "Batch Section", "general")
+	firstPostID := testutil.CreateTestPost(t, db, authorID, sectionID, "First")
+	secondPostID := testutil.CreateTestPost(t, db, authorID, sectionID, "Second")
+
+	exec := func(query string, args ...interface{}) {
+		t.Helper()
+		if _, err := db.Exec(query, args...); err != nil {
+			t.Fatalf("failed to seed post details: %v", err)
+		}
+	}
+	exec(`INSERT INTO links (post_id, url, created_at) VALUES ($1, 'https://example.com/first', now())`, firstPostID)
+	exec(`INSERT INTO post_images (post_id, image_url, position) VALUES ($1, 'https://example.com/b.png', 1), ($1, 'https://example.com/a.png', 0)`, secondPostID)
+	exec(`INSERT INTO reactions (user_id, post_id, emoji) VALUES ($1, $3, '👍'), ($2, $3, '👍'), ($2, $4, '🔥')`, authorID, viewerID, firstPostID, secondPostID)
+
+	service := NewPostService(db)
+	postsByID, err := service.GetPostsByIDs(context.Background(), []uuid.UUID{uuid.MustParse(firstPostID), uuid.MustParse(secondPostID)}, uuid.MustParse(viewerID))
+	if err != nil {
+		t.Fatalf("GetPostsByIDs failed: %v", err)
+	}
+
+	first := postsByID[uuid.MustParse(firstPostID)]
+	second := postsByID[uuid.MustParse(secondPostID)]
+	if first == nil || second == nil {
+		t.Fatalf("expected both posts, got %v", postsByID)
+	}
+	if len(first.Links) != 1 || first.Links[0].URL != "https://example.com/first" || len(second.Links) != 0 {
+		t.Fatalf("expected the link on the first post only, got %v and %v", first.Links, second.Links)
+	}
+	if len(first.Images) != 0 || len(second.Images) != 2 || second.Images[0].URL != "https://example.com/a.png" {
+		t.Fatalf("expected ordered images on the second post only, got %v and %v", first.Images, second.Images)
+	}
+	if first.ReactionCounts["👍"] != 2 || second.ReactionCounts["🔥"] != 1 {
+		t.Fatalf("expected per-post reaction counts, got %v and %v", first.ReactionCounts, second.ReactionCounts)
+	}
+	if len(first.ViewerReactions) != 1 || len(second.ViewerReactions) != 1 {
+		t.Fatalf("expected one viewer reaction per post, got %v and %v", first.ViewerReactions, second.ViewerReactions)
+	}
+}
@@ -0,0 +1,101 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/sanderginn/clubhouse/internal/models"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// postTimelineEventTypes maps the audit actions that make up a post's timeline to event types.
+var postTimelineEventTypes = map[string]string{
+	"update_post":          "edit",
+	"remove_link_metadata": "edit",
+	"delete_post":          "delete",
+	"restore_post":         "restore",
+}
+
+// GetPostTimeline returns a post's edits, deletions and restores from the audit log, oldest first.
+// Only the post author and admins can view a timeline; deleted posts are included.
+func (s *PostService) GetPostTimeline(ctx context.Context, postID uuid.UUID, userID uuid.UUID, isAdmin bool) ([]models.PostTimelineEvent, error) {
+	ctx, span := otel.Tracer("clubhouse.posts").Start(ctx, "PostService.GetPostTimeline")
+	span.SetAttributes(
+		attribute.String("post_id", postID.String()),
+		attribute.String("user_id", userID.String()),
+		attribute.Bool("is_admin", isAdmin),
+	)
+	defer span.End()
+
+	var ownerID uuid.UUID
+	if err := s.db.QueryRowContext(ctx, "SELECT user_id FROM posts WHERE id = $1", postID).Scan(&ownerID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			recordSpanError(span, ErrPostNotFound)
+			return nil, ErrPostNotFound
+		}
+		recordSpanError(span, err)
+		return nil, fmt.Errorf("failed to fetch post: %w", err)
+	}
+
+	if !isAdmin && ownerID != userID {
+		unauthorizedErr := errors.New("unauthorized")
+		recordSpanError(span, unauthorizedErr)
+		return nil, unauthorizedErr
+	}
+
+	actions := make([]string, 0, len(postTimelineEventTypes))
+	for action := range postTimelineEventTypes {
+		actions = append(actions, action)
+	}
+
+	// Edits are logged with the post ID in metadata only, moderation actions with related_post_id.
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT a.id, a.action, a.admin_user_id, u.username, a.metadata, a.created_at
+		FROM audit_logs a
+		LEFT JOIN users u ON u.id = a.admin_user_id
+		WHERE a.action = ANY($2)
+			AND (a.related_post_id = $1 OR a.metadata->>'post_id' = $3)
+		ORDER BY a.created_at ASC, a.id ASC
+	`, postID, pq.Array(actions), postID.String())
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, fmt.Errorf("failed to query post timeline: %w", err)
+	}
+	defer rows.Close()
+
+	events := []models.PostTimelineEvent{}
+	for rows.Next() {
+		var event models.PostTimelineEvent
+		var actorUsername sql.NullString
+		var metadataBytes []byte
+		if err := rows.Scan(&event.ID, &event.Action, &event.ActorUserID, &actorUsername, &metadataBytes, &event.CreatedAt); err != nil {
+			recordSpanError(span, err)
+			return nil, fmt.Errorf("failed to scan post timeline event: %w", err)
+		}
+		event.Type = postTimelineEventTypes[event.Action]
+		if actorUsername.Valid {
+			event.ActorUsername = actorUsername.String
+		}
+		if len(metadataBytes) > 0 {
+			if err := json.Unmarshal(metadataBytes, &event.Metadata); err != nil {
+				recordSpanError(span, err)
+				return nil, fmt.Errorf("failed to decode post timeline metadata: %w", err)
+			}
+		}
+		events = append(events, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		recordSpanError(span, err)
+		return nil, fmt.Errorf("error iterating post timeline: %w", err)
+	}
+
+	span.SetAttributes(attribute.Int("event_count", len(events)))
+	return events, nil
+}
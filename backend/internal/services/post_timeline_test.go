@@ -0,0 +1,74 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sanderginn/clubhouse/internal/models"
+	"github.com/sanderginn/clubhouse/internal/testutil"
+)
+
+func TestGetPostTimelineMergesEditAndAdminRestore(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	ctx := context.Background()
+	authorID := uuid.MustParse(testutil.CreateTestUser(t, db, "timelineauthor", "timelineauthor@test.com", false, true))
+	adminID := uuid.MustParse(testutil.CreateTestUser(t, db, "timelineadmin", "timelineadmin@test.com", true, true))
+	otherID := uuid.MustParse(testutil.CreateTestUser(t, db, "timelineother", "timelineother@test.com", false, true))
+	sectionID := testutil.CreateTestSection(t, db, "Timeline", "general")
+	postID := uuid.MustParse(testutil.CreateTestPost(t, db, authorID.String(), sectionID, "Original content"))
+
+	service := NewPostService(db)
+	if _, err := service.UpdatePost(ctx, postID, authorID, &models.UpdatePostRequest{Content: "Edited content"}); err != nil {
+		t.Fatalf("UpdatePost failed: %v", err)
+	}
+	if _, err := service.DeletePost(ctx, postID, adminID, true); err != nil {
+		t.Fatalf("DeletePost failed: %v", err)
+	}
+	if _, err := service.AdminRestorePost(ctx, postID, adminID); err != nil {
+		t.Fatalf("AdminRestorePost failed: %v", err)
+	}
+
+	events, err := service.GetPostTimeline(ctx, postID, authorID, false)
+	if err != nil {
+		t.Fatalf("GetPostTimeline failed: %v", err)
+	}
+
+	expectedTypes := []string{"edit", "delete", "restore"}
+	if len(events) != len(expectedTypes) {
+		t.Fatalf("expected %d events, got %d", len(expectedTypes), len(events))
+	}
+	for i, expectedType := range expectedTypes {
+		if events[i].Type != expectedType {
+			t.Errorf("expected event %d to be %q, got %q", i, expectedType, events[i].Type)
+		}
+		if i > 0 && events[i].CreatedAt.Before(events[i-1].CreatedAt) {
+			t.Errorf("expected events in chronological order, event %d precedes event %d", i, i-1)
+		}
+	}
+	if events[0].ActorUserID == nil || *events[0].ActorUserID != authorID {
+		t.Errorf("expected edit by author %s, got %v", authorID, events[0].ActorUserID)
+	}
+	if events[0].Metadata["previous_content"] != "Original content" {
+		t.Errorf("expected edit to record previous content, got %v", events[0].Metadata["previous_content"])
+	}
+	if events[2].ActorUserID == nil || *events[2].ActorUserID != adminID {
+		t.Errorf("expected restore by admin %s, got %v", adminID, events[2].ActorUserID)
+	}
+	if events[2].ActorUsername != "timelineadmin" {
+		t.Errorf("expected restore actor username timelineadmin, got %q", events[2].ActorUsername)
+	}
+
+	if _, err := service.GetPostTimeline(ctx, postID, adminID, true); err != nil {
+		t.Fatalf("expected admin to view timeline, got %v", err)
+	}
+	if _, err := service.GetPostTimeline(ctx, postID, otherID, false); err == nil || err.Error() != "unauthorized" {
+		t.Fatalf("expected unauthorized error for non-author, got %v", err)
+	}
+	if _, err := service.GetPostTimeline(ctx, uuid.New(), adminID, true); !errors.Is(err, ErrPostNotFound) {
+		t.Fatalf("expected ErrPostNotFound, got %v", err)
+	}
+}
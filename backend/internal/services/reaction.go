@@ -43,6 +43,11 @@ func (s *ReactionService) AddReactionToPost(ctx context.Context, postID uuid.UUI
 		return nil, err
 	}
 
+	if err := s.checkReactionAllowed(ctx, postID, emoji); err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+
 	existingReaction, err := s.getExistingPostReaction(ctx, postID, userID, emoji)
 	if err != nil {
 		recordSpanError(span, err)
@@ -174,6 +179,11 @@ func (s *ReactionService) AddReactionToComment(ctx context.Context, commentID uu
 	}
 	span.SetAttributes(attribute.String("post_id", postID.String()))
 
+	if err := s.checkReactionAllowed(ctx, postID, emoji); err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+
 	existingReaction, err := s.getExistingCommentReaction(ctx, commentID, userID, emoji)
 	if err != nil {
 		recordSpanError(span, err)
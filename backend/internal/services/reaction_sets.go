@@ -0,0 +1,136 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/sanderginn/clubhouse/internal/models"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// suggestedReactions is offered in the picker when the admin config does not restrict reactions.
+var suggestedReactions = []string{"👍", "❤️", "😂", "🎉", "😮", "😢", "🔥", "👀"}
+
+// reactionNames provides accessible names for common emojis. Emojis without an entry are
+// announced by the emoji itself.
+var reactionNames = map[string]string{
+	"👍":  "thumbs up",
+	"👎":  "thumbs down",
+	"❤️": "red heart",
+	"😂":  "face with tears of joy",
+	"🎉":  "party popper",
+	"😮":  "face with open mouth",
+	"😢":  "crying face",
+	"😭":  "loudly crying face",
+	"😱":  "face screaming in fear",
+	"😴":  "sleeping face",
+	"🔥":  "fire",
+	"👀":  "eyes",
+	"🍿":  "popcorn",
+	"🎬":  "clapper board",
+	"⭐":  "star",
+	"✅":  "check mark button",
+	"❌":  "cross mark",
+}
+
+// ResolveAllowedReactions returns the reaction options for a section type and whether the
+// admin config restricts reactions to exactly those options. When it does not, the suggested
+// picker set is returned and any emoji is accepted.
+func ResolveAllowedReactions(sectionType string) ([]models.ReactionOption, bool) {
+	emojis := GetConfigService().ReactionSetForSectionType(sectionType)
+	restricted := len(emojis) > 0
+	if !restricted {
+		emojis = suggestedReactions
+	}
+
+	options := make([]models.ReactionOption, 0, len(emojis))
+	for _, emoji := range emojis {
+		name, ok := reactionNames[emoji]
+		if !ok {
+			name = emoji
+		}
+		options = append(options, models.ReactionOption{Emoji: emoji, Name: name})
+	}
+	return options, restricted
+}
+
+// reactionKey normalizes an emoji for comparison. The U+FE0F variation selector only asks for
+// emoji presentation, so "❤️" and "❤" are the same reaction.
+func reactionKey(emoji string) string {
+	return strings.ReplaceAll(strings.TrimSpace(emoji), "\uFE0F", "")
+}
+
+// isReactionAllowed reports whether an emoji may be used in sections of the given type.
+func isReactionAllowed(sectionType string, emoji string) bool {
+	allowed := GetConfigService().ReactionSetForSectionType(sectionType)
+	if len(allowed) == 0 {
+		return true
+	}
+	key := reactionKey(emoji)
+	for _, candidate := range allowed {
+		if reactionKey(candidate) == key {
+			return true
+		}
+	}
+	return false
+}
+
+// checkReactionAllowed rejects emojis outside the reaction set of the post's section.
+// The section lookup is skipped entirely while no restrictions are configured.
+func (s *ReactionService) checkReactionAllowed(ctx context.Context, postID uuid.UUID, emoji string) error {
+	if !GetConfigService().HasReactionRestrictions() {
+		return nil
+	}
+	sectionType, err := s.getPostSectionType(ctx, postID)
+	if err != nil {
+		return err
+	}
+	if !isReactionAllowed(sectionType, emoji) {
+		return errors.New("emoji not allowed")
+	}
+	return nil
+}
+
+func (s *ReactionService) getPostSectionType(ctx context.Context, postID uuid.UUID) (string, error) {
+	var sectionType string
+	err := s.db.QueryRowContext(ctx, `
+		SELECT s.type
+		FROM posts p
+		JOIN sections s ON p.section_id = s.id
+		WHERE p.id = $1 AND p.deleted_at IS NULL
+	`, postID).Scan(&sectionType)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", errors.New("post not found")
+		}
+		return "", fmt.Errorf("failed to fetch post section: %w", err)
+	}
+	return sectionType, nil
+}
+
+// GetAllowedReactions resolves the reaction set for the section a post belongs to.
+func (s *ReactionService) GetAllowedReactions(ctx context.Context, postID uuid.UUID) (*models.AllowedReactionsResponse, error) {
+	ctx, span := otel.Tracer("clubhouse.reactions").Start(ctx, "ReactionService.GetAllowedReactions")
+	span.SetAttributes(attribute.String("post_id", postID.String()))
+	defer span.End()
+
+	sectionType, err := s.getPostSectionType(ctx, postID)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+	span.SetAttributes(attribute.String("section_type", sectionType))
+
+	reactions, restricted := ResolveAllowedReactions(sectionType)
+	return &models.AllowedReactionsResponse{
+		PostID:      postID,
+		SectionType: sectionType,
+		Reactions:   reactions,
+		Restricted:  restricted,
+	}, nil
+}
@@ -0,0 +1,125 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sanderginn/clubhouse/internal/models"
+	"github.com/sanderginn/clubhouse/internal/testutil"
+)
+
+func setReactionSetsForTest(t *testing.T, allowed []string, overrides map[string][]string) {
+	t.Helper()
+	ResetConfigServiceForTests()
+	t.Cleanup(ResetConfigServiceForTests)
+	if _, err := GetConfigService().UpdateConfig(context.Background(), ConfigUpdate{AllowedReactions: &allowed, SectionReactions: &overrides}); err != nil {
+		t.Fatalf("UpdateConfig failed: %v", err)
+	}
+}
+
+func TestResolveAllowedReactions(t *testing.T) {
+	ResetConfigServiceForTests()
+	t.Cleanup(ResetConfigServiceForTests)
+
+	suggested, restricted := ResolveAllowedReactions("general")
+	if restricted || len(suggested) != len(suggestedReactions) {
+		t.Fatalf("expected unrestricted suggested set, got %+v (restricted %v)", suggested, restricted)
+	}
+	if !isReactionAllowed("general", "✅") {
+		t.Fatal("expected any emoji to be allowed without configured sets")
+	}
+
+	setReactionSetsForTest(t, []string{"👍", " ❤️ ", "👍", "\u2764"}, map[string][]string{"Movie": {"🍿", "🦄"}})
+
+	global, restricted := ResolveAllowedReactions("general")
+	if !restricted || !reactionSetsEqual(global, []models.ReactionOption{{Emoji: "👍", Name: "thumbs up"}, {Emoji: "❤️", Name: "red heart"}}) {
+		t.Fatalf("expected configured global set, got %+v", global)
+	}
+	movie, _ := ResolveAllowedReactions("movie")
+	if !reactionSetsEqual(movie, []models.ReactionOption{{Emoji: "🍿", Name: "popcorn"}, {Emoji: "🦄", Name: "🦄"}}) {
+		t.Fatalf("expected movie override set with fallback name, got %+v", movie)
+	}
+
+	if !isReactionAllowed("movie", "🍿") || isReactionAllowed("movie", "👍") {
+		t.Fatal("expected the movie override to replace the global set")
+	}
+	if !isReactionAllowed("general", "❤️") || isReactionAllowed("general", "🍿") {
+		t.Fatal("expected the global set to apply to sections without an override")
+	}
+	if !isReactionAllowed("general", "\u2764") {
+		t.Fatal("expected a heart without the variation selector to match the configured heart")
+	}
+}
+
+func TestUpdateConfigRejectsInvalidReactionSets(t *testing.T) {
+	ResetConfigServiceForTests()
+	t.Cleanup(ResetConfigServiceForTests)
+	config := GetConfigService()
+
+	invalid := []string{"👍", ""}
+	if _, err := config.UpdateConfig(context.Background(), ConfigUpdate{AllowedReactions: &invalid}); err == nil {
+		t.Fatal("expected empty emoji to be rejected")
+	}
+	emptyOverride := map[string][]string{"movie": {}}
+	if _, err := config.UpdateConfig(context.Background(), ConfigUpdate{SectionReactions: &emptyOverride}); err == nil {
+		t.Fatal("expected empty override to be rejected")
+	}
+	if config.HasReactionRestrictions() {
+		t.Fatal("expected reaction sets to be unchanged after invalid updates")
+	}
+}
+
+func TestGetAllowedReactionsUsesPostSection(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+	movieSet := []string{"🍿", "🎬"}
+	setReactionSetsForTest(t, []string{"👍", "❤️"}, map[string][]string{"movie": movieSet})
+
+	userID := testutil.CreateTestUser(t, db, "allowedreactions", "allowedreactions@test.com", false, true)
+	movieSectionID := testutil.CreateTestSection(t, db, "Movies", "movie")
+	generalSectionID := testutil.CreateTestSection(t, db, "General", "general")
+	moviePostID := uuid.MustParse(testutil.CreateTestPost(t, db, userID, movieSectionID, "Movie night"))
+	generalPostID := uuid.MustParse(testutil.CreateTestPost(t, db, userID, generalSectionID, "Hello"))
+
+	service := NewReactionService(db)
+
+	movie, err := service.GetAllowedReactions(context.Background(), moviePostID)
+	if err != nil {
+		t.Fatalf("GetAllowedReactions movie failed: %v", err)
+	}
+	if movie.SectionType != "movie" || !movie.Restricted || len(movie.Reactions) != 2 || movie.Reactions[0].Emoji != "🍿" {
+		t.Fatalf("expected movie override set, got %+v", movie)
+	}
+
+	general, err := service.GetAllowedReactions(context.Background(), generalPostID)
+	if err != nil {
+		t.Fatalf("GetAllowedReactions general failed: %v", err)
+	}
+	if general.SectionType != "general" || len(general.Reactions) != 2 || general.Reactions[0].Emoji != "👍" {
+		t.Fatalf("expected global set, got %+v", general)
+	}
+
+	if _, err := service.GetAllowedReactions(context.Background(), uuid.New()); err == nil || err.Error() != "post not found" {
+		t.Fatalf("expected post not found, got %v", err)
+	}
+
+	if _, err := service.AddReactionToPost(context.Background(), moviePostID, uuid.MustParse(userID), "👍"); err == nil || err.Error() != "emoji not allowed" {
+		t.Fatalf("expected emoji outside the movie set to be rejected, got %v", err)
+	}
+	if _, err := service.AddReactionToPost(context.Background(), moviePostID, uuid.MustParse(userID), "🍿"); err != nil {
+		t.Fatalf("expected movie set emoji to be accepted: %v", err)
+	}
+}
+
+func reactionSetsEqual(a, b []models.ReactionOption) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -206,7 +206,7 @@ func (s *ReadLogService) GetPostReadLogs(ctx context.Context, postID uuid.UUID,
 	}
 
 	response := &models.PostReadLogsResponse{Readers: []models.ReadLogUserInfo{}}
-	if err := s.populateReadLogSummaries(ctx, map[uuid.UUID]*models.PostReadLogsResponse{postID: response}); err != nil {
+	if err := s.populateReadLogSummaries(ctx, map[uuid.UUID]*models.PostReadLogsResponse{postID: response}, viewerID); err != nil {
 		recordSpanError(span, err)
 		return nil, err
 	}
@@ -250,7 +250,7 @@ func (s *ReadLogService) GetReadLogsForPosts(ctx context.Context, postIDs []uuid
 		return responses, nil
 	}
 
-	if err := s.populateReadLogSummaries(ctx, responses); err != nil {
+	if err := s.populateReadLogSummaries(ctx, responses, viewerID); err != nil {
 		recordSpanError(span, err)
 		return nil, err
 	}
@@ -509,7 +509,7 @@ func (s *ReadLogService) getViewerReadLog(ctx context.Context, postID, viewerID
 	return readLog, nil
 }
 
-func (s *ReadLogService) populateReadLogSummaries(ctx context.Context, responses map[uuid.UUID]*models.PostReadLogsResponse) error {
+func (s *ReadLogService) populateReadLogSummaries(ctx context.Context, responses map[uuid.UUID]*models.PostReadLogsResponse, viewerID *uuid.UUID) error {
 	postIDs := make([]uuid.UUID, 0, len(responses))
 	for postID := range responses {
 		postIDs = append(postIDs, postID)
@@ -556,8 +556,9 @@ func (s *ReadLogService) populateReadLogSummaries(ctx context.Context, responses
 		FROM read_logs rl
 		JOIN users u ON rl.user_id = u.id
 		WHERE rl.post_id = ANY($1) AND rl.deleted_at IS NULL
+			AND (u.activity_private = false OR u.id = $2)
 		ORDER BY rl.post_id ASC, rl.created_at DESC, rl.id DESC
-	`, pq.Array(postIDs))
+	`, pq.Array(postIDs), uuidPointerValue(viewerID))
 	if err != nil {
 		return fmt.Errorf("failed to fetch read log readers: %w", err)
 	}
@@ -0,0 +1,181 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/sanderginn/clubhouse/internal/observability"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	// RejectedUserPurgerLockKey is the Redis key that keeps several instances from purging at once
+	RejectedUserPurgerLockKey = "clubhouse:rejected_user_purger:lock"
+	// DefaultRejectedUserRetention keeps no rejected users for appeal.
+	DefaultRejectedUserRetention time.Duration = 0
+	// DefaultRejectedUserPurgeInterval is how often the purge job looks for expired rejected users.
+	DefaultRejectedUserPurgeInterval = time.Hour
+)
+
+// PurgeRejectedUsers hard deletes soft-rejected users whose purge_after has passed at now and
+// returns how many were deleted. purge_after is fixed at rejection, so changing the retention
+// setting only affects later rejections.
+func (s *UserService) PurgeRejectedUsers(ctx context.Context, now time.Time) (int, error) {
+	ctx, span := otel.Tracer("clubhouse.users").Start(ctx, "UserService.PurgeRejectedUsers")
+	defer span.End()
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id
+		FROM users
+		WHERE purge_after IS NOT NULL
+		  AND purge_after <= $1
+		  AND approved_at IS NULL
+		ORDER BY purge_after ASC
+	`, now.UTC())
+	if err != nil {
+		recordSpanError(span, err)
+		return 0, fmt.Errorf("failed to list expired rejected users: %w", err)
+	}
+
+	var userIDs []uuid.UUID
+	for rows.Next() {
+		var userID uuid.UUID
+		if err := rows.Scan(&userID); err != nil {
+			rows.Close()
+			recordSpanError(span, err)
+			return 0, fmt.Errorf("failed to scan rejected user: %w", err)
+		}
+		userIDs = append(userIDs, userID)
+	}
+	if err := rows.Err(); err != nil {
+		rows.Close()
+		recordSpanError(span, err)
+		return 0, fmt.Errorf("error iterating rejected users: %w", err)
+	}
+	rows.Close()
+
+	purged := 0
+	for _, userID := range userIDs {
+		if err := s.purgeRejectedUser(ctx, userID); err != nil {
+			recordSpanError(span, err)
+			return purged, err
+		}
+		purged++
+	}
+
+	span.SetAttributes(attribute.Int("purged_count", purged))
+	return purged, nil
+}
+
+func (s *UserService) purgeRejectedUser(ctx context.Context, userID uuid.UUID) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	auditService := NewAuditService(tx)
+	metadata := map[string]interface{}{
+		"target_user_id": userID.String(),
+	}
+	if err := auditService.LogAuditWithMetadata(ctx, "purge_rejected_user", uuid.Nil, userID, metadata); err != nil {
+		return fmt.Errorf("failed to create audit log: %w", err)
+	}
+
+	if _, err := deleteRejectedUser(ctx, tx, userID); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// RejectedUserPurger periodically deletes rejected users once their retention window has passed.
+type RejectedUserPurger struct {
+	users    *UserService
+	redis    *redis.Client
+	interval time.Duration
+	stopCh   chan struct{}
+	wg       sync.WaitGroup
+}
+
+// NewRejectedUserPurger creates a rejected user purge job.
+func NewRejectedUserPurger(db *sql.DB, rdb *redis.Client, interval time.Duration) *RejectedUserPurger {
+	if interval <= 0 {
+		interval = DefaultRejectedUserPurgeInterval
+	}
+	return &RejectedUserPurger{
+		users:    NewUserService(db),
+		redis:    rdb,
+		interval: interval,
+		stopCh:   make(chan struct{}),
+	}
+}
+
+// Start runs the purge loop in the background.
+func (p *RejectedUserPurger) Start(ctx context.Context) {
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		ticker := time.NewTicker(p.interval)
+		defer ticker.Stop()
+
+		for {
+			purged, err := p.RunOnce(ctx, time.Now())
+			if err != nil {
+				observability.LogError(ctx, observability.ErrorLog{
+					Message: "failed to purge rejected users",
+					Code:    "REJECTED_USER_PURGE_FAILED",
+					Err:     err,
+				})
+			} else if purged > 0 {
+				observability.LogInfo(ctx, "rejected users purged", "count", fmt.Sprintf("%d", purged))
+			}
+
+			select {
+			case <-p.stopCh:
+				return
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// RunOnce purges expired rejected users unless another instance holds the purge lock, and
+// returns how many were deleted.
+func (p *RejectedUserPurger) RunOnce(ctx context.Context, now time.Time) (int, error) {
+	if p.redis == nil {
+		return 0, fmt.Errorf("rejected user purge lock is not configured")
+	}
+
+	// Only one instance purges at a time; the lock expires on its own if the holder dies.
+	release, locked, err := acquireJobLock(ctx, p.redis, RejectedUserPurgerLockKey, p.interval)
+	if err != nil {
+		return 0, fmt.Errorf("failed to acquire rejected user purge lock: %w", err)
+	}
+	if !locked {
+		return 0, nil
+	}
+	defer release()
+
+	return p.users.PurgeRejectedUsers(ctx, now)
+}
+
+// Stop shuts down the purge loop.
+func (p *RejectedUserPurger) Stop(ctx context.Context) {
+	close(p.stopCh)
+	p.wg.Wait()
+	observability.LogInfo(ctx, "rejected user purger stopped")
+}
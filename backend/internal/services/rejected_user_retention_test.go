@@ -0,0 +1,131 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sanderginn/clubhouse/internal/models"
+	"github.com/sanderginn/clubhouse/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSoftRejectedUserCannotLoginAndIsPurgedAfterRetention(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+	setSettingsForTest(t, func(settings *Settings) { settings.RejectedUserRetention = 7 * 24 * time.Hour })
+
+	ctx := context.Background()
+	adminID := uuid.MustParse(testutil.CreateTestUser(t, db, "softrejectadmin", "softrejectadmin@test.com", true, true))
+
+	service := NewUserService(db)
+	password := "correct horse battery staple"
+	user, err := service.RegisterUser(ctx, &models.RegisterRequest{
+		Username: "softrejected",
+		Email:    "softrejected@test.com",
+		Password: password,
+	})
+	if err != nil {
+		t.Fatalf("RegisterUser failed: %v", err)
+	}
+
+	response, err := service.RejectUser(ctx, user.ID, adminID)
+	if err != nil {
+		t.Fatalf("RejectUser failed: %v", err)
+	}
+	if response.RetainedUntil == nil {
+		t.Fatal("expected soft reject to report a retention deadline")
+	}
+
+	if _, err := service.LoginUser(ctx, &models.LoginRequest{Username: "softrejected", Password: password}); !errors.Is(err, ErrUserRejected) {
+		t.Fatalf("expected ErrUserRejected, got %v", err)
+	}
+
+	pending, err := service.GetPendingUsers(ctx)
+	if err != nil {
+		t.Fatalf("GetPendingUsers failed: %v", err)
+	}
+	for _, pendingUser := range pending {
+		if pendingUser.ID == user.ID {
+			t.Fatal("expected soft-rejected user to be hidden from pending users")
+		}
+	}
+
+	if _, err := service.RejectUser(ctx, user.ID, adminID); err == nil || err.Error() != "user already rejected" {
+		t.Fatalf("expected user already rejected error, got %v", err)
+	}
+
+	// The deadline was fixed at rejection, so a shorter retention now does not purge the user early.
+	setSettingsForTest(t, func(settings *Settings) { settings.RejectedUserRetention = 24 * time.Hour })
+
+	purged, err := service.PurgeRejectedUsers(ctx, time.Now().Add(6*24*time.Hour))
+	if err != nil {
+		t.Fatalf("PurgeRejectedUsers failed: %v", err)
+	}
+	if purged != 0 {
+		t.Fatalf("expected no users purged inside the retention window, got %d", purged)
+	}
+
+	purged, err = service.PurgeRejectedUsers(ctx, time.Now().Add(8*24*time.Hour))
+	if err != nil {
+		t.Fatalf("PurgeRejectedUsers failed: %v", err)
+	}
+	if purged != 1 {
+		t.Fatalf("expected 1 user purged after the retention window, got %d", purged)
+	}
+
+	var count int
+	if err := db.QueryRow("SELECT COUNT(*) FROM users WHERE id = $1", user.ID).Scan(&count); err != nil {
+		t.Fatalf("failed to count users: %v", err)
+	}
+	if count != 0 {
+		t.Fatalf("expected rejected user to be deleted, found %d", count)
+	}
+}
+
+func TestApproveSoftRejectedUserReversesRejection(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+	setSettingsForTest(t, func(settings *Settings) { settings.RejectedUserRetention = 7 * 24 * time.Hour })
+
+	ctx := context.Background()
+	adminID := uuid.MustParse(testutil.CreateTestUser(t, db, "appealadmin", "appealadmin@test.com", true, true))
+	userID := uuid.MustParse(testutil.CreateTestUser(t, db, "appealuser", "appealuser@test.com", false, false))
+
+	service := NewUserService(db)
+	if _, err := service.RejectUser(ctx, userID, adminID); err != nil {
+		t.Fatalf("RejectUser failed: %v", err)
+	}
+	if _, err := service.ApproveUser(ctx, userID, adminID); err != nil {
+		t.Fatalf("ApproveUser failed: %v", err)
+	}
+
+	purged, err := service.PurgeRejectedUsers(ctx, time.Now().Add(30*24*time.Hour))
+	if err != nil {
+		t.Fatalf("PurgeRejectedUsers failed: %v", err)
+	}
+	if purged != 0 {
+		t.Fatalf("expected approved user to survive the purge, got %d purged", purged)
+	}
+}
+
+func TestRejectedUserPurgerSkipsRunWhenLockIsHeld(t *testing.T) {
+	rdb := testutil.GetTestRedis(t)
+	t.Cleanup(func() { testutil.CleanupRedis(t) })
+	ctx := context.Background()
+
+	require.NoError(t, rdb.Set(ctx, RejectedUserPurgerLockKey, "other-instance", time.Minute).Err())
+
+	// The lock is checked before the database is touched, so no DB is needed here.
+	purger := NewRejectedUserPurger(nil, rdb, time.Minute)
+	purged, err := purger.RunOnce(ctx, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, 0, purged)
+
+	holder, err := rdb.Get(ctx, RejectedUserPurgerLockKey).Result()
+	require.NoError(t, err)
+	assert.Equal(t, "other-instance", holder, "another instance's lock must not be released")
+}
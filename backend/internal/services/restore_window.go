@@ -0,0 +1,11 @@
+package services
+
+import "time"
+
+// DefaultRestoreWindow is how long after deletion an author may restore their own post or comment.
+const DefaultRestoreWindow = 7 * 24 * time.Hour
+
+// restoreWindowExpired reports whether a deletion at deletedAt is outside the self-restore window.
+func restoreWindowExpired(deletedAt time.Time) bool {
+	return deletedAt.Before(time.Now().Add(-GetConfigService().GetSettings().RestoreWindow))
+}
@@ -0,0 +1,388 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/sanderginn/clubhouse/internal/models"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// MoveSavedRecipe moves a saved recipe out of one category into one or more other categories in a
+// single transaction. The original save row is kept and renamed where possible so its save time is
+// preserved. All target categories must already exist.
+func (s *SavedRecipeService) MoveSavedRecipe(ctx context.Context, userID, postID uuid.UUID, fromCategory string, toCategories []string) ([]models.SavedRecipe, error) {
+	ctx, span := otel.Tracer("clubhouse.saved_recipes").Start(ctx, "SavedRecipeService.MoveSavedRecipe")
+	span.SetAttributes(
+		attribute.String("user_id", userID.String()),
+		attribute.String("post_id", postID.String()),
+		attribute.Int("category_count", len(toCategories)),
+	)
+	defer span.End()
+
+	if err := s.verifyRecipePost(ctx, postID); err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+
+	from, err := normalizeRecipeCategory(fromCategory)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+	if len(toCategories) == 0 {
+		missingErr := errors.New("target category is required")
+		recordSpanError(span, missingErr)
+		return nil, missingErr
+	}
+	targets, err := normalizeRecipeCategories(toCategories)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+	span.SetAttributes(
+		attribute.String("from_category", from),
+		attribute.StringSlice("to_categories", targets),
+	)
+
+	for _, category := range targets {
+		if category == defaultRecipeCategory {
+			continue
+		}
+		exists, err := s.categoryExists(ctx, userID, category)
+		if err != nil {
+			recordSpanError(span, err)
+			return nil, err
+		}
+		if !exists {
+			missingErr := errors.New("category not found")
+			recordSpanError(span, missingErr)
+			return nil, missingErr
+		}
+	}
+
+	if err := moveCategorizedSave(ctx, s.db, "saved_recipes", userID, postID, from, targets); err != nil {
+		if errors.Is(err, errCategorizedSaveNotFound) {
+			err = errors.New("saved recipe not found")
+		}
+		recordSpanError(span, err)
+		return nil, err
+	}
+
+	if err := s.logSavedRecipeAudit(ctx, "move_saved_recipe", userID, map[string]interface{}{
+		"post_id":       postID.String(),
+		"from_category": from,
+		"to_categories": targets,
+	}); err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id, user_id, post_id, category, created_at, deleted_at
+		FROM saved_recipes
+		WHERE user_id = $1 AND post_id = $2 AND deleted_at IS NULL
+		ORDER BY created_at ASC, category ASC
+	`, userID, postID)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, fmt.Errorf("failed to query saved recipes: %w", err)
+	}
+	defer rows.Close()
+
+	savedRecipes := []models.SavedRecipe{}
+	for rows.Next() {
+		var savedRecipe models.SavedRecipe
+		if err := rows.Scan(
+			&savedRecipe.ID, &savedRecipe.UserID, &savedRecipe.PostID, &savedRecipe.Category,
+			&savedRecipe.CreatedAt, &savedRecipe.DeletedAt,
+		); err != nil {
+			recordSpanError(span, err)
+			return nil, fmt.Errorf("failed to scan saved recipe: %w", err)
+		}
+		savedRecipes = append(savedRecipes, savedRecipe)
+	}
+	if err := rows.Err(); err != nil {
+		recordSpanError(span, err)
+		return nil, fmt.Errorf("failed to iterate saved recipes: %w", err)
+	}
+
+	return savedRecipes, nil
+}
+
+// MoveWatchlistItem moves a watchlist entry out of one category into one or more other categories
+// in a single transaction, keeping the original entry where possible. All target categories must
+// already exist.
+func (s *WatchlistService) MoveWatchlistItem(ctx context.Context, userID, postID uuid.UUID, fromCategory string, toCategories []string) ([]models.WatchlistItem, error) {
+	ctx, span := otel.Tracer("clubhouse.watchlist").Start(ctx, "WatchlistService.MoveWatchlistItem")
+	span.SetAttributes(
+		attribute.String("user_id", userID.String()),
+		attribute.String("post_id", postID.String()),
+		attribute.Int("category_count", len(toCategories)),
+	)
+	defer span.End()
+
+	if err := s.verifyWatchlistPost(ctx, postID); err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+
+	from, err := normalizeWatchlistCategory(fromCategory)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+	if len(toCategories) == 0 {
+		missingErr := errors.New("target category is required")
+		recordSpanError(span, missingErr)
+		return nil, missingErr
+	}
+	targets, err := normalizeWatchlistCategories(toCategories)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+	span.SetAttributes(
+		attribute.String("from_category", from),
+		attribute.StringSlice("to_categories", targets),
+	)
+
+	for _, category := range targets {
+		if category == defaultWatchlistCategory {
+			continue
+		}
+		exists, err := s.categoryExists(ctx, userID, category)
+		if err != nil {
+			recordSpanError(span, err)
+			return nil, err
+		}
+		if !exists {
+			missingErr := errors.New("category not found")
+			recordSpanError(span, missingErr)
+			return nil, missingErr
+		}
+	}
+
+	if err := moveCategorizedSave(ctx, s.db, "watchlist_items", userID, postID, from, targets); err != nil {
+		if errors.Is(err, errCategorizedSaveNotFound) {
+			err = errors.New("watchlist item not found")
+		}
+		recordSpanError(span, err)
+		return nil, err
+	}
+
+	if err := s.logWatchlistAudit(ctx, "move_watchlist_item", userID, map[string]interface{}{
+		"post_id":       postID.String(),
+		"from_category": from,
+		"to_categories": targets,
+	}); err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id, user_id, post_id, category, created_at, deleted_at
+		FROM watchlist_items
+		WHERE user_id = $1 AND post_id = $2 AND deleted_at IS NULL
+		ORDER BY created_at ASC, category ASC
+	`, userID, postID)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, fmt.Errorf("failed to query watchlist items: %w", err)
+	}
+	defer rows.Close()
+
+	items := []models.WatchlistItem{}
+	for rows.Next() {
+		var item models.WatchlistItem
+		if err := rows.Scan(
+			&item.ID, &item.UserID, &item.PostID, &item.Category, &item.CreatedAt, &item.DeletedAt,
+		); err != nil {
+			recordSpanError(span, err)
+			return nil, fmt.Errorf("failed to scan watchlist item: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		recordSpanError(span, err)
+		return nil, fmt.Errorf("failed to iterate watchlist items: %w", err)
+	}
+
+	return items, nil
+}
+
+// MoveBookshelfItem moves a bookshelf entry from one category to another existing category.
+// An empty name or Uncategorized refers to items without a category.
+func (s *BookshelfService) MoveBookshelfItem(ctx context.Context, userID, postID uuid.UUID, fromCategory, toCategory string) (*models.BookshelfItem, error) {
+	ctx, span := otel.Tracer("clubhouse.bookshelf").Start(ctx, "BookshelfService.MoveBookshelfItem")
+	span.SetAttributes(
+		attribute.String("user_id", userID.String()),
+		attribute.String("post_id", postID.String()),
+	)
+	defer span.End()
+
+	if err := s.verifyBookPost(ctx, postID); err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+
+	fromID, err := s.lookupBookshelfCategoryID(ctx, userID, fromCategory)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+	toID, err := s.lookupBookshelfCategoryID(ctx, userID, toCategory)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+
+	item, err := scanBookshelfItem(s.db.QueryRowContext(ctx, `
+		UPDATE bookshelf_items
+		SET category_id = $4
+		WHERE user_id = $1 AND post_id = $2 AND deleted_at IS NULL
+			AND category_id IS NOT DISTINCT FROM $3
+		RETURNING id, user_id, post_id, category_id, created_at, deleted_at
+	`, userID, postID, uuidPointerValue(fromID), uuidPointerValue(toID)))
+	if errors.Is(err, sql.ErrNoRows) {
+		notFoundErr := errors.New("bookshelf item not found")
+		recordSpanError(span, notFoundErr)
+		return nil, notFoundErr
+	}
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, fmt.Errorf("failed to move bookshelf item: %w", err)
+	}
+
+	metadata := map[string]interface{}{
+		"post_id":       postID.String(),
+		"from_category": nil,
+		"to_category":   nil,
+	}
+	if fromID != nil {
+		metadata["from_category"] = strings.TrimSpace(fromCategory)
+	}
+	if toID != nil {
+		metadata["to_category"] = strings.TrimSpace(toCategory)
+	}
+	if err := s.logBookshelfAudit(ctx, "move_bookshelf_item", userID, metadata); err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+
+	return item, nil
+}
+
+// lookupBookshelfCategoryID resolves a category name to its ID. Empty names and Uncategorized
+// resolve to nil; unknown names return a category not found error.
+func (s *BookshelfService) lookupBookshelfCategoryID(ctx context.Context, userID uuid.UUID, name string) (*uuid.UUID, error) {
+	trimmed := strings.TrimSpace(name)
+	if trimmed == "" || strings.EqualFold(trimmed, defaultBookshelfCategoryName) {
+		return nil, nil
+	}
+
+	var categoryID uuid.UUID
+	err := s.db.QueryRowContext(ctx,
+		"SELECT id FROM bookshelf_categories WHERE user_id = $1 AND name = $2",
+		userID, trimmed,
+	).Scan(&categoryID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.New("category not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query bookshelf category: %w", err)
+	}
+	return &categoryID, nil
+}
+
+var errCategorizedSaveNotFound = errors.New("categorized save not found")
+
+// moveCategorizedSave moves a user's active save of a post from one category to a set of target
+// categories in tables keyed by (user_id, post_id, category), such as saved_recipes and
+// watchlist_items. The source row is renamed into the first target that has no row yet so its
+// identity and save time survive; remaining targets are restored or inserted, and the source is
+// soft-deleted only when it is neither kept nor renamed.
+func moveCategorizedSave(ctx context.Context, db *sql.DB, table string, userID, postID uuid.UUID, from string, targets []string) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin move transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	var sourceID uuid.UUID
+	err = tx.QueryRowContext(ctx, `
+		SELECT id FROM `+table+`
+		WHERE user_id = $1 AND post_id = $2 AND category = $3 AND deleted_at IS NULL
+		FOR UPDATE
+	`, userID, postID, from).Scan(&sourceID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return errCategorizedSaveNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load source save: %w", err)
+	}
+
+	sourceKept := false
+	for _, target := range targets {
+		if target == from {
+			sourceKept = true
+		}
+	}
+
+	for _, target := range targets {
+		if target == from {
+			continue
+		}
+
+		var existingID uuid.UUID
+		var deleted bool
+		err := tx.QueryRowContext(ctx, `
+			SELECT id, deleted_at IS NOT NULL
+			FROM `+table+`
+			WHERE user_id = $1 AND post_id = $2 AND category = $3
+			ORDER BY deleted_at IS NULL DESC, created_at DESC
+			LIMIT 1
+			FOR UPDATE
+		`, userID, postID, target).Scan(&existingID, &deleted)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			if !sourceKept {
+				if _, err := tx.ExecContext(ctx, "UPDATE "+table+" SET category = $2 WHERE id = $1", sourceID, target); err != nil {
+					return fmt.Errorf("failed to move save: %w", err)
+				}
+				sourceKept = true
+				continue
+			}
+			if _, err := tx.ExecContext(ctx, `
+				INSERT INTO `+table+` (id, user_id, post_id, category, created_at)
+				VALUES ($1, $2, $3, $4, now())
+			`, uuid.New(), userID, postID, target); err != nil {
+				return fmt.Errorf("failed to create save: %w", err)
+			}
+		case err != nil:
+			return fmt.Errorf("failed to load target save: %w", err)
+		case deleted:
+			if _, err := tx.ExecContext(ctx, "UPDATE "+table+" SET deleted_at = NULL WHERE id = $1", existingID); err != nil {
+				return fmt.Errorf("failed to restore save: %w", err)
+			}
+		}
+	}
+
+	if !sourceKept {
+		if _, err := tx.ExecContext(ctx, "UPDATE "+table+" SET deleted_at = now() WHERE id = $1", sourceID); err != nil {
+			return fmt.Errorf("failed to remove source save: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit move transaction: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sanderginn/clubhouse/internal/testutil"
+)
+
+func TestMoveSavedRecipeUpdatesRowAndPreservesSave(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	userID := uuid.MustParse(testutil.CreateTestUser(t, db, "moverecipeuser", "moverecipe@test.com", false, true))
+	sectionID := testutil.CreateTestSection(t, db, "Recipes", "recipe")
+	postID := uuid.MustParse(testutil.CreateTestPost(t, db, userID.String(), sectionID, "Recipe post"))
+
+	service := NewSavedRecipeService(db)
+	for _, name := range []string{"Dinner", "Favorites"} {
+		if _, err := service.CreateCategory(context.Background(), userID, name); err != nil {
+			t.Fatalf("CreateCategory failed: %v", err)
+		}
+	}
+	saved, err := service.SaveRecipe(context.Background(), userID, postID, []string{"Dinner"})
+	if err != nil {
+		t.Fatalf("SaveRecipe failed: %v", err)
+	}
+	original := saved[0]
+
+	moved, err := service.MoveSavedRecipe(context.Background(), userID, postID, "Dinner", []string{"Favorites"})
+	if err != nil {
+		t.Fatalf("MoveSavedRecipe failed: %v", err)
+	}
+	if len(moved) != 1 {
+		t.Fatalf("expected 1 active save, got %d", len(moved))
+	}
+	if moved[0].ID != original.ID || moved[0].Category != "Favorites" {
+		t.Fatalf("expected save %s moved to Favorites, got %s in %s", original.ID, moved[0].ID, moved[0].Category)
+	}
+	if !moved[0].CreatedAt.Equal(original.CreatedAt) {
+		t.Fatalf("expected save time to be preserved")
+	}
+
+	var dinnerCount int
+	if err := db.QueryRow(
+		"SELECT COUNT(*) FROM saved_recipes WHERE user_id = $1 AND post_id = $2 AND category = 'Dinner' AND deleted_at IS NULL",
+		userID, postID,
+	).Scan(&dinnerCount); err != nil {
+		t.Fatalf("failed to count saved recipes: %v", err)
+	}
+	if dinnerCount != 0 {
+		t.Fatalf("expected no Dinner save after move, got %d", dinnerCount)
+	}
+
+	if _, err := service.MoveSavedRecipe(context.Background(), userID, postID, "Favorites", []string{"Brunch"}); err == nil || err.Error() != "category not found" {
+		t.Fatalf("expected category not found, got %v", err)
+	}
+	if _, err := service.MoveSavedRecipe(context.Background(), userID, postID, "Dinner", []string{"Favorites"}); err == nil || err.Error() != "saved recipe not found" {
+		t.Fatalf("expected saved recipe not found, got %v", err)
+	}
+}
+
+func TestMoveWatchlistItemToMultipleCategories(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	userID := uuid.MustParse(testutil.CreateTestUser(t, db, "movewatchuser", "movewatch@test.com", false, true))
+	sectionID := testutil.CreateTestSection(t, db, "Movies", "movie")
+	postID := uuid.MustParse(testutil.CreateTestPost(t, db, userID.String(), sectionID, "Movie post"))
+
+	service := NewWatchlistService(db)
+	for _, name := range []string{"Weekend", "Favorites"} {
+		if _, err := service.CreateCategory(context.Background(), userID, name); err != nil {
+			t.Fatalf("CreateCategory failed: %v", err)
+		}
+	}
+	if _, err := service.AddToWatchlist(context.Background(), userID, postID, nil); err != nil {
+		t.Fatalf("AddToWatchlist failed: %v", err)
+	}
+
+	items, err := service.MoveWatchlistItem(context.Background(), userID, postID, "", []string{"Weekend", "Favorites"})
+	if err != nil {
+		t.Fatalf("MoveWatchlistItem failed: %v", err)
+	}
+	categories := map[string]bool{}
+	for _, item := range items {
+		categories[item.Category] = true
+	}
+	if len(items) != 2 || !categories["Weekend"] || !categories["Favorites"] {
+		t.Fatalf("expected Weekend and Favorites, got %+v", items)
+	}
+}
+
+func TestMoveBookshelfItemBetweenCategories(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	userID := uuid.MustParse(testutil.CreateTestUser(t, db, "movebookuser", "movebook@test.com", false, true))
+	sectionID := testutil.CreateTestSection(t, db, "Books", "book")
+	postID := uuid.MustParse(testutil.CreateTestPost(t, db, userID.String(), sectionID, "Book post"))
+
+	service := NewBookshelfService(db)
+	if err := service.AddToBookshelf(context.Background(), userID, postID, []string{"To Read"}); err != nil {
+		t.Fatalf("AddToBookshelf failed: %v", err)
+	}
+	favorites, err := service.CreateCategory(context.Background(), userID, "Favorites")
+	if err != nil {
+		t.Fatalf("CreateCategory failed: %v", err)
+	}
+
+	item, err := service.MoveBookshelfItem(context.Background(), userID, postID, "To Read", "Favorites")
+	if err != nil {
+		t.Fatalf("MoveBookshelfItem failed: %v", err)
+	}
+	if item.CategoryID == nil || *item.CategoryID != favorites.ID {
+		t.Fatalf("expected item in Favorites, got %v", item.CategoryID)
+	}
+
+	if _, err := service.MoveBookshelfItem(context.Background(), userID, postID, "To Read", "Favorites"); err == nil || err.Error() != "bookshelf item not found" {
+		t.Fatalf("expected bookshelf item not found, got %v", err)
+	}
+	if _, err := service.MoveBookshelfItem(context.Background(), userID, postID, "Favorites", "Missing"); err == nil || err.Error() != "category not found" {
+		t.Fatalf("expected category not found, got %v", err)
+	}
+}
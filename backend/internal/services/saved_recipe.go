@@ -217,10 +217,11 @@ func (s *SavedRecipeService) GetPostSaves(ctx context.Context, postID uuid.UUID,
 		FROM saved_recipes sr
 		JOIN users u ON sr.user_id = u.id
 		WHERE sr.post_id = $1 AND sr.deleted_at IS NULL
+			AND (u.activity_private = false OR u.id = $2)
 		GROUP BY u.id, u.username, u.profile_picture_url
 		ORDER BY first_saved ASC
 	`
-	rows, err := s.db.QueryContext(ctx, usersQuery, postID)
+	rows, err := s.db.QueryContext(ctx, usersQuery, postID, uuidPointerValue(viewerID))
 	if err != nil {
 		recordSpanError(span, err)
 		return nil, err
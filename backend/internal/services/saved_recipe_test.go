@@ -169,6 +169,46 @@ func TestGetPostSavesIncludesViewer(t *testing.T) {
 	}
 }
 
+func TestGetPostSavesHidesPrivateUsers(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	viewerID := uuid.MustParse(testutil.CreateTestUser(t, db, "savepublicviewer", "savepublicviewer@test.com", false, true))
+	privateUserID := uuid.MustParse(testutil.CreateTestUser(t, db, "saveprivateuser", "saveprivateuser@test.com", false, true))
+	sectionID := testutil.CreateTestSection(t, db, "Recipes", "recipe")
+	postID := uuid.MustParse(testutil.CreateTestPost(t, db, viewerID.String(), sectionID, "Recipe post"))
+
+	if _, err := db.Exec(`UPDATE users SET activity_private = true WHERE id = $1`, privateUserID); err != nil {
+		t.Fatalf("failed to mark user private: %v", err)
+	}
+
+	service := NewSavedRecipeService(db)
+	for _, userID := range []uuid.UUID{viewerID, privateUserID} {
+		if _, err := service.SaveRecipe(context.Background(), userID, postID, nil); err != nil {
+			t.Fatalf("SaveRecipe failed: %v", err)
+		}
+	}
+
+	info, err := service.GetPostSaves(context.Background(), postID, &viewerID)
+	if err != nil {
+		t.Fatalf("GetPostSaves failed: %v", err)
+	}
+	if info.SaveCount != 2 {
+		t.Fatalf("expected save count 2, got %d", info.SaveCount)
+	}
+	if len(info.Users) != 1 || info.Users[0].ID != viewerID {
+		t.Fatalf("expected only the public viewer in users, got %+v", info.Users)
+	}
+
+	info, err = service.GetPostSaves(context.Background(), postID, &privateUserID)
+	if err != nil {
+		t.Fatalf("GetPostSaves failed: %v", err)
+	}
+	if len(info.Users) != 2 {
+		t.Fatalf("expected private user to see their own save, got %d users", len(info.Users))
+	}
+}
+
 func TestCategoryCRUDWithAudit(t *testing.T) {
 	db := testutil.RequireTestDB(t)
 	t.Cleanup(func() { testutil.CleanupTables(t, db) })
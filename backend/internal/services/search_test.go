@@ -51,7 +51,7 @@ func TestSearchServiceGlobal(t *testing.T) {
 
 	mock.ExpectQuery(regexp.QuoteMeta("FROM links")).
 		WithArgs(postID).
-		WillReturnRows(sqlmock.NewRows([]string{"id", "url", "metadata", "created_at"}))
+		WillReturnRows(sqlmock.NewRows([]string{"id", "url", "metadata", "created_at", "is_dead", "last_checked_at"}))
 
 	mock.ExpectQuery(regexp.QuoteMeta("FROM post_images")).
 		WithArgs(postID).
@@ -80,8 +80,8 @@ func TestSearchServiceGlobal(t *testing.T) {
 
 	// Mock reaction counts for comment
 	mock.ExpectQuery(regexp.QuoteMeta("FROM reactions")).
-		WithArgs(commentID).
-		WillReturnRows(sqlmock.NewRows([]string{"emoji", "count"}))
+		WithArgs(sqlmock.AnyArg(), uuid.Nil).
+		WillReturnRows(sqlmock.NewRows([]string{"comment_id", "emoji", "count", "viewer_reacted"}))
 
 	results, err := service.Search(context.Background(), query, "global", nil, limit, uuid.Nil)
 	if err != nil {
@@ -21,6 +21,22 @@ type SectionService struct {
 
 const recentPodcastCursorSeparator = "|"
 
+// sectionDisplayOrderSQL builds the ORDER BY expression used to list sections in display order.
+func sectionDisplayOrderSQL(typeColumn, nameColumn string) string {
+	return `CASE ` + typeColumn + `
+			WHEN 'general' THEN 1
+			WHEN 'music' THEN 2
+			WHEN 'podcast' THEN 3
+			WHEN 'movie' THEN 4
+			WHEN 'series' THEN 5
+			WHEN 'recipe' THEN 6
+			WHEN 'book' THEN 7
+			WHEN 'event' THEN 8
+			ELSE 100
+		END,
+		` + nameColumn + ` ASC`
+}
+
 func NewSectionService(db *sql.DB) *SectionService {
 	return &SectionService{db: db}
 }
@@ -32,18 +48,7 @@ func (s *SectionService) ListSections(ctx context.Context) ([]models.Section, er
 	query := `
 		SELECT id, name, type
 		FROM sections
-		ORDER BY CASE type
-			WHEN 'general' THEN 1
-			WHEN 'music' THEN 2
-			WHEN 'podcast' THEN 3
-			WHEN 'movie' THEN 4
-			WHEN 'series' THEN 5
-			WHEN 'recipe' THEN 6
-			WHEN 'book' THEN 7
-			WHEN 'event' THEN 8
-			ELSE 100
-		END,
-		name ASC`
+		ORDER BY ` + sectionDisplayOrderSQL("type", "name")
 
 	rows, err := s.db.QueryContext(ctx, query)
 	if err != nil {
@@ -352,6 +357,48 @@ func (s *SectionService) GetRecentPodcasts(ctx context.Context, sectionID uuid.U
 	}, nil
 }
 
+// UpdateReadPosition stores the point up to which a user has read a section feed.
+func (s *SectionService) UpdateReadPosition(ctx context.Context, userID, sectionID uuid.UUID, lastReadAt *time.Time) (*models.SectionReadPosition, error) {
+	ctx, span := otel.Tracer("clubhouse.sections").Start(ctx, "SectionService.UpdateReadPosition")
+	span.SetAttributes(
+		attribute.String("user_id", userID.String()),
+		attribute.String("section_id", sectionID.String()),
+		attribute.Bool("has_last_read_at", lastReadAt != nil),
+	)
+	defer span.End()
+
+	var exists bool
+	if err := s.db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM sections WHERE id = $1)", sectionID).Scan(&exists); err != nil {
+		recordSpanError(span, err)
+		return nil, fmt.Errorf("failed to check section: %w", err)
+	}
+	if !exists {
+		notFoundErr := errors.New("section not found")
+		recordSpanError(span, notFoundErr)
+		return nil, notFoundErr
+	}
+
+	var readAt interface{}
+	if lastReadAt != nil {
+		readAt = lastReadAt.UTC()
+	}
+
+	position := models.SectionReadPosition{SectionID: sectionID}
+	if err := s.db.QueryRowContext(ctx, `
+		INSERT INTO section_read_positions (user_id, section_id, last_read_at, updated_at)
+		VALUES ($1, $2, COALESCE($3::timestamp, now()), now())
+		ON CONFLICT (user_id, section_id) DO UPDATE
+		SET last_read_at = EXCLUDED.last_read_at,
+			updated_at = now()
+		RETURNING last_read_at
+	`, userID, sectionID, readAt).Scan(&position.LastReadAt); err != nil {
+		recordSpanError(span, err)
+		return nil, fmt.Errorf("failed to update read position: %w", err)
+	}
+
+	return &position, nil
+}
+
 func extractRecentPodcastTitle(metadata map[string]interface{}, podcast *models.PodcastMetadata) string {
 	if podcast != nil && len(podcast.HighlightEpisodes) > 0 {
 		if title := strings.TrimSpace(podcast.HighlightEpisodes[0].Title); title != "" {
@@ -0,0 +1,68 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/sanderginn/clubhouse/internal/models"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// GetSubscribedSectionActivity returns the newest post timestamp and unread post count for every
+// section the user has not opted out of. Unread posts are those created after the user's read
+// position for the section; sections without a read position count every post as unread.
+func (s *SectionService) GetSubscribedSectionActivity(ctx context.Context, userID uuid.UUID) ([]models.SectionActivity, error) {
+	ctx, span := otel.Tracer("clubhouse.sections").Start(ctx, "SectionService.GetSubscribedSectionActivity")
+	span.SetAttributes(attribute.String("user_id", userID.String()))
+	defer span.End()
+
+	query := `
+		SELECT
+			s.id, s.name, s.type,
+			MAX(p.created_at) AS latest_post_at,
+			COUNT(p.id) FILTER (
+				WHERE p.created_at > COALESCE(rp.last_read_at, '-infinity'::timestamp)
+			) AS unread_count
+		FROM sections s
+		LEFT JOIN section_read_positions rp ON rp.section_id = s.id AND rp.user_id = $1
+		LEFT JOIN posts p ON p.section_id = s.id AND p.deleted_at IS NULL
+		WHERE NOT EXISTS (
+			SELECT 1 FROM section_subscriptions ss
+			WHERE ss.user_id = $1 AND ss.section_id = s.id
+		)
+		GROUP BY s.id, s.name, s.type, rp.last_read_at
+		ORDER BY ` + sectionDisplayOrderSQL("s.type", "s.name")
+
+	rows, err := s.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, fmt.Errorf("failed to query section activity: %w", err)
+	}
+	defer rows.Close()
+
+	activity := []models.SectionActivity{}
+	for rows.Next() {
+		var item models.SectionActivity
+		var latestPostAt sql.NullTime
+		if err := rows.Scan(&item.SectionID, &item.SectionName, &item.SectionType, &latestPostAt, &item.UnreadCount); err != nil {
+			recordSpanError(span, err)
+			return nil, fmt.Errorf("failed to scan section activity: %w", err)
+		}
+		if latestPostAt.Valid {
+			latest := latestPostAt.Time
+			item.LatestPostAt = &latest
+		}
+		activity = append(activity, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		recordSpanError(span, err)
+		return nil, fmt.Errorf("error iterating section activity: %w", err)
+	}
+
+	span.SetAttributes(attribute.Int("section_count", len(activity)))
+	return activity, nil
+}
@@ -0,0 +1,106 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sanderginn/clubhouse/internal/models"
+	"github.com/sanderginn/clubhouse/internal/testutil"
+)
+
+func TestGetSubscribedSectionActivity(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	ctx := context.Background()
+	viewerID := testutil.CreateTestUser(t, db, "activityviewer", "activityviewer@test.com", false, true)
+	authorID := testutil.CreateTestUser(t, db, "activityauthor", "activityauthor@test.com", false, true)
+	readSectionID := testutil.CreateTestSection(t, db, "Activity Read", "general")
+	unreadSectionID := testutil.CreateTestSection(t, db, "Activity Unread", "music")
+	quietSectionID := testutil.CreateTestSection(t, db, "Activity Quiet", "book")
+	optedOutSectionID := testutil.CreateTestSection(t, db, "Activity Opted Out", "movie")
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	setCreatedAt := func(postID string, createdAt time.Time) {
+		t.Helper()
+		if _, err := db.ExecContext(ctx, "UPDATE posts SET created_at = $1 WHERE id = $2", createdAt, postID); err != nil {
+			t.Fatalf("failed to backdate post: %v", err)
+		}
+	}
+
+	readOld := testutil.CreateTestPost(t, db, authorID, readSectionID, "Read old")
+	readNew := testutil.CreateTestPost(t, db, authorID, readSectionID, "Read new")
+	setCreatedAt(readOld, now.Add(-3*time.Hour))
+	setCreatedAt(readNew, now.Add(-30*time.Minute))
+
+	unreadOne := testutil.CreateTestPost(t, db, authorID, unreadSectionID, "Unread one")
+	unreadTwo := testutil.CreateTestPost(t, db, authorID, unreadSectionID, "Unread two")
+	unreadDeleted := testutil.CreateTestPost(t, db, authorID, unreadSectionID, "Unread deleted")
+	setCreatedAt(unreadOne, now.Add(-2*time.Hour))
+	setCreatedAt(unreadTwo, now.Add(-time.Hour))
+	setCreatedAt(unreadDeleted, now.Add(-time.Minute))
+	if _, err := db.ExecContext(ctx, "UPDATE posts SET deleted_at = now() WHERE id = $1", unreadDeleted); err != nil {
+		t.Fatalf("failed to delete post: %v", err)
+	}
+
+	optedOutPost := testutil.CreateTestPost(t, db, authorID, optedOutSectionID, "Opted out")
+	setCreatedAt(optedOutPost, now.Add(-time.Minute))
+	if _, err := db.ExecContext(ctx, "INSERT INTO section_subscriptions (user_id, section_id) VALUES ($1, $2)", viewerID, optedOutSectionID); err != nil {
+		t.Fatalf("failed to opt out of section: %v", err)
+	}
+
+	service := NewSectionService(db)
+	readAt := now.Add(-time.Hour)
+	if _, err := service.UpdateReadPosition(ctx, uuid.MustParse(viewerID), uuid.MustParse(readSectionID), &readAt); err != nil {
+		t.Fatalf("UpdateReadPosition failed: %v", err)
+	}
+
+	activity, err := service.GetSubscribedSectionActivity(ctx, uuid.MustParse(viewerID))
+	if err != nil {
+		t.Fatalf("GetSubscribedSectionActivity failed: %v", err)
+	}
+
+	bySection := make(map[string]models.SectionActivity, len(activity))
+	for _, item := range activity {
+		bySection[item.SectionID.String()] = item
+	}
+
+	if _, ok := bySection[optedOutSectionID]; ok {
+		t.Fatalf("expected opted-out section to be excluded")
+	}
+
+	readLatest := now.Add(-30 * time.Minute)
+	unreadLatest := now.Add(-time.Hour)
+	tests := []struct {
+		name         string
+		sectionID    string
+		latestPostAt *time.Time
+		unreadCount  int
+	}{
+		{name: "read position", sectionID: readSectionID, latestPostAt: &readLatest, unreadCount: 1},
+		{name: "no read position", sectionID: unreadSectionID, latestPostAt: &unreadLatest, unreadCount: 2},
+		{name: "no posts", sectionID: quietSectionID, latestPostAt: nil, unreadCount: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item, ok := bySection[tt.sectionID]
+			if !ok {
+				t.Fatalf("expected section %s in activity", tt.sectionID)
+			}
+			if item.UnreadCount != tt.unreadCount {
+				t.Errorf("expected unread count %d, got %d", tt.unreadCount, item.UnreadCount)
+			}
+			switch {
+			case tt.latestPostAt == nil && item.LatestPostAt != nil:
+				t.Errorf("expected no latest post, got %v", item.LatestPostAt)
+			case tt.latestPostAt != nil && item.LatestPostAt == nil:
+				t.Errorf("expected latest post at %v, got none", tt.latestPostAt)
+			case tt.latestPostAt != nil && !item.LatestPostAt.Equal(*tt.latestPostAt):
+				t.Errorf("expected latest post at %v, got %v", tt.latestPostAt, item.LatestPostAt)
+			}
+		})
+	}
+}
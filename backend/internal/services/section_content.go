@@ -0,0 +1,25 @@
+package services
+
+import "strings"
+
+// IsContentRequiredForSectionType reports whether posts in the given section type
+// must include non-empty text content.
+func IsContentRequiredForSectionType(sectionType string) bool {
+	return containsSectionType(GetConfigService().GetSettings().ContentRequiredSectionTypes, sectionType)
+}
+
+// IsMediaRequiredForSectionType reports whether posts in the given section type
+// must attach at least one image or link.
+func IsMediaRequiredForSectionType(sectionType string) bool {
+	return containsSectionType(GetConfigService().GetSettings().MediaRequiredSectionTypes, sectionType)
+}
+
+func containsSectionType(sectionTypes []string, sectionType string) bool {
+	sectionType = strings.ToLower(strings.TrimSpace(sectionType))
+	for _, candidate := range sectionTypes {
+		if candidate == sectionType {
+			return true
+		}
+	}
+	return false
+}
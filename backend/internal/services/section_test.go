@@ -470,3 +470,16 @@ func readMigrationFile(relativePath string) (string, error) {
 	}
 	return string(content), nil
 }
+
+func TestSectionServiceUpdateReadPositionNotFound(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	userID := testutil.CreateTestUser(t, db, "readpositionuser", "readpositionuser@test.com", false, true)
+
+	service := NewSectionService(db)
+	_, err := service.UpdateReadPosition(context.Background(), uuid.MustParse(userID), uuid.New(), nil)
+	if err == nil || err.Error() != "section not found" {
+		t.Fatalf("expected section not found error, got %v", err)
+	}
+}
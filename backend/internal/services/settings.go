@@ -0,0 +1,137 @@
+package services
+
+import (
+	"strings"
+	"time"
+)
+
+// Settings holds operator settings read from the environment at startup. Unlike Config they
+// are not persisted and cannot be changed by admins at runtime.
+type Settings struct {
+	// EditGracePeriod is how long after creation an edit does not mark a post or comment as edited.
+	// Zero disables the grace period.
+	EditGracePeriod time.Duration
+	// RestoreWindow is how long authors can restore their own deleted posts and comments.
+	// Zero disables self-restore; admins can always restore.
+	RestoreWindow time.Duration
+	// RejectedUserRetention is how long rejected users are kept for appeal before deletion.
+	// Zero deletes rejected users immediately.
+	RejectedUserRetention time.Duration
+	// LinkMetadataCacheTTL is how long fetched link metadata is reused for the same URL.
+	// Zero disables the cache.
+	LinkMetadataCacheTTL time.Duration
+
+	MaxBioLength               int
+	MaxProfilePictureURLLength int
+	// MaxLogNoteLength is shared by cook, watch and read log notes.
+	MaxLogNoteLength int
+	// CommentContextMaxDepth is how many ancestors are returned when jumping to a comment.
+	CommentContextMaxDepth int
+	// ThreadMaxComments caps the comments (top-level plus replies) returned per thread request.
+	ThreadMaxComments int
+
+	// PostRemovalNotificationsEnabled notifies commenters when a post they commented on is hard-deleted.
+	PostRemovalNotificationsEnabled bool
+	// ContentRequiredSectionTypes lists section types whose posts must include text content,
+	// even when links or images are attached.
+	ContentRequiredSectionTypes []string
+	// MediaRequiredSectionTypes lists section types whose posts must attach an image or link.
+	MediaRequiredSectionTypes []string
+	// ViewerCategoryOrders sets how a viewer's categories are ordered in post stats, by stat type.
+	ViewerCategoryOrders map[string]ViewerCategoryOrder
+}
+
+// DefaultSettings returns the settings used when nothing is configured.
+func DefaultSettings() Settings {
+	return Settings{
+		EditGracePeriod:                 DefaultEditGracePeriod,
+		RestoreWindow:                   DefaultRestoreWindow,
+		RejectedUserRetention:           DefaultRejectedUserRetention,
+		LinkMetadataCacheTTL:            DefaultLinkMetadataCacheTTL,
+		MaxBioLength:                    DefaultMaxBioLength,
+		MaxProfilePictureURLLength:      DefaultMaxProfilePictureURLLength,
+		MaxLogNoteLength:                DefaultMaxLogNoteLength,
+		CommentContextMaxDepth:          DefaultCommentContextMaxDepth,
+		ThreadMaxComments:               DefaultThreadMaxComments,
+		PostRemovalNotificationsEnabled: true,
+		ContentRequiredSectionTypes:     []string{},
+		MediaRequiredSectionTypes:       []string{},
+		ViewerCategoryOrders:            copyViewerCategoryOrders(defaultViewerCategoryOrders),
+	}
+}
+
+// GetSettings returns a copy of the operator settings.
+func (s *ConfigService) GetSettings() Settings {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.settings.copy()
+}
+
+// SetSettings replaces the operator settings. Negative durations, non-positive limits and
+// unknown category orders fall back to their defaults.
+func (s *ConfigService) SetSettings(settings Settings) {
+	normalized := settings.normalize()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.settings = normalized
+}
+
+func (settings Settings) normalize() Settings {
+	defaults := DefaultSettings()
+	if settings.EditGracePeriod < 0 {
+		settings.EditGracePeriod = defaults.EditGracePeriod
+	}
+	if settings.RestoreWindow < 0 {
+		settings.RestoreWindow = defaults.RestoreWindow
+	}
+	if settings.RejectedUserRetention < 0 {
+		settings.RejectedUserRetention = defaults.RejectedUserRetention
+	}
+	if settings.LinkMetadataCacheTTL < 0 {
+		settings.LinkMetadataCacheTTL = defaults.LinkMetadataCacheTTL
+	}
+	if settings.MaxBioLength <= 0 {
+		settings.MaxBioLength = defaults.MaxBioLength
+	}
+	if settings.MaxProfilePictureURLLength <= 0 {
+		settings.MaxProfilePictureURLLength = defaults.MaxProfilePictureURLLength
+	}
+	if settings.MaxLogNoteLength <= 0 {
+		settings.MaxLogNoteLength = defaults.MaxLogNoteLength
+	}
+	if settings.CommentContextMaxDepth <= 0 {
+		settings.CommentContextMaxDepth = defaults.CommentContextMaxDepth
+	}
+	if settings.ThreadMaxComments <= 0 {
+		settings.ThreadMaxComments = defaults.ThreadMaxComments
+	}
+	settings.ContentRequiredSectionTypes = normalizeSectionTypes(settings.ContentRequiredSectionTypes)
+	settings.MediaRequiredSectionTypes = normalizeSectionTypes(settings.MediaRequiredSectionTypes)
+	settings.ViewerCategoryOrders = normalizeViewerCategoryOrders(settings.ViewerCategoryOrders)
+	return settings
+}
+
+func (settings Settings) copy() Settings {
+	settings.ContentRequiredSectionTypes = append([]string{}, settings.ContentRequiredSectionTypes...)
+	settings.MediaRequiredSectionTypes = append([]string{}, settings.MediaRequiredSectionTypes...)
+	settings.ViewerCategoryOrders = copyViewerCategoryOrders(settings.ViewerCategoryOrders)
+	return settings
+}
+
+// normalizeSectionTypes lowercases and trims section types, dropping empty and duplicate entries.
+func normalizeSectionTypes(sectionTypes []string) []string {
+	normalized := make([]string, 0, len(sectionTypes))
+	seen := make(map[string]struct{}, len(sectionTypes))
+	for _, sectionType := range sectionTypes {
+		sectionType = strings.ToLower(strings.TrimSpace(sectionType))
+		if sectionType == "" {
+			continue
+		}
+		if _, ok := seen[sectionType]; ok {
+			continue
+		}
+		seen[sectionType] = struct{}{}
+		normalized = append(normalized, sectionType)
+	}
+	return normalized
+}
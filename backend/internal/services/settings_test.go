@@ -0,0 +1,98 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// setSettingsForTest applies update to the current operator settings and restores them when
+// the test finishes.
+func setSettingsForTest(t *testing.T, update func(*Settings)) {
+	t.Helper()
+	config := GetConfigService()
+	previous := config.GetSettings()
+	t.Cleanup(func() { config.SetSettings(previous) })
+
+	settings := config.GetSettings()
+	update(&settings)
+	config.SetSettings(settings)
+}
+
+func TestSetSettingsFallsBackToDefaults(t *testing.T) {
+	setSettingsForTest(t, func(settings *Settings) {
+		settings.EditGracePeriod = -time.Second
+		settings.RestoreWindow = -time.Second
+		settings.RejectedUserRetention = -time.Hour
+		settings.LinkMetadataCacheTTL = -time.Second
+		settings.MaxBioLength = 0
+		settings.MaxProfilePictureURLLength = -1
+		settings.MaxLogNoteLength = 0
+		settings.CommentContextMaxDepth = 0
+		settings.ThreadMaxComments = 0
+	})
+
+	settings := GetConfigService().GetSettings()
+	defaults := DefaultSettings()
+	assert.Equal(t, defaults.EditGracePeriod, settings.EditGracePeriod)
+	assert.Equal(t, defaults.RestoreWindow, settings.RestoreWindow)
+	assert.Equal(t, defaults.RejectedUserRetention, settings.RejectedUserRetention)
+	assert.Equal(t, defaults.LinkMetadataCacheTTL, settings.LinkMetadataCacheTTL)
+	assert.Equal(t, defaults.MaxBioLength, settings.MaxBioLength)
+	assert.Equal(t, defaults.MaxProfilePictureURLLength, settings.MaxProfilePictureURLLength)
+	assert.Equal(t, defaults.MaxLogNoteLength, settings.MaxLogNoteLength)
+	assert.Equal(t, defaults.CommentContextMaxDepth, settings.CommentContextMaxDepth)
+	assert.Equal(t, defaults.ThreadMaxComments, settings.ThreadMaxComments)
+}
+
+func TestSetSettingsKeepsZeroDurations(t *testing.T) {
+	// Zero is valid and disables the grace period, self-restore, retention and the link cache
+	setSettingsForTest(t, func(settings *Settings) {
+		settings.EditGracePeriod = 0
+		settings.RestoreWindow = 0
+		settings.RejectedUserRetention = 0
+		settings.LinkMetadataCacheTTL = 0
+	})
+
+	settings := GetConfigService().GetSettings()
+	assert.Equal(t, time.Duration(0), settings.EditGracePeriod)
+	assert.Equal(t, time.Duration(0), settings.RestoreWindow)
+	assert.Equal(t, time.Duration(0), settings.RejectedUserRetention)
+	assert.Equal(t, time.Duration(0), settings.LinkMetadataCacheTTL)
+}
+
+func TestSetSettingsNormalizesSectionTypesAndCategoryOrders(t *testing.T) {
+	setSettingsForTest(t, func(settings *Settings) {
+		settings.ContentRequiredSectionTypes = []string{" Recipe ", "", "book", "recipe"}
+		settings.MediaRequiredSectionTypes = nil
+		settings.ViewerCategoryOrders = map[string]ViewerCategoryOrder{
+			" Recipe ":                  "POSITION",
+			ViewerCategoryStatWatchlist: "bogus",
+			"unknown-stat":              ViewerCategoryOrderAdded,
+		}
+	})
+
+	settings := GetConfigService().GetSettings()
+	assert.Equal(t, []string{"recipe", "book"}, settings.ContentRequiredSectionTypes)
+	assert.Equal(t, []string{}, settings.MediaRequiredSectionTypes)
+	assert.Equal(t, ViewerCategoryOrderPosition, settings.ViewerCategoryOrders[ViewerCategoryStatRecipe])
+	assert.Equal(t, defaultViewerCategoryOrders[ViewerCategoryStatWatchlist], settings.ViewerCategoryOrders[ViewerCategoryStatWatchlist])
+	assert.Equal(t, defaultViewerCategoryOrders[ViewerCategoryStatBookshelf], settings.ViewerCategoryOrders[ViewerCategoryStatBookshelf])
+	_, ok := settings.ViewerCategoryOrders["unknown-stat"]
+	assert.False(t, ok)
+}
+
+func TestGetSettingsReturnsCopy(t *testing.T) {
+	setSettingsForTest(t, func(settings *Settings) {
+		settings.MediaRequiredSectionTypes = []string{"photos"}
+	})
+
+	settings := GetConfigService().GetSettings()
+	settings.MediaRequiredSectionTypes[0] = "general"
+	settings.ViewerCategoryOrders[ViewerCategoryStatRecipe] = ViewerCategoryOrderPosition
+
+	fresh := GetConfigService().GetSettings()
+	assert.Equal(t, []string{"photos"}, fresh.MediaRequiredSectionTypes)
+	assert.Equal(t, defaultViewerCategoryOrders[ViewerCategoryStatRecipe], fresh.ViewerCategoryOrders[ViewerCategoryStatRecipe])
+}
@@ -0,0 +1,26 @@
+package services
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimezoneChoicesAllLoad(t *testing.T) {
+	for _, zone := range timezoneChoices {
+		if _, err := time.LoadLocation(zone); err != nil {
+			t.Errorf("timezone %q does not load: %v", zone, err)
+		}
+	}
+	if len(SupportedTimezones()) != len(timezoneChoices) {
+		t.Fatalf("expected %d supported timezones, got %d", len(timezoneChoices), len(SupportedTimezones()))
+	}
+}
+
+func TestValidateTimezoneRejectsLocal(t *testing.T) {
+	if ValidateTimezone("Local") {
+		t.Fatalf("expected Local to be rejected")
+	}
+	if !ValidateTimezone("Europe/Amsterdam") {
+		t.Fatalf("expected Europe/Amsterdam to be accepted")
+	}
+}
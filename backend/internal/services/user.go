@@ -5,10 +5,12 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"math"
 	"net/url"
 	"strings"
 	"time"
 	"unicode"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 	"github.com/sanderginn/clubhouse/internal/models"
@@ -19,17 +21,27 @@ import (
 
 const (
 	bcryptCost = 12
+
+	// DefaultMaxBioLength is the default maximum bio length in characters.
+	DefaultMaxBioLength = 500
+	// DefaultMaxProfilePictureURLLength is the default maximum profile picture URL length in characters.
+	DefaultMaxProfilePictureURLLength = 2048
 )
 
 // dummyPasswordHash is a bcrypt hash for timing-equalized compares on unknown users.
 var dummyPasswordHash = []byte("$2a$12$ukjUkUX1cfSD88LBRMvNjuwNn2eWmisHaOuhtgo/napH/3VmLCtNK")
 
 var (
-	ErrUsernameRequired   = errors.New("username is required")
-	ErrPasswordRequired   = errors.New("password is required")
-	ErrInvalidCredentials = errors.New("invalid username or password")
-	ErrUserNotApproved    = errors.New("user not approved")
-	ErrUserSuspended      = errors.New("user suspended")
+	ErrUsernameRequired         = errors.New("username is required")
+	ErrPasswordRequired         = errors.New("password is required")
+	ErrInvalidCredentials       = errors.New("invalid username or password")
+	ErrUserNotApproved          = errors.New("user not approved")
+	ErrUserSuspended            = errors.New("user suspended")
+	ErrUserRejected             = errors.New("user rejected")
+	ErrBioTooLong               = errors.New("bio is too long")
+	ErrProfilePictureURLTooLong = errors.New("profile picture URL is too long")
+	ErrInvalidDigestFrequency   = errors.New("digest frequency must be off, daily, or weekly")
+	ErrInvalidTimezone          = errors.New("timezone must be a valid IANA timezone name")
 )
 
 // UserService handles user-related operations
@@ -230,7 +242,7 @@ func (s *UserService) GetUserByID(ctx context.Context, id uuid.UUID) (*models.Us
 	defer span.End()
 
 	query := `
-		SELECT id, username, COALESCE(email, '') as email, password_hash, profile_picture_url, bio, is_admin, totp_enabled, totp_secret_encrypted, approved_at, suspended_at, created_at, updated_at, deleted_at
+		SELECT id, username, COALESCE(email, '') as email, password_hash, profile_picture_url, bio, is_admin, activity_private, totp_enabled, totp_secret_encrypted, approved_at, suspended_at, created_at, updated_at, deleted_at
 		FROM users
 		WHERE id = $1 AND deleted_at IS NULL
 	`
@@ -238,7 +250,7 @@ func (s *UserService) GetUserByID(ctx context.Context, id uuid.UUID) (*models.Us
 	var user models.User
 	err := s.db.QueryRowContext(ctx, query, id).
 		Scan(&user.ID, &user.Username, &user.Email, &user.PasswordHash, &user.ProfilePictureURL,
-			&user.Bio, &user.IsAdmin, &user.TotpEnabled, &user.TotpSecretEncrypted, &user.ApprovedAt, &user.SuspendedAt, &user.CreatedAt, &user.UpdatedAt, &user.DeletedAt)
+			&user.Bio, &user.IsAdmin, &user.ActivityPrivate, &user.TotpEnabled, &user.TotpSecretEncrypted, &user.ApprovedAt, &user.SuspendedAt, &user.CreatedAt, &user.UpdatedAt, &user.DeletedAt)
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -260,7 +272,7 @@ func (s *UserService) GetUserByUsername(ctx context.Context, username string) (*
 	defer span.End()
 
 	query := `
-		SELECT id, username, COALESCE(email, '') as email, password_hash, profile_picture_url, bio, is_admin, totp_enabled, totp_secret_encrypted, approved_at, suspended_at, created_at, updated_at, deleted_at
+		SELECT id, username, COALESCE(email, '') as email, password_hash, profile_picture_url, bio, is_admin, activity_private, totp_enabled, totp_secret_encrypted, approved_at, suspended_at, created_at, updated_at, deleted_at
 		FROM users
 		WHERE username = $1 AND deleted_at IS NULL
 	`
@@ -268,7 +280,7 @@ func (s *UserService) GetUserByUsername(ctx context.Context, username string) (*
 	var user models.User
 	err := s.db.QueryRowContext(ctx, query, username).
 		Scan(&user.ID, &user.Username, &user.Email, &user.PasswordHash, &user.ProfilePictureURL,
-			&user.Bio, &user.IsAdmin, &user.TotpEnabled, &user.TotpSecretEncrypted, &user.ApprovedAt, &user.SuspendedAt, &user.CreatedAt, &user.UpdatedAt, &user.DeletedAt)
+			&user.Bio, &user.IsAdmin, &user.ActivityPrivate, &user.TotpEnabled, &user.TotpSecretEncrypted, &user.ApprovedAt, &user.SuspendedAt, &user.CreatedAt, &user.UpdatedAt, &user.DeletedAt)
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -290,7 +302,7 @@ func (s *UserService) GetUserByEmail(ctx context.Context, email string) (*models
 	defer span.End()
 
 	query := `
-		SELECT id, username, COALESCE(email, '') as email, password_hash, profile_picture_url, bio, is_admin, totp_enabled, totp_secret_encrypted, approved_at, suspended_at, created_at, updated_at, deleted_at
+		SELECT id, username, COALESCE(email, '') as email, password_hash, profile_picture_url, bio, is_admin, activity_private, totp_enabled, totp_secret_encrypted, approved_at, suspended_at, created_at, updated_at, deleted_at
 		FROM users
 		WHERE email = $1 AND deleted_at IS NULL
 	`
@@ -298,7 +310,7 @@ func (s *UserService) GetUserByEmail(ctx context.Context, email string) (*models
 	var user models.User
 	err := s.db.QueryRowContext(ctx, query, email).
 		Scan(&user.ID, &user.Username, &user.Email, &user.PasswordHash, &user.ProfilePictureURL,
-			&user.Bio, &user.IsAdmin, &user.TotpEnabled, &user.TotpSecretEncrypted, &user.ApprovedAt, &user.SuspendedAt, &user.CreatedAt, &user.UpdatedAt, &user.DeletedAt)
+			&user.Bio, &user.IsAdmin, &user.ActivityPrivate, &user.TotpEnabled, &user.TotpSecretEncrypted, &user.ApprovedAt, &user.SuspendedAt, &user.CreatedAt, &user.UpdatedAt, &user.DeletedAt)
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -341,6 +353,15 @@ func (s *UserService) LoginUser(ctx context.Context, req *models.LoginRequest) (
 
 	// Check if user is approved
 	if user.ApprovedAt == nil {
+		var rejected bool
+		if err := s.db.QueryRowContext(ctx, "SELECT rejected_at IS NOT NULL FROM users WHERE id = $1", user.ID).Scan(&rejected); err != nil {
+			recordSpanError(span, err)
+			return nil, fmt.Errorf("failed to check rejection: %w", err)
+		}
+		if rejected {
+			recordSpanError(span, ErrUserRejected)
+			return nil, ErrUserRejected
+		}
 		recordSpanError(span, ErrUserNotApproved)
 		return nil, ErrUserNotApproved
 	}
@@ -353,6 +374,183 @@ func (s *UserService) LoginUser(ctx context.Context, req *models.LoginRequest) (
 	return user, nil
 }
 
+// IsActivityPrivate returns true when the user has hidden their logs, saves, and last-active time from other users.
+func (s *UserService) IsActivityPrivate(ctx context.Context, userID uuid.UUID) (bool, error) {
+	ctx, span := otel.Tracer("clubhouse.users").Start(ctx, "UserService.IsActivityPrivate")
+	span.SetAttributes(attribute.String("user_id", userID.String()))
+	defer span.End()
+
+	var activityPrivate bool
+	if err := s.db.QueryRowContext(ctx, `
+		SELECT activity_private
+		FROM users
+		WHERE id = $1 AND deleted_at IS NULL
+	`, userID).Scan(&activityPrivate); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			notFoundErr := fmt.Errorf("user not found")
+			recordSpanError(span, notFoundErr)
+			return false, notFoundErr
+		}
+		recordSpanError(span, err)
+		return false, fmt.Errorf("failed to check activity privacy: %w", err)
+	}
+
+	return activityPrivate, nil
+}
+
+// GetRatingBias compares a user's cook, watch, and read log ratings with the average rating
+// other users gave the same posts, grouped by section type. Ratings are averaged per user and
+// post first so a post logged in several places counts once. Posts nobody else rated are ignored.
+func (s *UserService) GetRatingBias(ctx context.Context, userID uuid.UUID) (*models.UserRatingBias, error) {
+	ctx, span := otel.Tracer("clubhouse.users").Start(ctx, "UserService.GetRatingBias")
+	span.SetAttributes(attribute.String("user_id", userID.String()))
+	defer span.End()
+
+	var exists bool
+	if err := s.db.QueryRowContext(ctx, `
+		SELECT EXISTS(SELECT 1 FROM users WHERE id = $1 AND deleted_at IS NULL)
+	`, userID).Scan(&exists); err != nil {
+		recordSpanError(span, err)
+		return nil, fmt.Errorf("failed to check user: %w", err)
+	}
+	if !exists {
+		notFoundErr := errors.New("user not found")
+		recordSpanError(span, notFoundErr)
+		return nil, notFoundErr
+	}
+
+	query := `
+		WITH rated AS (
+			SELECT post_id FROM cook_logs WHERE user_id = $1 AND deleted_at IS NULL
+			UNION
+			SELECT post_id FROM watch_logs WHERE user_id = $1 AND deleted_at IS NULL
+			UNION
+			SELECT post_id FROM read_logs WHERE user_id = $1 AND deleted_at IS NULL AND rating IS NOT NULL
+		),
+		ratings AS (
+			SELECT user_id, post_id, rating FROM cook_logs
+			WHERE deleted_at IS NULL AND post_id IN (SELECT post_id FROM rated)
+			UNION ALL
+			SELECT user_id, post_id, rating FROM watch_logs
+			WHERE deleted_at IS NULL AND post_id IN (SELECT post_id FROM rated)
+			UNION ALL
+			SELECT user_id, post_id, rating FROM read_logs
+			WHERE deleted_at IS NULL AND rating IS NOT NULL AND post_id IN (SELECT post_id FROM rated)
+		),
+		per_user AS (
+			SELECT user_id, post_id, AVG(rating) AS rating
+			FROM ratings
+			GROUP BY user_id, post_id
+		),
+		community AS (
+			SELECT post_id, AVG(rating) AS avg_rating
+			FROM per_user
+			WHERE user_id <> $1
+			GROUP BY post_id
+		)
+		SELECT s.type, COUNT(*), AVG(r.rating - c.avg_rating)
+		FROM per_user r
+		JOIN community c ON c.post_id = r.post_id
+		JOIN posts p ON p.id = r.post_id AND p.deleted_at IS NULL
+		JOIN sections s ON s.id = p.section_id
+		WHERE r.user_id = $1
+		GROUP BY s.type
+		ORDER BY s.type
+	`
+
+	rows, err := s.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, fmt.Errorf("failed to query rating bias: %w", err)
+	}
+	defer rows.Close()
+
+	bias := models.UserRatingBias{
+		UserID:   userID,
+		Sections: []models.SectionRatingBias{},
+	}
+	var weightedDifference float64
+	for rows.Next() {
+		var section models.SectionRatingBias
+		if err := rows.Scan(&section.SectionType, &section.RatedPosts, &section.AverageDifference); err != nil {
+			recordSpanError(span, err)
+			return nil, fmt.Errorf("failed to scan rating bias: %w", err)
+		}
+		weightedDifference += section.AverageDifference * float64(section.RatedPosts)
+		bias.RatedPosts += section.RatedPosts
+		section.AverageDifference = roundRatingDifference(section.AverageDifference)
+		bias.Sections = append(bias.Sections, section)
+	}
+	if err := rows.Err(); err != nil {
+		recordSpanError(span, err)
+		return nil, fmt.Errorf("failed to iterate rating bias: %w", err)
+	}
+
+	if bias.RatedPosts > 0 {
+		overall := roundRatingDifference(weightedDifference / float64(bias.RatedPosts))
+		bias.AverageDifference = &overall
+	}
+
+	span.SetAttributes(attribute.Int("rated_posts", bias.RatedPosts))
+	return &bias, nil
+}
+
+func roundRatingDifference(value float64) float64 {
+	return math.Round(value*100) / 100
+}
+
+// GetEffectiveConfig returns the configuration that applies to a user, combining the
+// admin config with per-user settings and section opt-outs. The user's own timezone,
+// when set, replaces the admin display timezone.
+func (s *UserService) GetEffectiveConfig(ctx context.Context, userID uuid.UUID) (*models.UserConfig, error) {
+	ctx, span := otel.Tracer("clubhouse.users").Start(ctx, "UserService.GetEffectiveConfig")
+	span.SetAttributes(attribute.String("user_id", userID.String()))
+	defer span.End()
+
+	user, err := s.GetUserByID(ctx, userID)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+
+	subscriptions, err := s.GetSectionSubscriptions(ctx, userID)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+	mutedSectionIDs := make([]uuid.UUID, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		mutedSectionIDs = append(mutedSectionIDs, subscription.SectionID)
+	}
+
+	timezone, err := s.GetTimezone(ctx, userID)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+
+	globalConfig := GetConfigService().GetConfig()
+	settings := GetConfigService().GetSettings()
+	config := &models.UserConfig{
+		DisplayTimezone:            timezone.EffectiveTimezone,
+		LinkMetadataEnabled:        globalConfig.LinkMetadataEnabled,
+		MFARequired:                globalConfig.MFARequired,
+		MFAEnabled:                 user.TotpEnabled,
+		MFASetupRequired:           globalConfig.MFARequired && !user.TotpEnabled,
+		ActivityPrivate:            user.ActivityPrivate,
+		EditGracePeriodSeconds:     int(settings.EditGracePeriod / time.Second),
+		MaxBioLength:               settings.MaxBioLength,
+		MaxProfilePictureURLLength: settings.MaxProfilePictureURLLength,
+		MaxLogNoteLength:           settings.MaxLogNoteLength,
+		MutedSectionIDs:            mutedSectionIDs,
+		AllowedReactions:           globalConfig.AllowedReactions,
+		SectionReactions:           globalConfig.SectionReactions,
+	}
+	span.SetAttributes(attribute.Bool("mfa_setup_required", config.MFASetupRequired))
+
+	return config, nil
+}
+
 // IsUserSuspended returns true when the user is currently suspended.
 func (s *UserService) IsUserSuspended(ctx context.Context, userID uuid.UUID) (bool, error) {
 	ctx, span := otel.Tracer("clubhouse.users").Start(ctx, "UserService.IsUserSuspended")
@@ -455,7 +653,7 @@ func (s *UserService) GetPendingUsers(ctx context.Context) ([]*models.PendingUse
 	query := `
 		SELECT id, username, COALESCE(email, '') as email, created_at
 		FROM users
-		WHERE approved_at IS NULL AND deleted_at IS NULL
+		WHERE approved_at IS NULL AND deleted_at IS NULL AND rejected_at IS NULL
 		ORDER BY created_at ASC
 	`
 
@@ -670,10 +868,10 @@ func (s *UserService) ApproveUser(ctx context.Context, userID uuid.UUID, adminUs
 		return nil, deletedErr
 	}
 
-	// Update approved_at timestamp
+	// Update approved_at timestamp; approving a soft-rejected user during the retention window reverses the rejection
 	updateQuery := `
 		UPDATE users
-		SET approved_at = now(), updated_at = now()
+		SET approved_at = now(), rejected_at = NULL, purge_after = NULL, updated_at = now()
 		WHERE id = $1
 		RETURNING id, username, COALESCE(email, '') as email
 	`
@@ -1005,14 +1203,15 @@ func (s *UserService) RejectUser(ctx context.Context, userID uuid.UUID, adminUse
 
 	// Get the user first to verify they exist and are pending
 	query := `
-		SELECT id, approved_at, deleted_at
+		SELECT id, approved_at, deleted_at, rejected_at
 		FROM users
 		WHERE id = $1
 	`
 
 	var user models.User
+	var rejectedAt *time.Time
 	err = tx.QueryRowContext(ctx, query, userID).
-		Scan(&user.ID, &user.ApprovedAt, &user.DeletedAt)
+		Scan(&user.ID, &user.ApprovedAt, &user.DeletedAt, &rejectedAt)
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -1031,45 +1230,67 @@ func (s *UserService) RejectUser(ctx context.Context, userID uuid.UUID, adminUse
 		return nil, approvedErr
 	}
 
+	retention := GetConfigService().GetSettings().RejectedUserRetention
+	softReject := retention > 0
+	span.SetAttributes(attribute.Bool("soft_reject", softReject))
+	if softReject && rejectedAt != nil {
+		alreadyRejectedErr := fmt.Errorf("user already rejected")
+		recordSpanError(span, alreadyRejectedErr)
+		return nil, alreadyRejectedErr
+	}
+
 	// Create audit log entry BEFORE deleting the user (FK constraint)
 	auditService := NewAuditService(tx)
 	metadata := map[string]interface{}{
 		"target_user_id": userID.String(),
+		"soft_reject":    softReject,
+	}
+	if softReject {
+		metadata["retention_days"] = int(retention / (24 * time.Hour))
 	}
 	if err := auditService.LogAuditWithMetadata(ctx, "reject_user", adminUserID, userID, metadata); err != nil {
 		recordSpanError(span, err)
 		return nil, fmt.Errorf("failed to create audit log: %w", err)
 	}
 
-	if _, err := tx.ExecContext(ctx, `
-		UPDATE notifications
-		SET read_at = CASE
-				WHEN type = 'user_registration_pending' THEN COALESCE(read_at, now())
-				ELSE read_at
-			END,
-		    related_user_id = NULL
-		WHERE related_user_id = $1
-	`, userID); err != nil {
-		recordSpanError(span, err)
-		return nil, fmt.Errorf("failed to resolve registration notifications: %w", err)
-	}
+	if softReject {
+		// Keep the account for appeal; the rejected user purge job deletes it after the window.
+		var retainedUntil time.Time
+		if err := tx.QueryRowContext(ctx, `
+			UPDATE users
+			SET rejected_at = now(), purge_after = now() + $2 * INTERVAL '1 second', updated_at = now()
+			WHERE id = $1
+			RETURNING purge_after
+		`, userID, int64(retention/time.Second)).Scan(&retainedUntil); err != nil {
+			recordSpanError(span, err)
+			return nil, fmt.Errorf("failed to reject user: %w", err)
+		}
 
-	// Hard delete the user
-	deleteQuery := `
-		DELETE FROM users
-		WHERE id = $1
-	`
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE notifications
+			SET read_at = COALESCE(read_at, now())
+			WHERE related_user_id = $1 AND type = 'user_registration_pending'
+		`, userID); err != nil {
+			recordSpanError(span, err)
+			return nil, fmt.Errorf("failed to resolve registration notifications: %w", err)
+		}
 
-	result, err := tx.ExecContext(ctx, deleteQuery, userID)
-	if err != nil {
-		recordSpanError(span, err)
-		return nil, fmt.Errorf("failed to reject user: %w", err)
+		if err := tx.Commit(); err != nil {
+			recordSpanError(span, err)
+			return nil, fmt.Errorf("failed to commit transaction: %w", err)
+		}
+
+		return &models.RejectUserResponse{
+			ID:            userID,
+			Message:       "User rejected and will be deleted after the retention window",
+			RetainedUntil: &retainedUntil,
+		}, nil
 	}
 
-	rowsAffected, err := result.RowsAffected()
+	rowsAffected, err := deleteRejectedUser(ctx, tx, userID)
 	if err != nil {
 		recordSpanError(span, err)
-		return nil, fmt.Errorf("failed to get affected rows: %w", err)
+		return nil, err
 	}
 
 	if rowsAffected == 0 {
@@ -1089,15 +1310,50 @@ func (s *UserService) RejectUser(ctx context.Context, userID uuid.UUID, adminUse
 	}, nil
 }
 
+// deleteRejectedUser resolves a rejected user's registration notifications and hard deletes the user.
+func deleteRejectedUser(ctx context.Context, tx *sql.Tx, userID uuid.UUID) (int64, error) {
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE notifications
+		SET read_at = CASE
+				WHEN type = 'user_registration_pending' THEN COALESCE(read_at, now())
+				ELSE read_at
+			END,
+		    related_user_id = NULL
+		WHERE related_user_id = $1
+	`, userID); err != nil {
+		return 0, fmt.Errorf("failed to resolve registration notifications: %w", err)
+	}
+
+	// Hard delete the user
+	result, err := tx.ExecContext(ctx, `
+		DELETE FROM users
+		WHERE id = $1
+	`, userID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to reject user: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
 // GetUserProfile retrieves a user profile with stats by ID
-func (s *UserService) GetUserProfile(ctx context.Context, id uuid.UUID) (*models.UserProfileResponse, error) {
+func (s *UserService) GetUserProfile(ctx context.Context, id uuid.UUID, viewerID uuid.UUID) (*models.UserProfileResponse, error) {
 	ctx, span := otel.Tracer("clubhouse.users").Start(ctx, "UserService.GetUserProfile")
-	span.SetAttributes(attribute.String("user_id", id.String()))
+	span.SetAttributes(
+		attribute.String("user_id", id.String()),
+		attribute.String("viewer_id", viewerID.String()),
+	)
 	defer span.End()
 
 	query := `
 		SELECT
 			u.id, u.username, u.bio, u.profile_picture_url, u.created_at,
+			CASE WHEN u.activity_private AND u.id <> $2 THEN NULL ELSE u.last_active_at END as last_active_at,
 			(SELECT COUNT(*) FROM posts WHERE user_id = u.id AND deleted_at IS NULL) as post_count,
 			(SELECT COUNT(*) FROM comments WHERE user_id = u.id AND deleted_at IS NULL) as comment_count
 		FROM users u
@@ -1105,9 +1361,9 @@ func (s *UserService) GetUserProfile(ctx context.Context, id uuid.UUID) (*models
 	`
 
 	var profile models.UserProfileResponse
-	err := s.db.QueryRowContext(ctx, query, id).
+	err := s.db.QueryRowContext(ctx, query, id, viewerID).
 		Scan(&profile.ID, &profile.Username, &profile.Bio, &profile.ProfilePictureUrl,
-			&profile.CreatedAt, &profile.Stats.PostCount, &profile.Stats.CommentCount)
+			&profile.CreatedAt, &profile.LastActiveAt, &profile.Stats.PostCount, &profile.Stats.CommentCount)
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -1305,9 +1561,15 @@ func (s *UserService) UpdateProfile(ctx context.Context, userID uuid.UUID, req *
 		attribute.String("user_id", userID.String()),
 		attribute.Bool("has_bio", req != nil && req.Bio != nil),
 		attribute.Bool("has_profile_picture_url", req != nil && req.ProfilePictureUrl != nil),
+		attribute.Bool("has_activity_private", req != nil && req.ActivityPrivate != nil),
 	)
 	defer span.End()
 
+	if err := normalizeProfileUpdate(req); err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+
 	// Validate profile picture URL if provided
 	if req.ProfilePictureUrl != nil && *req.ProfilePictureUrl != "" {
 		if err := validateProfilePictureURL(*req.ProfilePictureUrl); err != nil {
@@ -1317,8 +1579,8 @@ func (s *UserService) UpdateProfile(ctx context.Context, userID uuid.UUID, req *
 	}
 
 	// Check if at least one field is provided
-	if req.Bio == nil && req.ProfilePictureUrl == nil {
-		missingErr := fmt.Errorf("at least one field (bio or profile_picture_url) is required")
+	if req.Bio == nil && req.ProfilePictureUrl == nil && req.ActivityPrivate == nil {
+		missingErr := fmt.Errorf("at least one field (bio, profile_picture_url, or activity_private) is required")
 		recordSpanError(span, missingErr)
 		return nil, missingErr
 	}
@@ -1334,12 +1596,13 @@ func (s *UserService) UpdateProfile(ctx context.Context, userID uuid.UUID, req *
 
 	var currentBio sql.NullString
 	var currentProfilePictureURL sql.NullString
+	var currentActivityPrivate bool
 	currentQuery := `
-		SELECT bio, profile_picture_url
+		SELECT bio, profile_picture_url, activity_private
 		FROM users
 		WHERE id = $1 AND deleted_at IS NULL
 	`
-	if err := tx.QueryRowContext(ctx, currentQuery, userID).Scan(&currentBio, &currentProfilePictureURL); err != nil {
+	if err := tx.QueryRowContext(ctx, currentQuery, userID).Scan(&currentBio, &currentProfilePictureURL, &currentActivityPrivate); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			notFoundErr := fmt.Errorf("user not found")
 			recordSpanError(span, notFoundErr)
@@ -1366,19 +1629,25 @@ func (s *UserService) UpdateProfile(ctx context.Context, userID uuid.UUID, req *
 		argIndex++
 	}
 
+	if req.ActivityPrivate != nil {
+		setClauses = append(setClauses, fmt.Sprintf("activity_private = $%d", argIndex))
+		args = append(args, *req.ActivityPrivate)
+		argIndex++
+	}
+
 	args = append(args, userID)
 
 	query := fmt.Sprintf(`
 		UPDATE users
 		SET %s
 		WHERE id = $%d AND deleted_at IS NULL
-		RETURNING id, username, COALESCE(email, '') as email, profile_picture_url, bio, is_admin
+		RETURNING id, username, COALESCE(email, '') as email, profile_picture_url, bio, is_admin, activity_private
 	`, strings.Join(setClauses, ", "), argIndex)
 
 	var response models.UpdateUserResponse
 	err = tx.QueryRowContext(ctx, query, args...).
 		Scan(&response.ID, &response.Username, &response.Email,
-			&response.ProfilePictureUrl, &response.Bio, &response.IsAdmin)
+			&response.ProfilePictureUrl, &response.Bio, &response.IsAdmin, &response.ActivityPrivate)
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -1422,6 +1691,14 @@ func (s *UserService) UpdateProfile(ctx context.Context, userID uuid.UUID, req *
 		}
 	}
 
+	if req.ActivityPrivate != nil && currentActivityPrivate != *req.ActivityPrivate {
+		changes["activity_private"] = map[string]interface{}{
+			"old": currentActivityPrivate,
+			"new": *req.ActivityPrivate,
+		}
+		changedFields = append(changedFields, "activity_private")
+	}
+
 	metadata := map[string]interface{}{
 		"changed_fields": changedFields,
 	}
@@ -1443,6 +1720,26 @@ func (s *UserService) UpdateProfile(ctx context.Context, userID uuid.UUID, req *
 	return &response, nil
 }
 
+// normalizeProfileUpdate trims profile fields in place and enforces the configured length limits.
+func normalizeProfileUpdate(req *models.UpdateUserRequest) error {
+	settings := GetConfigService().GetSettings()
+	if req.Bio != nil {
+		bio := strings.TrimSpace(*req.Bio)
+		if utf8.RuneCountInString(bio) > settings.MaxBioLength {
+			return fmt.Errorf("%w: must be %d characters or less", ErrBioTooLong, settings.MaxBioLength)
+		}
+		req.Bio = &bio
+	}
+	if req.ProfilePictureUrl != nil {
+		profilePictureURL := strings.TrimSpace(*req.ProfilePictureUrl)
+		if utf8.RuneCountInString(profilePictureURL) > settings.MaxProfilePictureURLLength {
+			return fmt.Errorf("%w: must be %d characters or less", ErrProfilePictureURLTooLong, settings.MaxProfilePictureURLLength)
+		}
+		req.ProfilePictureUrl = &profilePictureURL
+	}
+	return nil
+}
+
 // validateProfilePictureURL validates that the profile picture URL is a valid URL
 func validateProfilePictureURL(urlStr string) error {
 	parsedURL, err := url.Parse(urlStr)
@@ -1555,6 +1852,122 @@ func (s *UserService) UpdateSectionSubscription(ctx context.Context, userID uuid
 	}, nil
 }
 
+// GetDigestPreferences returns a user's digest email preferences.
+func (s *UserService) GetDigestPreferences(ctx context.Context, userID uuid.UUID) (*models.DigestPreferences, error) {
+	ctx, span := otel.Tracer("clubhouse.users").Start(ctx, "UserService.GetDigestPreferences")
+	span.SetAttributes(attribute.String("user_id", userID.String()))
+	defer span.End()
+
+	var preferences models.DigestPreferences
+	if err := s.db.QueryRowContext(ctx, `
+		SELECT digest_frequency
+		FROM users
+		WHERE id = $1 AND deleted_at IS NULL
+	`, userID).Scan(&preferences.Frequency); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			notFoundErr := fmt.Errorf("user not found")
+			recordSpanError(span, notFoundErr)
+			return nil, notFoundErr
+		}
+		recordSpanError(span, err)
+		return nil, fmt.Errorf("failed to get digest preferences: %w", err)
+	}
+
+	return &preferences, nil
+}
+
+// UpdateDigestPreferences sets how often a user receives digest emails.
+func (s *UserService) UpdateDigestPreferences(ctx context.Context, userID uuid.UUID, frequency string) (*models.DigestPreferences, error) {
+	ctx, span := otel.Tracer("clubhouse.users").Start(ctx, "UserService.UpdateDigestPreferences")
+	frequency = strings.ToLower(strings.TrimSpace(frequency))
+	span.SetAttributes(
+		attribute.String("user_id", userID.String()),
+		attribute.String("frequency", frequency),
+	)
+	defer span.End()
+
+	switch frequency {
+	case models.DigestFrequencyOff, models.DigestFrequencyDaily, models.DigestFrequencyWeekly:
+	default:
+		recordSpanError(span, ErrInvalidDigestFrequency)
+		return nil, ErrInvalidDigestFrequency
+	}
+
+	var preferences models.DigestPreferences
+	if err := s.db.QueryRowContext(ctx, `
+		UPDATE users
+		SET digest_frequency = $1, updated_at = now()
+		WHERE id = $2 AND deleted_at IS NULL
+		RETURNING digest_frequency
+	`, frequency, userID).Scan(&preferences.Frequency); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			notFoundErr := fmt.Errorf("user not found")
+			recordSpanError(span, notFoundErr)
+			return nil, notFoundErr
+		}
+		recordSpanError(span, err)
+		return nil, fmt.Errorf("failed to update digest preferences: %w", err)
+	}
+
+	return &preferences, nil
+}
+
+// GetNotificationPreferences returns which optional notifications a user receives.
+func (s *UserService) GetNotificationPreferences(ctx context.Context, userID uuid.UUID) (*models.NotificationPreferences, error) {
+	ctx, span := otel.Tracer("clubhouse.users").Start(ctx, "UserService.GetNotificationPreferences")
+	span.SetAttributes(attribute.String("user_id", userID.String()))
+	defer span.End()
+
+	var preferences models.NotificationPreferences
+	if err := s.db.QueryRowContext(ctx, `
+		SELECT comment_reaction_notifications
+		FROM users
+		WHERE id = $1 AND deleted_at IS NULL
+	`, userID).Scan(&preferences.CommentReactions); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			notFoundErr := fmt.Errorf("user not found")
+			recordSpanError(span, notFoundErr)
+			return nil, notFoundErr
+		}
+		recordSpanError(span, err)
+		return nil, fmt.Errorf("failed to get notification preferences: %w", err)
+	}
+
+	return &preferences, nil
+}
+
+// UpdateNotificationPreferences sets which optional notifications a user receives.
+func (s *UserService) UpdateNotificationPreferences(ctx context.Context, userID uuid.UUID, req *models.UpdateNotificationPreferencesRequest) (*models.NotificationPreferences, error) {
+	ctx, span := otel.Tracer("clubhouse.users").Start(ctx, "UserService.UpdateNotificationPreferences")
+	span.SetAttributes(attribute.String("user_id", userID.String()))
+	defer span.End()
+
+	if req == nil || req.CommentReactions == nil {
+		missingErr := fmt.Errorf("comment_reactions is required")
+		recordSpanError(span, missingErr)
+		return nil, missingErr
+	}
+	span.SetAttributes(attribute.Bool("comment_reactions", *req.CommentReactions))
+
+	var preferences models.NotificationPreferences
+	if err := s.db.QueryRowContext(ctx, `
+		UPDATE users
+		SET comment_reaction_notifications = $1, updated_at = now()
+		WHERE id = $2 AND deleted_at IS NULL
+		RETURNING comment_reaction_notifications
+	`, *req.CommentReactions, userID).Scan(&preferences.CommentReactions); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			notFoundErr := fmt.Errorf("user not found")
+			recordSpanError(span, notFoundErr)
+			return nil, notFoundErr
+		}
+		recordSpanError(span, err)
+		return nil, fmt.Errorf("failed to update notification preferences: %w", err)
+	}
+
+	return &preferences, nil
+}
+
 // ResetPassword resets a user's password (called after token verification)
 func (s *UserService) ResetPassword(ctx context.Context, userID uuid.UUID, newPassword string) error {
 	ctx, span := otel.Tracer("clubhouse.users").Start(ctx, "UserService.ResetPassword")
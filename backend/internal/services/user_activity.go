@@ -0,0 +1,92 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/sanderginn/clubhouse/internal/models"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	userActivityKeyPrefix = "user:activity:"
+	// userActivityThrottle bounds how often last_active_at is written per user.
+	userActivityThrottle = 5 * time.Minute
+)
+
+// RecordActivity bumps the user's last_active_at. Writes are throttled per user so that
+// authenticated requests only touch the database once per throttle window.
+func (s *UserService) RecordActivity(ctx context.Context, rdb *redis.Client, userID uuid.UUID) error {
+	ctx, span := otel.Tracer("clubhouse.users").Start(ctx, "UserService.RecordActivity")
+	span.SetAttributes(attribute.String("user_id", userID.String()))
+	defer span.End()
+
+	if rdb != nil {
+		claimed, err := rdb.SetNX(ctx, userActivityKeyPrefix+userID.String(), "1", userActivityThrottle).Result()
+		if err != nil {
+			recordSpanError(span, err)
+			return fmt.Errorf("failed to claim activity update: %w", err)
+		}
+		if !claimed {
+			span.SetAttributes(attribute.Bool("throttled", true))
+			return nil
+		}
+	}
+
+	// The WHERE guard keeps the timestamp coarse even when Redis is unavailable
+	_, err := s.db.ExecContext(ctx, `
+		UPDATE users
+		SET last_active_at = now()
+		WHERE id = $1
+		  AND deleted_at IS NULL
+		  AND (last_active_at IS NULL OR last_active_at < now() - make_interval(secs => $2))
+	`, userID, userActivityThrottle.Seconds())
+	if err != nil {
+		recordSpanError(span, err)
+		return fmt.Errorf("failed to update last active time: %w", err)
+	}
+
+	return nil
+}
+
+// GetLastActive returns when the user was last active. The timestamp is omitted for other
+// viewers when the user has made their activity private.
+func (s *UserService) GetLastActive(ctx context.Context, userID uuid.UUID, viewerID uuid.UUID) (*models.UserLastActiveResponse, error) {
+	ctx, span := otel.Tracer("clubhouse.users").Start(ctx, "UserService.GetLastActive")
+	span.SetAttributes(
+		attribute.String("user_id", userID.String()),
+		attribute.String("viewer_id", viewerID.String()),
+	)
+	defer span.End()
+
+	var lastActiveAt sql.NullTime
+	var activityPrivate bool
+	if err := s.db.QueryRowContext(ctx, `
+		SELECT last_active_at, activity_private
+		FROM users
+		WHERE id = $1 AND deleted_at IS NULL AND approved_at IS NOT NULL
+	`, userID).Scan(&lastActiveAt, &activityPrivate); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			notFoundErr := fmt.Errorf("user not found")
+			recordSpanError(span, notFoundErr)
+			return nil, notFoundErr
+		}
+		recordSpanError(span, err)
+		return nil, fmt.Errorf("failed to get last active time: %w", err)
+	}
+
+	response := &models.UserLastActiveResponse{UserID: userID}
+	if lastActiveAt.Valid && (!activityPrivate || userID == viewerID) {
+		lastActive := lastActiveAt.Time
+		response.LastActiveAt = &lastActive
+	}
+	span.SetAttributes(attribute.Bool("hidden", activityPrivate && userID != viewerID))
+
+	return response, nil
+}
@@ -0,0 +1,115 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sanderginn/clubhouse/internal/testutil"
+)
+
+func TestRecordActivityUpdatesLastActive(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+	rdb := testutil.GetTestRedis(t)
+	t.Cleanup(func() { testutil.CleanupRedis(t) })
+
+	userID := uuid.MustParse(testutil.CreateTestUser(t, db, "activeuser", "activeuser@test.com", false, true))
+
+	service := NewUserService(db)
+	if err := service.RecordActivity(context.Background(), rdb, userID); err != nil {
+		t.Fatalf("RecordActivity failed: %v", err)
+	}
+
+	var lastActiveAt *time.Time
+	if err := db.QueryRow(`SELECT last_active_at FROM users WHERE id = $1`, userID).Scan(&lastActiveAt); err != nil {
+		t.Fatalf("failed to query last_active_at: %v", err)
+	}
+	if lastActiveAt == nil {
+		t.Fatal("expected last_active_at to be set")
+	}
+	if time.Since(*lastActiveAt) > time.Minute {
+		t.Fatalf("expected recent last_active_at, got %v", *lastActiveAt)
+	}
+
+	// A second request inside the throttle window must not rewrite the timestamp
+	stale := time.Now().Add(-time.Hour)
+	if _, err := db.Exec(`UPDATE users SET last_active_at = $1 WHERE id = $2`, stale, userID); err != nil {
+		t.Fatalf("failed to backdate last_active_at: %v", err)
+	}
+	if err := service.RecordActivity(context.Background(), rdb, userID); err != nil {
+		t.Fatalf("RecordActivity failed: %v", err)
+	}
+	if err := db.QueryRow(`SELECT last_active_at FROM users WHERE id = $1`, userID).Scan(&lastActiveAt); err != nil {
+		t.Fatalf("failed to query last_active_at: %v", err)
+	}
+	if lastActiveAt == nil || !lastActiveAt.Round(time.Second).Equal(stale.Round(time.Second)) {
+		t.Fatalf("expected throttled update to keep %v, got %v", stale, lastActiveAt)
+	}
+}
+
+func TestRecordActivitySkipsDatabaseWhenThrottled(t *testing.T) {
+	rdb := testutil.GetTestRedis(t)
+	t.Cleanup(func() { testutil.CleanupRedis(t) })
+
+	userID := uuid.New()
+	if err := rdb.Set(context.Background(), userActivityKeyPrefix+userID.String(), "1", userActivityThrottle).Err(); err != nil {
+		t.Fatalf("failed to seed throttle key: %v", err)
+	}
+
+	// A nil database would panic if the throttled path tried to write
+	service := NewUserService(nil)
+	if err := service.RecordActivity(context.Background(), rdb, userID); err != nil {
+		t.Fatalf("expected throttled RecordActivity to succeed, got %v", err)
+	}
+}
+
+func TestGetLastActiveHidesPrivateActivityFromOthers(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	userID := uuid.MustParse(testutil.CreateTestUser(t, db, "privateactive", "privateactive@test.com", false, true))
+	viewerID := uuid.MustParse(testutil.CreateTestUser(t, db, "activeviewer", "activeviewer@test.com", false, true))
+
+	if _, err := db.Exec(`UPDATE users SET last_active_at = now(), activity_private = true WHERE id = $1`, userID); err != nil {
+		t.Fatalf("failed to set up user activity: %v", err)
+	}
+
+	service := NewUserService(db)
+
+	hidden, err := service.GetLastActive(context.Background(), userID, viewerID)
+	if err != nil {
+		t.Fatalf("GetLastActive failed: %v", err)
+	}
+	if hidden.LastActiveAt != nil {
+		t.Fatalf("expected last_active_at hidden from other users, got %v", *hidden.LastActiveAt)
+	}
+
+	own, err := service.GetLastActive(context.Background(), userID, userID)
+	if err != nil {
+		t.Fatalf("GetLastActive failed: %v", err)
+	}
+	if own.LastActiveAt == nil {
+		t.Fatal("expected users to see their own last_active_at")
+	}
+
+	profile, err := service.GetUserProfile(context.Background(), userID, viewerID)
+	if err != nil {
+		t.Fatalf("GetUserProfile failed: %v", err)
+	}
+	if profile.LastActiveAt != nil {
+		t.Fatalf("expected profile last_active_at hidden, got %v", *profile.LastActiveAt)
+	}
+
+	if _, err := db.Exec(`UPDATE users SET activity_private = false WHERE id = $1`, userID); err != nil {
+		t.Fatalf("failed to make activity public: %v", err)
+	}
+	visible, err := service.GetLastActive(context.Background(), userID, viewerID)
+	if err != nil {
+		t.Fatalf("GetLastActive failed: %v", err)
+	}
+	if visible.LastActiveAt == nil {
+		t.Fatal("expected public last_active_at to be visible")
+	}
+}
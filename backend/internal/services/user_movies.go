@@ -0,0 +1,212 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/sanderginn/clubhouse/internal/models"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	// UserMovieStatusWatchlist marks saved items the user has not logged a watch for yet.
+	UserMovieStatusWatchlist = "watchlist"
+	// UserMovieStatusWatched marks items with a watch log.
+	UserMovieStatusWatched = "watched"
+)
+
+// UserMoviesFilter narrows the movie profile list.
+type UserMoviesFilter struct {
+	Status   string
+	Category string
+}
+
+// GetUserMovies returns a user's watchlist items and watched movies and series as one list,
+// newest activity first. Users with private activity only expose an empty list to others.
+func (s *WatchlistService) GetUserMovies(ctx context.Context, userID, viewerID uuid.UUID, filter UserMoviesFilter, limit int, cursor *string) ([]models.UserMovieItem, *string, error) {
+	ctx, span := otel.Tracer("clubhouse.watchlist").Start(ctx, "WatchlistService.GetUserMovies")
+	span.SetAttributes(
+		attribute.String("user_id", userID.String()),
+		attribute.String("viewer_id", viewerID.String()),
+		attribute.String("status", filter.Status),
+		attribute.Bool("has_category", filter.Category != ""),
+		attribute.Int("limit", limit),
+		attribute.Bool("has_cursor", cursor != nil && *cursor != ""),
+	)
+	defer span.End()
+
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+
+	status := strings.ToLower(strings.TrimSpace(filter.Status))
+	if status != "" && status != UserMovieStatusWatchlist && status != UserMovieStatusWatched {
+		invalidErr := errors.New("invalid status")
+		recordSpanError(span, invalidErr)
+		return nil, nil, invalidErr
+	}
+
+	var activityPrivate bool
+	if err := s.db.QueryRowContext(ctx, `
+		SELECT activity_private
+		FROM users
+		WHERE id = $1 AND deleted_at IS NULL AND approved_at IS NOT NULL
+	`, userID).Scan(&activityPrivate); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			notFoundErr := errors.New("user not found")
+			recordSpanError(span, notFoundErr)
+			return nil, nil, notFoundErr
+		}
+		recordSpanError(span, err)
+		return nil, nil, fmt.Errorf("failed to check user: %w", err)
+	}
+	if activityPrivate && userID != viewerID {
+		return []models.UserMovieItem{}, nil, nil
+	}
+
+	query := `
+		WITH saved AS (
+			SELECT post_id, array_agg(category ORDER BY category) AS categories, MAX(created_at) AS saved_at
+			FROM watchlist_items
+			WHERE user_id = $1 AND deleted_at IS NULL
+			GROUP BY post_id
+		),
+		watched AS (
+			SELECT post_id, rating, watched_at
+			FROM watch_logs
+			WHERE user_id = $1 AND deleted_at IS NULL
+		),
+		combined AS (
+			SELECT
+				COALESCE(w.post_id, sv.post_id) AS post_id,
+				sv.categories,
+				w.rating,
+				w.watched_at,
+				COALESCE(w.watched_at, sv.saved_at) AS activity_at
+			FROM saved sv
+			FULL OUTER JOIN watched w ON w.post_id = sv.post_id
+		)
+		SELECT c.post_id, c.categories, c.rating, c.watched_at, c.activity_at
+		FROM combined c
+		JOIN posts p ON p.id = c.post_id AND p.deleted_at IS NULL
+		JOIN sections sec ON sec.id = p.section_id AND sec.type IN ('movie', 'series')
+		WHERE 1 = 1
+	`
+
+	args := []interface{}{userID}
+	argIndex := 2
+	switch status {
+	case UserMovieStatusWatched:
+		query += " AND c.watched_at IS NOT NULL"
+	case UserMovieStatusWatchlist:
+		query += " AND c.watched_at IS NULL"
+	}
+	if category := strings.TrimSpace(filter.Category); category != "" {
+		query += fmt.Sprintf(" AND $%d = ANY(c.categories)", argIndex)
+		args = append(args, category)
+		argIndex++
+	}
+	if cursor != nil && strings.TrimSpace(*cursor) != "" {
+		cursorActivityAt, cursorPostID, err := parseUserMoviesCursor(strings.TrimSpace(*cursor))
+		if err != nil {
+			recordSpanError(span, err)
+			return nil, nil, err
+		}
+		query += fmt.Sprintf(" AND (c.activity_at < $%d OR (c.activity_at = $%d AND c.post_id < $%d))", argIndex, argIndex, argIndex+1)
+		args = append(args, cursorActivityAt, cursorPostID)
+		argIndex += 2
+	}
+
+	query += fmt.Sprintf(" ORDER BY c.activity_at DESC, c.post_id DESC LIMIT $%d", argIndex)
+	args = append(args, limit+1)
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, nil, fmt.Errorf("failed to query user movies: %w", err)
+	}
+	defer rows.Close()
+
+	items := make([]models.UserMovieItem, 0, limit)
+	for rows.Next() {
+		var item models.UserMovieItem
+		var categories []string
+		var rating sql.NullInt64
+		var watchedAt sql.NullTime
+		if err := rows.Scan(&item.PostID, pq.Array(&categories), &rating, &watchedAt, &item.ActivityAt); err != nil {
+			recordSpanError(span, err)
+			return nil, nil, fmt.Errorf("failed to scan user movie: %w", err)
+		}
+
+		item.Status = UserMovieStatusWatchlist
+		item.Categories = categories
+		if watchedAt.Valid {
+			item.Status = UserMovieStatusWatched
+			item.WatchedAt = &watchedAt.Time
+		}
+		if rating.Valid {
+			value := int(rating.Int64)
+			item.Rating = &value
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		recordSpanError(span, err)
+		return nil, nil, fmt.Errorf("failed to iterate user movies: %w", err)
+	}
+
+	hasMore := len(items) > limit
+	if hasMore {
+		items = items[:limit]
+	}
+
+	postIDs := make([]uuid.UUID, 0, len(items))
+	for _, item := range items {
+		postIDs = append(postIDs, item.PostID)
+	}
+	postsByID, err := s.postService.GetPostsByIDs(ctx, postIDs, viewerID)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, nil, fmt.Errorf("failed to load user movie posts: %w", err)
+	}
+	for i := range items {
+		items[i].Post = postsByID[items[i].PostID]
+	}
+
+	var nextCursor *string
+	if hasMore && len(items) > 0 {
+		last := items[len(items)-1]
+		cursorValue := buildUserMoviesCursor(last.ActivityAt, last.PostID)
+		nextCursor = &cursorValue
+	}
+
+	span.SetAttributes(attribute.Int("result_count", len(items)))
+	return items, nextCursor, nil
+}
+
+func buildUserMoviesCursor(activityAt time.Time, postID uuid.UUID) string {
+	return activityAt.UTC().Format(time.RFC3339Nano) + watchLogCursorSeparator + postID.String()
+}
+
+func parseUserMoviesCursor(cursor string) (time.Time, uuid.UUID, error) {
+	parts := strings.Split(cursor, watchLogCursorSeparator)
+	if len(parts) != 2 {
+		return time.Time{}, uuid.Nil, errors.New("invalid cursor")
+	}
+	activityAt, err := time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil {
+		return time.Time{}, uuid.Nil, errors.New("invalid cursor")
+	}
+	postID, err := uuid.Parse(parts[1])
+	if err != nil {
+		return time.Time{}, uuid.Nil, errors.New("invalid cursor")
+	}
+	return activityAt.UTC(), postID, nil
+}
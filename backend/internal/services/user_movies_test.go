@@ -0,0 +1,112 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sanderginn/clubhouse/internal/testutil"
+)
+
+func TestGetUserMoviesFiltersByStatus(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	userID := uuid.MustParse(testutil.CreateTestUser(t, db, "moviesprofile", "moviesprofile@test.com", false, true))
+	movieSectionID := testutil.CreateTestSection(t, db, "Movies", "movie")
+	generalSectionID := testutil.CreateTestSection(t, db, "General", "general")
+	savedPost := uuid.MustParse(testutil.CreateTestPost(t, db, userID.String(), movieSectionID, "Saved movie"))
+	watchedPost := uuid.MustParse(testutil.CreateTestPost(t, db, userID.String(), movieSectionID, "Watched movie"))
+	savedAndWatchedPost := uuid.MustParse(testutil.CreateTestPost(t, db, userID.String(), movieSectionID, "Saved then watched"))
+	testutil.CreateTestPost(t, db, userID.String(), generalSectionID, "Not a movie")
+
+	watchlistService := NewWatchlistService(db)
+	watchLogService := NewWatchLogService(db, nil)
+	ctx := context.Background()
+
+	if _, err := watchlistService.AddToWatchlist(ctx, userID, savedPost, []string{"Someday"}); err != nil {
+		t.Fatalf("AddToWatchlist failed: %v", err)
+	}
+	if _, err := watchlistService.AddToWatchlist(ctx, userID, savedAndWatchedPost, nil); err != nil {
+		t.Fatalf("AddToWatchlist failed: %v", err)
+	}
+	if _, err := watchLogService.LogWatch(ctx, userID, watchedPost, 4, ""); err != nil {
+		t.Fatalf("LogWatch failed: %v", err)
+	}
+	if _, err := watchLogService.LogWatch(ctx, userID, savedAndWatchedPost, 5, ""); err != nil {
+		t.Fatalf("LogWatch failed: %v", err)
+	}
+
+	all, _, err := watchlistService.GetUserMovies(ctx, userID, userID, UserMoviesFilter{}, 20, nil)
+	if err != nil {
+		t.Fatalf("GetUserMovies failed: %v", err)
+	}
+	if len(all) != 3 {
+		t.Fatalf("expected 3 movies, got %d", len(all))
+	}
+
+	watchlist, _, err := watchlistService.GetUserMovies(ctx, userID, userID, UserMoviesFilter{Status: UserMovieStatusWatchlist}, 20, nil)
+	if err != nil {
+		t.Fatalf("GetUserMovies watchlist failed: %v", err)
+	}
+	if len(watchlist) != 1 || watchlist[0].PostID != savedPost {
+		t.Fatalf("expected only the unwatched saved movie, got %+v", watchlist)
+	}
+	if watchlist[0].Status != UserMovieStatusWatchlist || watchlist[0].Rating != nil {
+		t.Fatalf("expected watchlist item without rating, got %+v", watchlist[0])
+	}
+	if len(watchlist[0].Categories) != 1 || watchlist[0].Categories[0] != "Someday" {
+		t.Fatalf("expected Someday category, got %v", watchlist[0].Categories)
+	}
+	if watchlist[0].Post == nil {
+		t.Fatal("expected post to be included")
+	}
+
+	watched, _, err := watchlistService.GetUserMovies(ctx, userID, userID, UserMoviesFilter{Status: UserMovieStatusWatched}, 20, nil)
+	if err != nil {
+		t.Fatalf("GetUserMovies watched failed: %v", err)
+	}
+	if len(watched) != 2 {
+		t.Fatalf("expected 2 watched movies, got %d", len(watched))
+	}
+	ratings := map[uuid.UUID]int{}
+	for _, item := range watched {
+		if item.Status != UserMovieStatusWatched || item.Rating == nil || item.WatchedAt == nil {
+			t.Fatalf("expected watched item with rating, got %+v", item)
+		}
+		ratings[item.PostID] = *item.Rating
+	}
+	if ratings[watchedPost] != 4 || ratings[savedAndWatchedPost] != 5 {
+		t.Fatalf("unexpected ratings: %v", ratings)
+	}
+
+	if _, _, err := watchlistService.GetUserMovies(ctx, userID, userID, UserMoviesFilter{Status: "abandoned"}, 20, nil); err == nil || err.Error() != "invalid status" {
+		t.Fatalf("expected invalid status error, got %v", err)
+	}
+}
+
+func TestGetUserMoviesHidesPrivateActivity(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	userID := uuid.MustParse(testutil.CreateTestUser(t, db, "privatemovies", "privatemovies@test.com", false, true))
+	viewerID := uuid.MustParse(testutil.CreateTestUser(t, db, "moviesviewer", "moviesviewer@test.com", false, true))
+	sectionID := testutil.CreateTestSection(t, db, "Movies", "movie")
+	postID := uuid.MustParse(testutil.CreateTestPost(t, db, userID.String(), sectionID, "Private movie"))
+
+	service := NewWatchlistService(db)
+	if _, err := service.AddToWatchlist(context.Background(), userID, postID, nil); err != nil {
+		t.Fatalf("AddToWatchlist failed: %v", err)
+	}
+	if _, err := db.Exec(`UPDATE users SET activity_private = true WHERE id = $1`, userID); err != nil {
+		t.Fatalf("failed to set activity private: %v", err)
+	}
+
+	items, _, err := service.GetUserMovies(context.Background(), userID, viewerID, UserMoviesFilter{}, 20, nil)
+	if err != nil {
+		t.Fatalf("GetUserMovies failed: %v", err)
+	}
+	if len(items) != 0 {
+		t.Fatalf("expected private movies hidden from other users, got %d", len(items))
+	}
+}
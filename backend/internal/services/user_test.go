@@ -1,9 +1,14 @@
 package services
 
 import (
+	"context"
+	"errors"
+	"strings"
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/sanderginn/clubhouse/internal/models"
+	"github.com/sanderginn/clubhouse/internal/testutil"
 )
 
 func TestValidateRegisterInput(t *testing.T) {
@@ -256,3 +261,196 @@ func TestValidateProfilePictureURL(t *testing.T) {
 		})
 	}
 }
+
+func TestNormalizeProfileUpdate(t *testing.T) {
+	setSettingsForTest(t, func(settings *Settings) {
+		settings.MaxBioLength = 10
+		settings.MaxProfilePictureURLLength = 40
+	})
+
+	t.Run("trims bio", func(t *testing.T) {
+		bio := "  hello  "
+		req := &models.UpdateUserRequest{Bio: &bio}
+		if err := normalizeProfileUpdate(req); err != nil {
+			t.Fatalf("normalizeProfileUpdate() error = %v", err)
+		}
+		if *req.Bio != "hello" {
+			t.Errorf("expected trimmed bio %q, got %q", "hello", *req.Bio)
+		}
+	})
+
+	t.Run("counts characters rather than bytes", func(t *testing.T) {
+		bio := strings.Repeat("é", 10)
+		req := &models.UpdateUserRequest{Bio: &bio}
+		if err := normalizeProfileUpdate(req); err != nil {
+			t.Fatalf("normalizeProfileUpdate() error = %v", err)
+		}
+	})
+
+	t.Run("rejects long bio", func(t *testing.T) {
+		bio := strings.Repeat("a", 11)
+		req := &models.UpdateUserRequest{Bio: &bio}
+		if err := normalizeProfileUpdate(req); !errors.Is(err, ErrBioTooLong) {
+			t.Fatalf("expected ErrBioTooLong, got %v", err)
+		}
+	})
+
+	t.Run("rejects long profile picture URL", func(t *testing.T) {
+		profilePictureURL := "https://example.com/" + strings.Repeat("a", 40)
+		req := &models.UpdateUserRequest{ProfilePictureUrl: &profilePictureURL}
+		if err := normalizeProfileUpdate(req); !errors.Is(err, ErrProfilePictureURLTooLong) {
+			t.Fatalf("expected ErrProfilePictureURLTooLong, got %v", err)
+		}
+	})
+}
+
+func TestUpdateProfileStoresWhitespaceBioAsEmpty(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	userID := uuid.MustParse(testutil.CreateTestUser(t, db, "whitespacebio", "whitespacebio@test.com", false, true))
+
+	service := NewUserService(db)
+	bio := "   \n\t  "
+	response, err := service.UpdateProfile(context.Background(), userID, &models.UpdateUserRequest{Bio: &bio})
+	if err != nil {
+		t.Fatalf("UpdateProfile failed: %v", err)
+	}
+	if response.Bio == nil || *response.Bio != "" {
+		t.Fatalf("expected empty bio in response, got %v", response.Bio)
+	}
+
+	var stored string
+	if err := db.QueryRow(`SELECT bio FROM users WHERE id = $1`, userID).Scan(&stored); err != nil {
+		t.Fatalf("failed to query bio: %v", err)
+	}
+	if stored != "" {
+		t.Fatalf("expected stored bio to be empty, got %q", stored)
+	}
+}
+
+func TestUpdateProfileRejectsLongBio(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	userID := uuid.MustParse(testutil.CreateTestUser(t, db, "longbio", "longbio@test.com", false, true))
+
+	service := NewUserService(db)
+	bio := strings.Repeat("a", GetConfigService().GetSettings().MaxBioLength+1)
+	if _, err := service.UpdateProfile(context.Background(), userID, &models.UpdateUserRequest{Bio: &bio}); !errors.Is(err, ErrBioTooLong) {
+		t.Fatalf("expected ErrBioTooLong, got %v", err)
+	}
+}
+
+func TestGetRatingBiasShowsGenerousRaterAsPositive(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+	ctx := context.Background()
+
+	generousID := uuid.MustParse(testutil.CreateTestUser(t, db, "generousrater", "generousrater@test.com", false, true))
+	otherID := uuid.MustParse(testutil.CreateTestUser(t, db, "strictrater", "strictrater@test.com", false, true))
+	thirdID := uuid.MustParse(testutil.CreateTestUser(t, db, "middlerater", "middlerater@test.com", false, true))
+	movieSectionID := testutil.CreateTestSection(t, db, "Movies", "movie")
+	recipeSectionID := testutil.CreateTestSection(t, db, "Recipes", "recipe")
+	firstMovie := uuid.MustParse(testutil.CreateTestPost(t, db, otherID.String(), movieSectionID, "First movie"))
+	secondMovie := uuid.MustParse(testutil.CreateTestPost(t, db, otherID.String(), movieSectionID, "Second movie"))
+	unratedByOthers := uuid.MustParse(testutil.CreateTestPost(t, db, otherID.String(), movieSectionID, "Third movie"))
+	recipe := uuid.MustParse(testutil.CreateTestPost(t, db, otherID.String(), recipeSectionID, "Recipe"))
+
+	watchLogs := NewWatchLogService(db, nil)
+	for _, entry := range []struct {
+		userID uuid.UUID
+		postID uuid.UUID
+		rating int
+	}{
+		{generousID, firstMovie, 5},
+		{otherID, firstMovie, 2},
+		{thirdID, firstMovie, 4},
+		{generousID, secondMovie, 5},
+		{otherID, secondMovie, 2},
+		{generousID, unratedByOthers, 1},
+	} {
+		if _, err := watchLogs.LogWatch(ctx, entry.userID, entry.postID, entry.rating, ""); err != nil {
+			t.Fatalf("LogWatch failed: %v", err)
+		}
+	}
+
+	cookLogs := NewCookLogService(db)
+	if _, err := cookLogs.LogCook(ctx, generousID, recipe, 5, nil); err != nil {
+		t.Fatalf("LogCook failed: %v", err)
+	}
+	if _, err := cookLogs.LogCook(ctx, otherID, recipe, 4, nil); err != nil {
+		t.Fatalf("LogCook failed: %v", err)
+	}
+
+	bias, err := NewUserService(db).GetRatingBias(ctx, generousID)
+	if err != nil {
+		t.Fatalf("GetRatingBias failed: %v", err)
+	}
+
+	if bias.RatedPosts != 3 {
+		t.Fatalf("expected 3 comparable ratings, got %d", bias.RatedPosts)
+	}
+	if bias.AverageDifference == nil || *bias.AverageDifference <= 0 {
+		t.Fatalf("expected positive overall bias, got %v", bias.AverageDifference)
+	}
+	if len(bias.Sections) != 2 {
+		t.Fatalf("expected 2 section types, got %d", len(bias.Sections))
+	}
+	// Movies: (5-3) and (5-2); recipes: (5-4)
+	if bias.Sections[0].SectionType != "movie" || bias.Sections[0].RatedPosts != 2 || bias.Sections[0].AverageDifference != 2.5 {
+		t.Fatalf("unexpected movie bias: %+v", bias.Sections[0])
+	}
+	if bias.Sections[1].SectionType != "recipe" || bias.Sections[1].RatedPosts != 1 || bias.Sections[1].AverageDifference != 1 {
+		t.Fatalf("unexpected recipe bias: %+v", bias.Sections[1])
+	}
+	if *bias.AverageDifference != 2 {
+		t.Fatalf("expected overall bias 2, got %v", *bias.AverageDifference)
+	}
+}
+
+func TestGetRatingBiasCountsEachPostOncePerUser(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+	ctx := context.Background()
+
+	raterID := uuid.MustParse(testutil.CreateTestUser(t, db, "doublerater", "doublerater@test.com", false, true))
+	otherID := uuid.MustParse(testutil.CreateTestUser(t, db, "singlerater", "singlerater@test.com", false, true))
+	sectionID := testutil.CreateTestSection(t, db, "Recipes", "recipe")
+	recipe := uuid.MustParse(testutil.CreateTestPost(t, db, otherID.String(), sectionID, "Recipe"))
+
+	cookLogs := NewCookLogService(db)
+	if _, err := cookLogs.LogCook(ctx, raterID, recipe, 5, nil); err != nil {
+		t.Fatalf("LogCook failed: %v", err)
+	}
+	if _, err := cookLogs.LogCook(ctx, otherID, recipe, 2, nil); err != nil {
+		t.Fatalf("LogCook failed: %v", err)
+	}
+	// The same post logged a second time in another log table must not count twice.
+	for _, entry := range []struct {
+		userID uuid.UUID
+		rating int
+	}{
+		{raterID, 3},
+		{otherID, 4},
+	} {
+		if _, err := db.ExecContext(ctx, `
+			INSERT INTO watch_logs (user_id, post_id, rating) VALUES ($1, $2, $3)
+		`, entry.userID, recipe, entry.rating); err != nil {
+			t.Fatalf("failed to insert watch log: %v", err)
+		}
+	}
+
+	bias, err := NewUserService(db).GetRatingBias(ctx, raterID)
+	if err != nil {
+		t.Fatalf("GetRatingBias failed: %v", err)
+	}
+
+	if bias.RatedPosts != 1 {
+		t.Fatalf("expected 1 comparable post, got %d", bias.RatedPosts)
+	}
+	// Rater averages 4, the other user averages 3.
+	if bias.AverageDifference == nil || *bias.AverageDifference != 1 {
+		t.Fatalf("expected overall bias 1, got %v", bias.AverageDifference)
+	}
+}
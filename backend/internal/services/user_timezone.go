@@ -0,0 +1,118 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sanderginn/clubhouse/internal/models"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// UserLocation resolves the timezone used for a user's local-day calculations. The user's own
+// timezone wins when set and valid; otherwise the admin display timezone applies, then UTC.
+func UserLocation(userTimezone string) *time.Location {
+	if userTimezone = strings.TrimSpace(userTimezone); userTimezone != "" {
+		if loc, err := time.LoadLocation(userTimezone); err == nil {
+			return loc
+		}
+	}
+	loc, err := time.LoadLocation(GetConfigService().GetConfig().DisplayTimezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// normalizeUserTimezone trims a requested timezone and checks that it names a real location.
+// An empty timezone is valid and clears the user's setting.
+func normalizeUserTimezone(timezone string) (string, error) {
+	timezone = strings.TrimSpace(timezone)
+	if timezone == "" {
+		return "", nil
+	}
+	if timezone == "Local" {
+		return "", ErrInvalidTimezone
+	}
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return "", ErrInvalidTimezone
+	}
+	return timezone, nil
+}
+
+func newTimezonePreference(timezone sql.NullString) *models.TimezonePreference {
+	preference := &models.TimezonePreference{}
+	if timezone.Valid {
+		preference.Timezone = timezone.String
+	}
+	preference.EffectiveTimezone = UserLocation(preference.Timezone).String()
+	return preference
+}
+
+// GetTimezone returns a user's timezone setting and the timezone that applies to them.
+func (s *UserService) GetTimezone(ctx context.Context, userID uuid.UUID) (*models.TimezonePreference, error) {
+	ctx, span := otel.Tracer("clubhouse.users").Start(ctx, "UserService.GetTimezone")
+	span.SetAttributes(attribute.String("user_id", userID.String()))
+	defer span.End()
+
+	var timezone sql.NullString
+	if err := s.db.QueryRowContext(ctx, `
+		SELECT timezone
+		FROM users
+		WHERE id = $1 AND deleted_at IS NULL
+	`, userID).Scan(&timezone); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			notFoundErr := fmt.Errorf("user not found")
+			recordSpanError(span, notFoundErr)
+			return nil, notFoundErr
+		}
+		recordSpanError(span, err)
+		return nil, fmt.Errorf("failed to get timezone: %w", err)
+	}
+
+	return newTimezonePreference(timezone), nil
+}
+
+// UpdateTimezone sets a user's timezone. An empty timezone falls back to the global display timezone.
+func (s *UserService) UpdateTimezone(ctx context.Context, userID uuid.UUID, timezone string) (*models.TimezonePreference, error) {
+	ctx, span := otel.Tracer("clubhouse.users").Start(ctx, "UserService.UpdateTimezone")
+	span.SetAttributes(
+		attribute.String("user_id", userID.String()),
+		attribute.String("timezone", strings.TrimSpace(timezone)),
+	)
+	defer span.End()
+
+	normalized, err := normalizeUserTimezone(timezone)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+
+	var timezoneValue interface{}
+	if normalized != "" {
+		timezoneValue = normalized
+	}
+
+	var updated sql.NullString
+	if err := s.db.QueryRowContext(ctx, `
+		UPDATE users
+		SET timezone = $1, updated_at = now()
+		WHERE id = $2 AND deleted_at IS NULL
+		RETURNING timezone
+	`, timezoneValue, userID).Scan(&updated); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			notFoundErr := fmt.Errorf("user not found")
+			recordSpanError(span, notFoundErr)
+			return nil, notFoundErr
+		}
+		recordSpanError(span, err)
+		return nil, fmt.Errorf("failed to update timezone: %w", err)
+	}
+
+	return newTimezonePreference(updated), nil
+}
@@ -0,0 +1,111 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sanderginn/clubhouse/internal/models"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	defaultTopPostsLimit = 10
+	maxTopPostsLimit     = 50
+)
+
+// GetTopPostsByUser returns a user's posts ordered by how many reactions other users gave them.
+// Only reactions created at or after since count when since is set. Deleted posts, removed
+// reactions, and posts without reactions are excluded.
+func (s *PostService) GetTopPostsByUser(ctx context.Context, targetUserID uuid.UUID, viewerID uuid.UUID, since *time.Time, limit int) ([]models.TopPost, error) {
+	ctx, span := otel.Tracer("clubhouse.posts").Start(ctx, "PostService.GetTopPostsByUser")
+	span.SetAttributes(
+		attribute.String("target_user_id", targetUserID.String()),
+		attribute.String("viewer_id", viewerID.String()),
+		attribute.Bool("has_since", since != nil),
+		attribute.Int("limit", limit),
+	)
+	defer span.End()
+
+	if limit <= 0 || limit > maxTopPostsLimit {
+		limit = defaultTopPostsLimit
+	}
+
+	var exists bool
+	if err := s.db.QueryRowContext(ctx, `
+		SELECT EXISTS(SELECT 1 FROM users WHERE id = $1 AND deleted_at IS NULL)
+	`, targetUserID).Scan(&exists); err != nil {
+		recordSpanError(span, err)
+		return nil, fmt.Errorf("failed to check user: %w", err)
+	}
+	if !exists {
+		notFoundErr := errors.New("user not found")
+		recordSpanError(span, notFoundErr)
+		return nil, notFoundErr
+	}
+
+	var sinceValue interface{}
+	if since != nil {
+		sinceValue = since.UTC()
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT p.id, COUNT(r.id) AS reaction_count
+		FROM posts p
+		JOIN reactions r ON r.post_id = p.id
+			AND r.deleted_at IS NULL
+			AND r.user_id <> p.user_id
+			AND ($2::timestamp IS NULL OR r.created_at >= $2::timestamp)
+		WHERE p.user_id = $1 AND p.deleted_at IS NULL
+		GROUP BY p.id
+		ORDER BY reaction_count DESC, p.created_at DESC, p.id DESC
+		LIMIT $3
+	`, targetUserID, sinceValue, limit)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, fmt.Errorf("failed to fetch top posts: %w", err)
+	}
+	defer rows.Close()
+
+	type rankedPost struct {
+		id            uuid.UUID
+		reactionCount int
+	}
+	var ranked []rankedPost
+	for rows.Next() {
+		var item rankedPost
+		if err := rows.Scan(&item.id, &item.reactionCount); err != nil {
+			recordSpanError(span, err)
+			return nil, fmt.Errorf("failed to scan top post: %w", err)
+		}
+		ranked = append(ranked, item)
+	}
+	if err := rows.Err(); err != nil {
+		recordSpanError(span, err)
+		return nil, fmt.Errorf("failed to iterate top posts: %w", err)
+	}
+
+	postIDs := make([]uuid.UUID, 0, len(ranked))
+	for _, item := range ranked {
+		postIDs = append(postIDs, item.id)
+	}
+	postsByID, err := s.GetPostsByIDs(ctx, postIDs, viewerID)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+
+	topPosts := make([]models.TopPost, 0, len(ranked))
+	for _, item := range ranked {
+		// The post may have been deleted since the ranking query
+		if post, ok := postsByID[item.id]; ok {
+			topPosts = append(topPosts, models.TopPost{Post: post, ReactionCount: item.reactionCount})
+		}
+	}
+
+	span.SetAttributes(attribute.Int("result_count", len(topPosts)))
+	return topPosts, nil
+}
@@ -0,0 +1,83 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sanderginn/clubhouse/internal/testutil"
+)
+
+func TestGetTopPostsByUserRanksByReactions(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	authorID := testutil.CreateTestUser(t, db, "topauthor", "topauthor@test.com", false, true)
+	fanID := testutil.CreateTestUser(t, db, "topfan", "topfan@test.com", false, true)
+	otherFanID := testutil.CreateTestUser(t, db, "topfan2", "topfan2@test.com", false, true)
+	sectionID := testutil.CreateTestSection(t, db, "Top Posts", "general")
+
+	lovedPostID := testutil.CreateTestPost(t, db, authorID, sectionID, "Most loved")
+	likedPostID := testutil.CreateTestPost(t, db, authorID, sectionID, "Liked")
+	oldPostID := testutil.CreateTestPost(t, db, authorID, sectionID, "Loved long ago")
+	deletedPostID := testutil.CreateTestPost(t, db, authorID, sectionID, "Deleted favourite")
+	testutil.CreateTestPost(t, db, authorID, sectionID, "No reactions")
+
+	exec := func(query string, args ...interface{}) {
+		t.Helper()
+		if _, err := db.Exec(query, args...); err != nil {
+			t.Fatalf("failed to seed reactions: %v", err)
+		}
+	}
+	exec(`INSERT INTO reactions (user_id, post_id, emoji) VALUES ($1, $3, '👍'), ($1, $3, '❤️'), ($2, $3, '🔥')`, fanID, otherFanID, lovedPostID)
+	exec(`INSERT INTO reactions (user_id, post_id, emoji) VALUES ($1, $2, '👍')`, fanID, likedPostID)
+	// The author's own reactions and removed reactions do not count
+	exec(`INSERT INTO reactions (user_id, post_id, emoji) VALUES ($1, $2, '👍'), ($1, $2, '❤️'), ($1, $2, '🔥')`, authorID, likedPostID)
+	exec(`INSERT INTO reactions (user_id, post_id, emoji, deleted_at) VALUES ($1, $2, '❤️', now())`, otherFanID, likedPostID)
+	exec(`INSERT INTO reactions (user_id, post_id, emoji, created_at) VALUES ($1, $3, '👍', now() - interval '60 days'), ($2, $3, '👍', now() - interval '60 days')`, fanID, otherFanID, oldPostID)
+	exec(`INSERT INTO reactions (user_id, post_id, emoji) VALUES ($1, $3, '👍'), ($1, $3, '❤️'), ($2, $3, '👍'), ($2, $3, '❤️')`, fanID, otherFanID, deletedPostID)
+	exec(`UPDATE posts SET deleted_at = now() WHERE id = $1`, deletedPostID)
+
+	service := NewPostService(db)
+	topPosts, err := service.GetTopPostsByUser(context.Background(), uuid.MustParse(authorID), uuid.MustParse(fanID), nil, 10)
+	if err != nil {
+		t.Fatalf("GetTopPostsByUser failed: %v", err)
+	}
+
+	expected := []struct {
+		postID string
+		count  int
+	}{
+		{lovedPostID, 3},
+		{oldPostID, 2},
+		{likedPostID, 1},
+	}
+	if len(topPosts) != len(expected) {
+		t.Fatalf("expected %d top posts, got %d", len(expected), len(topPosts))
+	}
+	for i, want := range expected {
+		if topPosts[i].Post.ID.String() != want.postID {
+			t.Errorf("expected post %s at rank %d, got %s", want.postID, i+1, topPosts[i].Post.ID)
+		}
+		if topPosts[i].ReactionCount != want.count {
+			t.Errorf("expected %d reactions at rank %d, got %d", want.count, i+1, topPosts[i].ReactionCount)
+		}
+	}
+
+	since := time.Now().AddDate(0, 0, -30)
+	recent, err := service.GetTopPostsByUser(context.Background(), uuid.MustParse(authorID), uuid.MustParse(fanID), &since, 10)
+	if err != nil {
+		t.Fatalf("GetTopPostsByUser with window failed: %v", err)
+	}
+	if len(recent) != 2 {
+		t.Fatalf("expected 2 top posts within the window, got %d", len(recent))
+	}
+	if recent[0].Post.ID.String() != lovedPostID || recent[1].Post.ID.String() != likedPostID {
+		t.Fatalf("expected loved then liked posts within the window, got %s then %s", recent[0].Post.ID, recent[1].Post.ID)
+	}
+
+	if _, err := service.GetTopPostsByUser(context.Background(), uuid.New(), uuid.MustParse(fanID), nil, 10); err == nil || err.Error() != "user not found" {
+		t.Fatalf("expected user not found error, got %v", err)
+	}
+}
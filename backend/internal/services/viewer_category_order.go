@@ -0,0 +1,68 @@
+package services
+
+import "strings"
+
+// ViewerCategoryOrder controls how a viewer's categories are ordered in post stats.
+type ViewerCategoryOrder string
+
+const (
+	// ViewerCategoryOrderAlphabetical sorts categories by name.
+	ViewerCategoryOrderAlphabetical ViewerCategoryOrder = "alphabetical"
+	// ViewerCategoryOrderAdded sorts categories by when the post was saved to them.
+	ViewerCategoryOrderAdded ViewerCategoryOrder = "added"
+	// ViewerCategoryOrderPosition sorts categories by the viewer's own category position,
+	// with categories that have no position (such as Uncategorized) last.
+	ViewerCategoryOrderPosition ViewerCategoryOrder = "position"
+)
+
+// Stat types whose viewer category ordering can be configured.
+const (
+	ViewerCategoryStatRecipe    = "recipe"
+	ViewerCategoryStatWatchlist = "watchlist"
+	ViewerCategoryStatBookshelf = "bookshelf"
+)
+
+var defaultViewerCategoryOrders = map[string]ViewerCategoryOrder{
+	ViewerCategoryStatRecipe:    ViewerCategoryOrderAlphabetical,
+	ViewerCategoryStatWatchlist: ViewerCategoryOrderAlphabetical,
+	ViewerCategoryStatBookshelf: ViewerCategoryOrderAdded,
+}
+
+// normalizeViewerCategoryOrders fills in the default order for every stat type, replacing
+// unknown or empty orders and dropping unknown stat types.
+func normalizeViewerCategoryOrders(orders map[string]ViewerCategoryOrder) map[string]ViewerCategoryOrder {
+	normalized := copyViewerCategoryOrders(defaultViewerCategoryOrders)
+	for statType, order := range orders {
+		statType = strings.ToLower(strings.TrimSpace(statType))
+		if _, ok := normalized[statType]; !ok {
+			continue
+		}
+		resolved := ViewerCategoryOrder(strings.ToLower(strings.TrimSpace(string(order))))
+		switch resolved {
+		case ViewerCategoryOrderAlphabetical, ViewerCategoryOrderAdded, ViewerCategoryOrderPosition:
+			normalized[statType] = resolved
+		}
+	}
+	return normalized
+}
+
+// viewerCategoryOrderBy builds the ORDER BY expression for a stat type's viewer categories from
+// the category name, save timestamp and category position columns of the query.
+func viewerCategoryOrderBy(statType, nameColumn, createdAtColumn, positionColumn string) string {
+	switch GetConfigService().GetSettings().ViewerCategoryOrders[statType] {
+	case ViewerCategoryOrderAdded:
+		return createdAtColumn + " ASC, " + nameColumn + " ASC"
+	case ViewerCategoryOrderPosition:
+		return positionColumn + " ASC NULLS LAST, " + nameColumn + " ASC"
+	default:
+		return nameColumn + " ASC"
+	}
+}
+
+func copyViewerCategoryOrders(orders map[string]ViewerCategoryOrder) map[string]ViewerCategoryOrder {
+	copied := make(map[string]ViewerCategoryOrder, len(orders))
+	for statType, order := range orders {
+		copied[statType] = order
+	}
+	return copied
+}
@@ -0,0 +1,75 @@
+package services
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sanderginn/clubhouse/internal/testutil"
+)
+
+func TestViewerCategoryOrderBy(t *testing.T) {
+	if got := viewerCategoryOrderBy(ViewerCategoryStatRecipe, "sr.category", "sr.created_at", "rc.position"); got != "sr.category ASC" {
+		t.Errorf("expected alphabetical default for recipes, got %q", got)
+	}
+	if got := viewerCategoryOrderBy(ViewerCategoryStatBookshelf, "bc.name", "bi.created_at", "bc.position"); got != "bi.created_at ASC, bc.name ASC" {
+		t.Errorf("expected added-order default for bookshelf, got %q", got)
+	}
+
+	setSettingsForTest(t, func(settings *Settings) {
+		settings.ViewerCategoryOrders[ViewerCategoryStatRecipe] = ViewerCategoryOrderPosition
+	})
+	if got := viewerCategoryOrderBy(ViewerCategoryStatRecipe, "sr.category", "sr.created_at", "rc.position"); got != "rc.position ASC NULLS LAST, sr.category ASC" {
+		t.Errorf("expected position ordering, got %q", got)
+	}
+}
+
+func TestRecipeStatsViewerCategoriesRespectConfiguredOrder(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	viewerID := testutil.CreateTestUser(t, db, "categoryorderviewer", "categoryorderviewer@test.com", false, true)
+	sectionID := testutil.CreateTestSection(t, db, "Ordered Recipes", "recipe")
+	postID := testutil.CreateTestPost(t, db, viewerID, sectionID, "Recipe content")
+
+	for _, category := range []struct {
+		name     string
+		position int
+	}{{"Weeknight", 0}, {"Dinner", 1}} {
+		if _, err := db.Exec(`
+			INSERT INTO recipe_categories (user_id, name, position) VALUES ($1, $2, $3)
+		`, viewerID, category.name, category.position); err != nil {
+			t.Fatalf("failed to insert recipe category: %v", err)
+		}
+	}
+	for _, category := range []string{"Dinner", "Uncategorized", "Weeknight"} {
+		if _, err := db.Exec(`
+			INSERT INTO saved_recipes (user_id, post_id, category) VALUES ($1, $2, $3)
+		`, viewerID, postID, category); err != nil {
+			t.Fatalf("failed to insert saved recipe: %v", err)
+		}
+	}
+
+	service := NewPostService(db)
+	viewer := uuid.MustParse(viewerID)
+
+	stats, err := service.getRecipeStats(context.Background(), uuid.MustParse(postID), &viewer)
+	if err != nil {
+		t.Fatalf("getRecipeStats failed: %v", err)
+	}
+	if expected := []string{"Dinner", "Uncategorized", "Weeknight"}; !reflect.DeepEqual(stats.ViewerCategories, expected) {
+		t.Fatalf("expected alphabetical categories %v, got %v", expected, stats.ViewerCategories)
+	}
+
+	setSettingsForTest(t, func(settings *Settings) {
+		settings.ViewerCategoryOrders[ViewerCategoryStatRecipe] = ViewerCategoryOrderPosition
+	})
+	stats, err = service.getRecipeStats(context.Background(), uuid.MustParse(postID), &viewer)
+	if err != nil {
+		t.Fatalf("getRecipeStats failed: %v", err)
+	}
+	if expected := []string{"Weeknight", "Dinner", "Uncategorized"}; !reflect.DeepEqual(stats.ViewerCategories, expected) {
+		t.Fatalf("expected position-ordered categories %v, got %v", expected, stats.ViewerCategories)
+	}
+}
@@ -78,6 +78,11 @@ func (s *WatchLogService) logWatch(ctx context.Context, userID, postID uuid.UUID
 		return nil, err
 	}
 
+	if err := validateLogNotes(notes); err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+
 	if err := s.verifyWatchablePost(ctx, postID); err != nil {
 		recordSpanError(span, err)
 		return nil, err
@@ -154,6 +159,13 @@ func (s *WatchLogService) UpdateWatchLog(ctx context.Context, userID, postID uui
 		}
 	}
 
+	if notes != nil {
+		if err := validateLogNotes(*notes); err != nil {
+			recordSpanError(span, err)
+			return nil, err
+		}
+	}
+
 	if err := s.verifyWatchablePost(ctx, postID); err != nil {
 		recordSpanError(span, err)
 		return nil, err
@@ -371,8 +383,9 @@ func (s *WatchLogService) GetPostWatchLogs(ctx context.Context, postID uuid.UUID
 		FROM watch_logs wl
 		JOIN users u ON wl.user_id = u.id
 		WHERE wl.post_id = $1 AND wl.deleted_at IS NULL
+			AND (u.activity_private = false OR u.id = $2)
 		ORDER BY wl.watched_at DESC, wl.id DESC
-	`, postID)
+	`, postID, uuidPointerValue(viewerID))
 	if err != nil {
 		recordSpanError(span, err)
 		return nil, fmt.Errorf("failed to query post watch logs: %w", err)
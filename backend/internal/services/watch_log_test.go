@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"math"
 	"testing"
 	"time"
@@ -297,6 +298,38 @@ func TestGetPostWatchLogs(t *testing.T) {
 	}
 }
 
+func TestGetPostWatchLogsHidesPrivateUsers(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	viewerID := testutil.CreateTestUser(t, db, "watchpublicviewer", "watchpublicviewer@test.com", false, true)
+	privateUserID := testutil.CreateTestUser(t, db, "watchprivateuser", "watchprivateuser@test.com", false, true)
+	sectionID := testutil.CreateTestSection(t, db, "Movies", "movie")
+	postID := testutil.CreateTestPost(t, db, viewerID, sectionID, "Movie post")
+
+	if _, err := db.Exec(`UPDATE users SET activity_private = true WHERE id = $1`, privateUserID); err != nil {
+		t.Fatalf("failed to mark user private: %v", err)
+	}
+
+	service := NewWatchLogService(db, nil)
+	if _, err := service.LogWatch(context.Background(), uuid.MustParse(privateUserID), uuid.MustParse(postID), 5, ""); err != nil {
+		t.Fatalf("LogWatch failed: %v", err)
+	}
+
+	viewer := uuid.MustParse(viewerID)
+	info, err := service.GetPostWatchLogs(context.Background(), uuid.MustParse(postID), &viewer)
+	if err != nil {
+		t.Fatalf("GetPostWatchLogs failed: %v", err)
+	}
+
+	if info.WatchCount != 1 {
+		t.Fatalf("expected watch count 1, got %d", info.WatchCount)
+	}
+	if len(info.Logs) != 0 {
+		t.Fatalf("expected private watch log to be hidden, got %d logs", len(info.Logs))
+	}
+}
+
 func TestGetUserWatchLogsPagination(t *testing.T) {
 	db := testutil.RequireTestDB(t)
 	t.Cleanup(func() { testutil.CleanupTables(t, db) })
@@ -435,3 +468,31 @@ func TestWatchLogRatingValidation(t *testing.T) {
 		t.Fatalf("expected update validation error for invalid rating")
 	}
 }
+
+func TestWatchLogNotesLengthLimit(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+	setSettingsForTest(t, func(settings *Settings) { settings.MaxLogNoteLength = 10 })
+
+	userID := testutil.CreateTestUser(t, db, "watchlognotes", "watchlognotes@test.com", false, true)
+	sectionID := testutil.CreateTestSection(t, db, "Movies", "movie")
+	postID := testutil.CreateTestPost(t, db, userID, sectionID, "Movie post")
+
+	service := NewWatchLogService(db, nil)
+	if _, err := service.LogWatch(context.Background(), uuid.MustParse(userID), uuid.MustParse(postID), 4, "eleven char"); !errors.Is(err, ErrLogNoteTooLong) {
+		t.Fatalf("expected ErrLogNoteTooLong, got %v", err)
+	}
+
+	watchLog, err := service.LogWatch(context.Background(), uuid.MustParse(userID), uuid.MustParse(postID), 4, "ten chars!")
+	if err != nil {
+		t.Fatalf("LogWatch failed: %v", err)
+	}
+	if watchLog.Notes == nil || *watchLog.Notes != "ten chars!" {
+		t.Fatalf("expected notes to be stored, got %v", watchLog.Notes)
+	}
+
+	tooLong := "eleven char"
+	if _, err := service.UpdateWatchLog(context.Background(), uuid.MustParse(userID), uuid.MustParse(postID), nil, &tooLong); !errors.Is(err, ErrLogNoteTooLong) {
+		t.Fatalf("expected ErrLogNoteTooLong on update, got %v", err)
+	}
+}
@@ -20,6 +20,7 @@ const (
 
 type watchlistPostService interface {
 	GetPostByID(ctx context.Context, postID uuid.UUID, userID uuid.UUID) (*models.Post, error)
+	GetPostsByIDs(ctx context.Context, postIDs []uuid.UUID, viewerID uuid.UUID) (map[uuid.UUID]*models.Post, error)
 }
 
 // WatchlistService handles watchlist operations for movie and series posts.
@@ -326,10 +327,11 @@ func (s *WatchlistService) GetPostWatchlistInfo(ctx context.Context, postID uuid
 		FROM watchlist_items wi
 		JOIN users u ON wi.user_id = u.id
 		WHERE wi.post_id = $1 AND wi.deleted_at IS NULL
+			AND (u.activity_private = false OR u.id = $2)
 		GROUP BY u.id, u.username, u.profile_picture_url
 		ORDER BY first_saved ASC
 	`
-	rows, err := s.db.QueryContext(ctx, usersQuery, postID)
+	rows, err := s.db.QueryContext(ctx, usersQuery, postID, uuidPointerValue(viewerID))
 	if err != nil {
 		recordSpanError(span, err)
 		return nil, err
@@ -427,6 +429,63 @@ func (s *WatchlistService) GetUserWatchlistCategories(ctx context.Context, userI
 	return categories, nil
 }
 
+// GetUserWatchlistCategoryCounts returns each watchlist category with its active item count.
+// Categories are listed by position; items filed under names without a category row
+// (such as Uncategorized) are appended after them.
+func (s *WatchlistService) GetUserWatchlistCategoryCounts(ctx context.Context, userID uuid.UUID) ([]models.WatchlistCategoryCount, error) {
+	ctx, span := otel.Tracer("clubhouse.watchlist").Start(ctx, "WatchlistService.GetUserWatchlistCategoryCounts")
+	span.SetAttributes(attribute.String("user_id", userID.String()))
+	defer span.End()
+
+	query := `
+		WITH item_counts AS (
+			SELECT wi.category, COUNT(*) AS item_count
+			FROM watchlist_items wi
+			JOIN posts p ON wi.post_id = p.id
+			WHERE wi.user_id = $1 AND wi.deleted_at IS NULL AND p.deleted_at IS NULL
+			GROUP BY wi.category
+		)
+		SELECT name, item_count
+		FROM (
+			SELECT wc.name, COALESCE(ic.item_count, 0) AS item_count, 0 AS sort_group, wc.position, wc.created_at
+			FROM watchlist_categories wc
+			LEFT JOIN item_counts ic ON ic.category = wc.name
+			WHERE wc.user_id = $1
+			UNION ALL
+			SELECT ic.category, ic.item_count, 1, 0, NULL
+			FROM item_counts ic
+			WHERE NOT EXISTS (
+				SELECT 1 FROM watchlist_categories wc
+				WHERE wc.user_id = $1 AND wc.name = ic.category
+			)
+		) counts
+		ORDER BY sort_group ASC, position ASC, created_at ASC, name ASC
+	`
+
+	rows, err := s.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := []models.WatchlistCategoryCount{}
+	for rows.Next() {
+		var count models.WatchlistCategoryCount
+		if err := rows.Scan(&count.Name, &count.ItemCount); err != nil {
+			recordSpanError(span, err)
+			return nil, err
+		}
+		counts = append(counts, count)
+	}
+	if err := rows.Err(); err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+
+	return counts, nil
+}
+
 // CreateCategory creates a new watchlist category.
 func (s *WatchlistService) CreateCategory(ctx context.Context, userID uuid.UUID, name string) (*models.WatchlistCategory, error) {
 	ctx, span := otel.Tracer("clubhouse.watchlist").Start(ctx, "WatchlistService.CreateCategory")
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
+	"github.com/sanderginn/clubhouse/internal/models"
 	"github.com/sanderginn/clubhouse/internal/testutil"
 )
 
@@ -396,6 +397,52 @@ func TestGetUserWatchlistGroupsByCategoryAndIncludesPost(t *testing.T) {
 	}
 }
 
+func TestGetUserWatchlistCategoryCounts(t *testing.T) {
+	db := testutil.RequireTestDB(t)
+	t.Cleanup(func() { testutil.CleanupTables(t, db) })
+
+	userID := uuid.MustParse(testutil.CreateTestUser(t, db, "watchlistcounts", "watchlistcounts@test.com", false, true))
+	sectionID := testutil.CreateTestSection(t, db, "Movies", "movie")
+	postA := uuid.MustParse(testutil.CreateTestPost(t, db, userID.String(), sectionID, "Movie A"))
+	postB := uuid.MustParse(testutil.CreateTestPost(t, db, userID.String(), sectionID, "Movie B"))
+	postC := uuid.MustParse(testutil.CreateTestPost(t, db, userID.String(), sectionID, "Movie C"))
+
+	service := NewWatchlistService(db)
+	for _, name := range []string{"Favorites", "Someday"} {
+		if _, err := service.CreateCategory(context.Background(), userID, name); err != nil {
+			t.Fatalf("CreateCategory %s failed: %v", name, err)
+		}
+	}
+
+	for _, postID := range []uuid.UUID{postA, postB} {
+		if _, err := service.AddToWatchlist(context.Background(), userID, postID, []string{"Favorites"}); err != nil {
+			t.Fatalf("AddToWatchlist favorites failed: %v", err)
+		}
+	}
+	if _, err := service.AddToWatchlist(context.Background(), userID, postC, nil); err != nil {
+		t.Fatalf("AddToWatchlist uncategorized failed: %v", err)
+	}
+
+	counts, err := service.GetUserWatchlistCategoryCounts(context.Background(), userID)
+	if err != nil {
+		t.Fatalf("GetUserWatchlistCategoryCounts failed: %v", err)
+	}
+
+	expected := []models.WatchlistCategoryCount{
+		{Name: "Favorites", ItemCount: 2},
+		{Name: "Someday", ItemCount: 0},
+		{Name: defaultWatchlistCategory, ItemCount: 1},
+	}
+	if len(counts) != len(expected) {
+		t.Fatalf("expected %d categories, got %+v", len(expected), counts)
+	}
+	for i := range expected {
+		if counts[i] != expected[i] {
+			t.Fatalf("expected category %d to be %+v, got %+v", i, expected[i], counts[i])
+		}
+	}
+}
+
 func TestGetUserWatchlistFiltersBySectionType(t *testing.T) {
 	db := testutil.RequireTestDB(t)
 	t.Cleanup(func() { testutil.CleanupTables(t, db) })
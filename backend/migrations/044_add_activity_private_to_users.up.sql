@@ -0,0 +1 @@
+ALTER TABLE users ADD COLUMN activity_private BOOLEAN NOT NULL DEFAULT false;
@@ -0,0 +1,7 @@
+CREATE TABLE section_read_positions (
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  section_id UUID NOT NULL REFERENCES sections(id) ON DELETE CASCADE,
+  last_read_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP NOT NULL DEFAULT now(),
+  PRIMARY KEY (user_id, section_id)
+);
@@ -0,0 +1,3 @@
+ALTER TABLE users
+  DROP COLUMN IF EXISTS digest_last_run_at,
+  DROP COLUMN IF EXISTS digest_frequency;
@@ -0,0 +1,4 @@
+ALTER TABLE users
+  ADD COLUMN digest_frequency VARCHAR(10) NOT NULL DEFAULT 'off'
+    CHECK (digest_frequency IN ('off', 'daily', 'weekly')),
+  ADD COLUMN digest_last_run_at TIMESTAMP;
@@ -0,0 +1,2 @@
+ALTER TABLE admin_config
+DROP COLUMN IF EXISTS allowed_image_hosts;
@@ -0,0 +1,2 @@
+ALTER TABLE admin_config
+ADD COLUMN IF NOT EXISTS allowed_image_hosts TEXT[] NOT NULL DEFAULT '{}';
@@ -0,0 +1,2 @@
+ALTER TABLE admin_config
+ADD COLUMN IF NOT EXISTS maintenance_mode BOOLEAN NOT NULL DEFAULT false;
@@ -0,0 +1,2 @@
+ALTER TABLE users
+  ADD COLUMN timezone VARCHAR(64);
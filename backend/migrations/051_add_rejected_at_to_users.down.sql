@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_users_rejected_at;
+
+ALTER TABLE users
+  DROP COLUMN IF EXISTS rejected_at;
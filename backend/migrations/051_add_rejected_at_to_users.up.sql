@@ -0,0 +1,4 @@
+ALTER TABLE users
+  ADD COLUMN rejected_at TIMESTAMP;
+
+CREATE INDEX idx_users_rejected_at ON users(rejected_at) WHERE rejected_at IS NOT NULL;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_links_last_checked_at;
+
+ALTER TABLE links
+  DROP COLUMN IF EXISTS is_dead,
+  DROP COLUMN IF EXISTS last_checked_at;
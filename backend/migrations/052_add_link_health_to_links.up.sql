@@ -0,0 +1,5 @@
+ALTER TABLE links
+  ADD COLUMN last_checked_at TIMESTAMP,
+  ADD COLUMN is_dead BOOLEAN NOT NULL DEFAULT false;
+
+CREATE INDEX idx_links_last_checked_at ON links(last_checked_at NULLS FIRST);
@@ -0,0 +1,3 @@
+ALTER TABLE admin_config
+DROP COLUMN IF EXISTS section_reactions,
+DROP COLUMN IF EXISTS allowed_reactions;
@@ -0,0 +1,3 @@
+ALTER TABLE admin_config
+ADD COLUMN IF NOT EXISTS allowed_reactions TEXT[] NOT NULL DEFAULT '{}',
+ADD COLUMN IF NOT EXISTS section_reactions JSONB NOT NULL DEFAULT '{}';
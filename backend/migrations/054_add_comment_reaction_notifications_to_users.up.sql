@@ -0,0 +1,2 @@
+ALTER TABLE users
+  ADD COLUMN comment_reaction_notifications BOOLEAN NOT NULL DEFAULT true;
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_notifications_unread_comment_reaction;
@@ -0,0 +1,13 @@
+-- Allow one unread reaction notification per comment and reactor. The index only covers
+-- notifications created from this migration on, so existing unread duplicates are left in place.
+DO $$
+BEGIN
+  EXECUTE format(
+    'CREATE UNIQUE INDEX idx_notifications_unread_comment_reaction
+       ON notifications(user_id, related_comment_id, related_user_id)
+       WHERE type = %L AND read_at IS NULL AND related_comment_id IS NOT NULL AND created_at >= %L::timestamp',
+    'reaction',
+    now()::timestamp
+  );
+END
+$$;
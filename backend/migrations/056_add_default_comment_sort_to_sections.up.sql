@@ -0,0 +1,3 @@
+ALTER TABLE sections
+  ADD COLUMN default_comment_sort VARCHAR(10)
+    CHECK (default_comment_sort IN ('new', 'top'));
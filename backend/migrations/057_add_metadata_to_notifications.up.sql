@@ -0,0 +1,2 @@
+ALTER TABLE notifications
+  ADD COLUMN metadata JSONB;
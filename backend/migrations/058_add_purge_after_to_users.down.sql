@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_users_purge_after;
+
+ALTER TABLE users
+  DROP COLUMN IF EXISTS purge_after;
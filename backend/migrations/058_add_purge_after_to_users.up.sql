@@ -0,0 +1,10 @@
+ALTER TABLE users
+  ADD COLUMN purge_after TIMESTAMP;
+
+-- The retention window used for earlier rejections was not recorded; give them the full
+-- 30 days from rejection so no appeal is cut short.
+UPDATE users
+SET purge_after = rejected_at + INTERVAL '30 days'
+WHERE rejected_at IS NOT NULL AND approved_at IS NULL;
+
+CREATE INDEX idx_users_purge_after ON users(purge_after) WHERE purge_after IS NOT NULL;